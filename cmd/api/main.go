@@ -2,21 +2,38 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/aws"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/eventbus"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/graphql"
+	appgrpc "github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc"
 	apphttp "github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/handler"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/middleware"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/openapi"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/plantillas"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/smtp"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/dynamodb"
-	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/postgres"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/local"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/redis"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/s3"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/webhook"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/config"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/usecase"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
 )
 
 func main() {
@@ -26,54 +43,116 @@ func main() {
 		log.Fatalf("Error al cargar configuración: %v", err)
 	}
 
-	// Conectar a PostgreSQL
-	db, err := config.NewPostgresConnection(cfg.Database)
+	// Conectar a la base de datos relacional
+	db, err := config.NewDatabaseConnection(cfg.Database)
 	if err != nil {
-		log.Fatalf("Error al conectar a PostgreSQL: %v", err)
+		log.Fatalf("Error al conectar a la base de datos: %v", err)
 	}
-	log.Println("Conectado a PostgreSQL")
+	log.Printf("Conectado a la base de datos (%s)", cfg.Database.Driver)
 
 	// Ejecutar migraciones
-	if err := postgres.RunMigrations(db); err != nil {
+	if err := storage.RunMigrations(db); err != nil {
 		log.Fatalf("Error al ejecutar migraciones: %v", err)
 	}
 	log.Println("Migraciones ejecutadas")
 
-	// Crear cliente S3 (MinIO)
-	s3Client, err := config.NewS3Client(cfg.S3)
-	if err != nil {
-		log.Fatalf("Error al crear cliente S3: %v", err)
-	}
-	log.Println("Cliente S3 creado")
+	// Inicializar repositorios; los de GORM sirven para cualquier DB_DRIVER
+	alumnoRepo := relational.NewAlumnoRepository(db)
+	profesorRepo := relational.NewProfesorRepository(db)
+	documentoRepo := relational.NewDocumentoRepository(db)
+	plantillaRepo := relational.NewPlantillaRepository(db)
+	notificacionRepo := relational.NewNotificacionRepository(db)
+	webhookRepo := relational.NewWebhookRepository(db)
+	webhookEntregaRepo := relational.NewWebhookEntregaRepository(db)
+	eventoSalidaRepo := relational.NewEventoSalidaRepository(db)
+	jobRepo := relational.NewJobRepository(db)
+	idempotenciaRepo := relational.NewIdempotenciaRepository(db)
 
-	// Crear cliente DynamoDB
-	dynamoClient, err := config.NewDynamoDBCient(cfg.DynamoDB)
-	if err != nil {
-		log.Fatalf("Error al crear cliente DynamoDB: %v", err)
-	}
-	log.Println("Cliente DynamoDB creado")
+	// Inicializar almacenamiento de archivos
+	ctx := context.Background()
+	var fileStorage port.FileStorage
+	var fileHandler *handler.FileHandler
+	switch cfg.Storage.Driver {
+	case config.StorageDriverLocal:
+		signingKey := []byte(cfg.Storage.Local.SigningKey)
+		if len(signingKey) == 0 {
+			signingKey = make([]byte, 32)
+			if _, err := rand.Read(signingKey); err != nil {
+				log.Fatalf("Error al generar clave de firma: %v", err)
+			}
+			log.Println("LOCAL_STORAGE_SIGNING_KEY vacío: las URLs firmadas no sobrevivirán a un reinicio")
+		}
 
-	// Inicializar repositorios
-	alumnoRepo := postgres.NewAlumnoRepository(db)
-	profesorRepo := postgres.NewProfesorRepository(db)
-	sesionRepo := dynamodb.NewSesionRepository(dynamoClient, cfg.DynamoDB.TableName)
-	fileStorage := s3.NewFileStorage(s3Client, cfg.S3.BucketName, cfg.S3.Endpoint)
+		localStorage := local.NewFileStorage(cfg.Storage.Local.RootDir, cfg.Storage.Local.BaseURL, signingKey, cfg.Storage.Local.URLTTL)
+		if err := localStorage.CreateRoot(ctx); err != nil {
+			log.Fatalf("Error al crear directorio de archivos: %v", err)
+		}
+		fileStorage = localStorage
+		fileHandler = handler.NewFileHandler(localStorage)
+		log.Printf("Archivos almacenados en disco: %s", cfg.Storage.Local.RootDir)
+	default:
+		s3Client, err := config.NewS3Client(cfg.S3)
+		if err != nil {
+			log.Fatalf("Error al crear cliente S3: %v", err)
+		}
+		log.Println("Cliente S3 creado")
 
-	// Crear tabla DynamoDB y bucket S3 si no existen
-	ctx := context.Background()
-	if err := sesionRepo.CreateTable(ctx); err != nil {
-		log.Printf("Tabla DynamoDB ya existe o error: %v", err)
+		s3Storage := s3.NewFileStorage(s3Client, cfg.S3.BucketName, cfg.S3.PresignTTL)
+		if err := s3Storage.CreateBucket(ctx); err != nil {
+			log.Printf("Bucket S3 ya existe o error: %v", err)
+		}
+		fileStorage = s3Storage
 	}
-	if err := fileStorage.CreateBucket(ctx); err != nil {
-		log.Printf("Bucket S3 ya existe o error: %v", err)
+
+	// Inicializar almacén de sesiones
+	var sesionRepo port.SesionRepository
+	switch cfg.Sesion.Store {
+	case config.SesionStorePostgres:
+		repo := relational.NewSesionRepository(db, cfg.Sesion.TTL)
+		if err := repo.CreateTable(ctx); err != nil {
+			log.Fatalf("Error al crear tabla de sesiones: %v", err)
+		}
+		sesionRepo = repo
+		log.Printf("Sesiones almacenadas en la base de datos relacional (TTL %s)", cfg.Sesion.TTL)
+	case config.SesionStoreRedis:
+		redisClient, err := config.NewRedisClient(cfg.Redis)
+		if err != nil {
+			log.Fatalf("Error al crear cliente Redis: %v", err)
+		}
+		sesionRepo = redis.NewSesionRepository(redisClient, cfg.Sesion.TTL)
+		log.Printf("Sesiones almacenadas en Redis (TTL %s)", cfg.Sesion.TTL)
+	default:
+		dynamoClient, err := config.NewDynamoDBCient(cfg.DynamoDB)
+		if err != nil {
+			log.Fatalf("Error al crear cliente DynamoDB: %v", err)
+		}
+		log.Println("Cliente DynamoDB creado")
+
+		repo := dynamodb.NewSesionRepository(dynamoClient, cfg.DynamoDB.TableName)
+		if err := repo.CreateTable(ctx); err != nil {
+			log.Printf("Tabla DynamoDB ya existe o error: %v", err)
+		}
+		sesionRepo = repo
 	}
 
 	// Inicializar notificador
 	var notifier aws.Notifier
-	if cfg.SNS.Mock {
+	switch {
+	case cfg.Notifier.Driver == config.NotifierDriverSMTP:
+		notifier = smtp.NewNotifier(smtp.Config{
+			Host:        cfg.SMTP.Host,
+			Port:        cfg.SMTP.Port,
+			Username:    cfg.SMTP.Username,
+			Password:    cfg.SMTP.Password,
+			TLS:         cfg.SMTP.TLS,
+			From:        cfg.SMTP.From,
+			BroadcastTo: cfg.SMTP.BroadcastTo,
+		})
+		log.Printf("SMTP habilitado: %s:%s (tls=%s)", cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.TLS)
+	case cfg.SNS.Mock:
 		notifier = aws.NewSNSMock()
 		log.Println("SNS Mock habilitado")
-	} else {
+	default:
 		snsClient, err := config.NewSNSClient(cfg.SNS)
 		if err != nil {
 			log.Fatalf("Error al inicializar cliente SNS: %v", err)
@@ -82,19 +161,116 @@ func main() {
 		log.Printf("SNS habilitado con topic: %s", cfg.SNS.TopicARN)
 	}
 
+	// Plantillas de notificación: las guardadas en la base de datos tienen prioridad
+	renderer, err := plantillas.NewRenderer(plantillaRepo)
+	if err != nil {
+		log.Fatalf("Error al cargar plantillas: %v", err)
+	}
+
 	// Inicializar casos de uso
-	alumnoUseCase := usecase.NewAlumnoUseCase(alumnoRepo, fileStorage, notifier)
-	profesorUseCase := usecase.NewProfesorUseCase(profesorRepo)
-	sesionUseCase := usecase.NewSesionUseCase(sesionRepo, alumnoRepo)
+	transactor := storage.NewTransactor(db)
+	outboxConfig := usecase.OutboxConfig{
+		BatchSize:   cfg.Outbox.BatchSize,
+		MaxIntentos: cfg.Outbox.MaxIntentos,
+		BackoffBase: cfg.Outbox.BackoffBase,
+		BackoffMax:  cfg.Outbox.BackoffMax,
+		Lease:       cfg.Outbox.Lease,
+	}
+
+	// Bus de eventos de dominio; los sinks externos se entregan desde la bandeja de salida
+	bus := eventbus.New()
+	var sinks []port.EventSink
+	for _, sink := range cfg.Events.Sinks {
+		switch sink {
+		case config.EventSinkLog:
+			sinks = append(sinks, eventbus.NewLogSink())
+		case config.EventSinkSNS:
+			if cfg.Events.SNSTopicARN == "" {
+				log.Fatal("EVENTS_SNS_TOPIC_ARN es requerido para el sink sns")
+			}
+			snsClient, err := config.NewSNSClient(cfg.SNS)
+			if err != nil {
+				log.Fatalf("Error al inicializar cliente SNS: %v", err)
+			}
+			sinks = append(sinks, aws.NewSNSEventSink(snsClient, cfg.Events.SNSTopicARN))
+		default:
+			log.Fatalf("EVENT_SINKS inválido: %s", sink)
+		}
+	}
+	log.Printf("Bus de eventos con sinks: %v", cfg.Events.Sinks)
+
+	alumnoUseCase := usecase.NewAlumnoUseCase(alumnoRepo, documentoRepo, fileStorage, transactor, notificacionRepo, renderer, bus)
+	profesorUseCase := usecase.NewProfesorUseCase(profesorRepo, transactor, bus)
+	sesionUseCase := usecase.NewSesionUseCase(sesionRepo, alumnoRepo, bus)
+	documentoUseCase := usecase.NewDocumentoUseCase(documentoRepo, alumnoRepo, fileStorage)
+	plantillaUseCase := usecase.NewPlantillaUseCase(plantillaRepo, renderer)
+	outboxUseCase := usecase.NewOutboxUseCase(notificacionRepo, notifier, outboxConfig)
+	eventoSalidaUseCase := usecase.NewEventoSalidaUseCase(eventoSalidaRepo, sinks, outboxConfig)
+	webhookUseCase := usecase.NewWebhookUseCase(webhookRepo, webhookEntregaRepo, webhook.NewSender(cfg.Webhook.Timeout), transactor, outboxConfig)
+	jobUseCase := usecase.NewJobUseCase(jobRepo, fileStorage, alumnoUseCase, profesorUseCase, usecase.JobConfig{
+		Lease:       cfg.Jobs.Lease,
+		MaxIntentos: cfg.Jobs.MaxIntentos,
+	})
+
+	// Suscriptores en proceso: corren en la transacción del cambio
+	eventbus.On(bus, outboxUseCase.OnAlumnoCreated)
+	eventbus.On(bus, outboxUseCase.OnAlumnoUpdated)
+	bus.Subscribe(domain.EventoTodos, webhookUseCase.Handle)
+	bus.Subscribe(domain.EventoTodos, eventoSalidaUseCase.Handle)
 
 	// Inicializar handlers
 	alumnoHandler := handler.NewAlumnoHandler(alumnoUseCase)
 	profesorHandler := handler.NewProfesorHandler(profesorUseCase)
 	sesionHandler := handler.NewSesionHandler(sesionUseCase)
+	documentoHandler := handler.NewDocumentoHandler(documentoUseCase)
+	plantillaHandler := handler.NewPlantillaHandler(plantillaUseCase)
+	notificacionHandler := handler.NewNotificacionHandler(outboxUseCase)
+	webhookHandler := handler.NewWebhookHandler(webhookUseCase)
+	jobHandler := handler.NewJobHandler(jobUseCase)
+
+	// Sesiones de alumno y token de administración para las rutas protegidas
+	authenticator := auth.NewAuthenticator(sesionUseCase, cfg.Auth.AdminToken)
+	if cfg.Auth.AdminToken == "" {
+		log.Println("ADMIN_API_TOKEN vacío: las rutas de administración rechazarán todas las peticiones")
+	}
+
+	graphqlHandler := graphql.NewHandler(alumnoUseCase, profesorUseCase, documentoUseCase, sesionUseCase, authenticator)
 
 	// Configurar router
-	router := apphttp.NewRouter(alumnoHandler, profesorHandler, sesionHandler)
+	router := apphttp.NewRouter(alumnoHandler, profesorHandler, sesionHandler, documentoHandler, plantillaHandler, notificacionHandler, webhookHandler, jobHandler, fileHandler, graphqlHandler, middleware.NewIdempotency(idempotenciaRepo, cfg.Idempotency.TTL), middleware.NewDeprecation(cfg.API.AliasDeprecation, cfg.API.AliasSunset), middleware.NewAuth(authenticator), cfg.OpenAPI.ValidateResponses)
 	r := router.Setup()
+	// La prueba de openapi garantiza que todas las rutas estén documentadas;
+	// aquí solo se avisa, una ruta sin documentar no impide arrancar
+	if err := openapi.Check(r); err != nil {
+		log.Printf("Advertencia: %v", err)
+	}
+	operaciones, err := openapi.Routes(r)
+	if err != nil {
+		log.Fatalf("Error al listar las rutas: %v", err)
+	}
+
+	// Despachadores de notificaciones, webhooks y eventos en segundo plano
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	var dispatchers sync.WaitGroup
+	dispatchers.Go(func() {
+		outboxUseCase.Run(dispatcherCtx, cfg.Outbox.Interval)
+	})
+	dispatchers.Go(func() {
+		webhookUseCase.Run(dispatcherCtx, cfg.Outbox.Interval)
+	})
+	dispatchers.Go(func() {
+		eventoSalidaUseCase.Run(dispatcherCtx, cfg.Outbox.Interval)
+	})
+	log.Printf("Despachadores de notificaciones, webhooks y eventos cada %s", cfg.Outbox.Interval)
+	for range cfg.Jobs.Workers {
+		dispatchers.Go(func() {
+			jobUseCase.Run(dispatcherCtx, cfg.Jobs.Interval)
+		})
+	}
+	log.Printf("%d workers de jobs", cfg.Jobs.Workers)
+	dispatchers.Go(func() {
+		purgeIdempotencia(dispatcherCtx, idempotenciaRepo, time.Hour)
+	})
 
 	// Configurar servidor
 	server := &http.Server{
@@ -109,21 +285,32 @@ func main() {
 	go func() {
 		log.Printf("Servidor iniciado en http://localhost:%s", cfg.Server.Port)
 		log.Println("Endpoints disponibles:")
-		log.Println("   GET/POST       /alumnos")
-		log.Println("   GET/PUT/DELETE /alumnos/{id}")
-		log.Println("   POST           /alumnos/{id}/fotoPerfil")
-		log.Println("   POST           /alumnos/{id}/email")
-		log.Println("   POST           /alumnos/{id}/session/login")
-		log.Println("   POST           /alumnos/{id}/session/verify")
-		log.Println("   POST           /alumnos/{id}/session/logout")
-		log.Println("   GET/POST       /profesores")
-		log.Println("   GET/PUT/DELETE /profesores/{id}")
+		for _, op := range operaciones {
+			if !op.Obsoleta {
+				log.Printf("   %-7s %s", op.Metodo, op.Path)
+			}
+		}
+		log.Println("Las rutas sin /v1 siguen disponibles como alias obsoletos")
+		log.Printf("Documentación en http://localhost:%s/docs", cfg.Server.Port)
 
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Fatalf("Error al iniciar servidor: %v", err)
 		}
 	}()
 
+	// Servidor gRPC en su propio puerto, sobre los mismos casos de uso
+	grpcServer := appgrpc.NewServer(alumnoUseCase, profesorUseCase, sesionUseCase, authenticator, cfg.GRPC.Reflection)
+	grpcListener, err := net.Listen("tcp", ":"+cfg.GRPC.Port)
+	if err != nil {
+		log.Fatalf("Error al abrir el puerto gRPC: %v", err)
+	}
+	go func() {
+		log.Printf("Servidor gRPC iniciado en localhost:%s", cfg.GRPC.Port)
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Fatalf("Error al iniciar servidor gRPC: %v", err)
+		}
+	}()
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -138,5 +325,40 @@ func main() {
 		log.Fatalf("Error al apagar servidor: %v", err)
 	}
 
+	// GracefulStop espera a los streams en curso; si no terminan a tiempo se cortan
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+
+	stopDispatcher()
+	dispatchers.Wait()
+
 	log.Println("Servidor apagado correctamente")
 }
+
+// purgeIdempotencia borra periódicamente las respuestas idempotentes expiradas;
+// las claves expiradas ya se reemplazan al reutilizarse, esto solo libera espacio
+func purgeIdempotencia(ctx context.Context, repo port.IdempotenciaRepository, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if n, err := repo.DeleteExpirados(ctx); err != nil {
+			log.Printf("Error al purgar claves de idempotencia: %v", err)
+		} else if n > 0 {
+			log.Printf("%d claves de idempotencia expiradas eliminadas", n)
+		}
+	}
+}
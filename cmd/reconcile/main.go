@@ -0,0 +1,68 @@
+// Comando reconcile: elimina del almacenamiento las fotos de perfil que ya no
+// están referenciadas por ningún alumno.
+//
+//	go run ./cmd/reconcile -dry-run
+//	go run ./cmd/reconcile -min-age 24h
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/local"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/s3"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/config"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/usecase"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "solo listar los archivos huérfanos sin borrarlos")
+	minAge := flag.Duration("min-age", time.Hour, "ignorar archivos modificados más recientemente que esto")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Error al cargar configuración: %v", err)
+	}
+
+	db, err := config.NewDatabaseConnection(cfg.Database)
+	if err != nil {
+		log.Fatalf("Error al conectar a la base de datos: %v", err)
+	}
+
+	alumnoRepo := relational.NewAlumnoRepository(db)
+
+	var fileStorage port.FileStorage
+	switch cfg.Storage.Driver {
+	case config.StorageDriverLocal:
+		// Las URLs no se usan aquí, así que la clave de firma es irrelevante
+		fileStorage = local.NewFileStorage(cfg.Storage.Local.RootDir, cfg.Storage.Local.BaseURL, nil, cfg.Storage.Local.URLTTL)
+	default:
+		s3Client, err := config.NewS3Client(cfg.S3)
+		if err != nil {
+			log.Fatalf("Error al crear cliente S3: %v", err)
+		}
+		fileStorage = s3.NewFileStorage(s3Client, cfg.S3.BucketName, cfg.S3.PresignTTL)
+	}
+
+	alumnoUseCase := usecase.NewAlumnoUseCase(alumnoRepo, relational.NewDocumentoRepository(db), fileStorage, storage.NewTransactor(db), nil, nil, nil)
+
+	orphans, err := alumnoUseCase.ReconcileFotosPerfil(context.Background(), *minAge, *dryRun)
+	for _, key := range orphans {
+		if *dryRun {
+			log.Printf("Huérfano: %s", key)
+		} else {
+			log.Printf("Eliminado: %s", key)
+		}
+	}
+	if err != nil {
+		log.Fatalf("Error al reconciliar fotos: %v", err)
+	}
+
+	log.Printf("Reconciliación terminada: %d archivos huérfanos", len(orphans))
+}
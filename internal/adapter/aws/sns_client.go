@@ -2,16 +2,35 @@ package aws
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+// Todo pasa por el topic compartido. Los anuncios llevan {"tipo": "anuncio"} y
+// los mensajes individuales {"tipo": "envio", "destinatario": hash del email};
+// cada suscripción del topic debe filtrar por uno de los dos (Subscribe ya
+// crea la del alumno con su filtro), o recibiría los mensajes de todos
+const (
+	attrTipo         = "tipo"
+	attrDestinatario = "destinatario"
+	tipoAnuncio      = "anuncio"
+	tipoEnvio        = "envio"
 )
 
 // Notifier interface para envío de notificaciones
 type Notifier interface {
 	Publish(ctx context.Context, subject, message string) error
+	Send(ctx context.Context, to domain.Destinatario, mensaje domain.Mensaje) error
+	Subscribe(ctx context.Context, to domain.Destinatario) error
 }
 
 // SNSClient implementación real de SNS
@@ -32,6 +51,9 @@ func (s *SNSClient) Publish(ctx context.Context, subject, message string) error
 		TopicArn: aws.String(s.topicARN),
 		Subject:  aws.String(subject),
 		Message:  aws.String(message),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			attrTipo: stringAttribute(tipoAnuncio),
+		},
 	})
 	if err != nil {
 		return fmt.Errorf("error al publicar en SNS: %w", err)
@@ -40,6 +62,88 @@ func (s *SNSClient) Publish(ctx context.Context, subject, message string) error
 	return nil
 }
 
+// Send publica en el topic compartido con el destinatario como atributo (solo
+// la suscripción del alumno lo deja pasar) y, si hay teléfono, envía un SMS
+// directo. El outbox encola una notificación por canal, así que normalmente
+// llega solo uno de los dos. SNS solo entrega texto plano, así que se ignora
+// mensaje.HTML. Los logs no incluyen el email ni el teléfono
+func (s *SNSClient) Send(ctx context.Context, to domain.Destinatario, mensaje domain.Mensaje) error {
+	if to.Email == "" && to.Telefono == "" {
+		return fmt.Errorf("destinatario sin email ni teléfono")
+	}
+
+	if to.Email != "" {
+		_, err := s.client.Publish(ctx, &sns.PublishInput{
+			TopicArn: aws.String(s.topicARN),
+			Subject:  aws.String(mensaje.Asunto),
+			Message:  aws.String(mensaje.Texto),
+			MessageAttributes: map[string]types.MessageAttributeValue{
+				attrTipo:         stringAttribute(tipoEnvio),
+				attrDestinatario: stringAttribute(hashEmail(to.Email)),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error al publicar en SNS: %w", err)
+		}
+		log.Printf("SNS: Mensaje individual - Asunto: %s", mensaje.Asunto)
+	}
+
+	if to.Telefono != "" {
+		_, err := s.client.Publish(ctx, &sns.PublishInput{
+			PhoneNumber: aws.String(to.Telefono),
+			Message:     aws.String(mensaje.Asunto + "\n\n" + mensaje.Texto),
+		})
+		if err != nil {
+			return fmt.Errorf("error al enviar SMS: %w", err)
+		}
+		log.Printf("SNS: SMS - Asunto: %s", mensaje.Asunto)
+	}
+
+	return nil
+}
+
+// Subscribe suscribe el email al topic compartido con un filtro que solo deja
+// pasar sus mensajes individuales; AWS envía un correo de confirmación que el
+// alumno debe aceptar. Repetirla con el mismo email no crea otra suscripción
+func (s *SNSClient) Subscribe(ctx context.Context, to domain.Destinatario) error {
+	if to.Email == "" {
+		return nil
+	}
+
+	filtro, err := json.Marshal(map[string][]string{attrDestinatario: {hashEmail(to.Email)}})
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Subscribe(ctx, &sns.SubscribeInput{
+		TopicArn: aws.String(s.topicARN),
+		Protocol: aws.String("email"),
+		Endpoint: aws.String(to.Email),
+		Attributes: map[string]string{
+			"FilterPolicy":      string(filtro),
+			"FilterPolicyScope": "MessageAttributes",
+		},
+		ReturnSubscriptionArn: true,
+	})
+	if err != nil {
+		return fmt.Errorf("error al suscribir en SNS: %w", err)
+	}
+	return nil
+}
+
+// hashEmail identifica al destinatario en los atributos del mensaje sin
+// exponer su email a los demás suscriptores del topic
+func hashEmail(email string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(hash[:])
+}
+
+func stringAttribute(value string) types.MessageAttributeValue {
+	return types.MessageAttributeValue{
+		DataType:    aws.String("String"),
+		StringValue: aws.String(value),
+	}
+}
+
 // SNSMock implementación mock de SNS
 type SNSMock struct{}
 
@@ -55,3 +159,18 @@ func (s *SNSMock) Publish(ctx context.Context, subject, message string) error {
 	log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	return nil
 }
+
+func (s *SNSMock) Send(ctx context.Context, to domain.Destinatario, mensaje domain.Mensaje) error {
+	log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	log.Println("Mensaje individual:")
+	log.Printf("   Para: %s %s (%s)", to.Email, to.Telefono, to.Idioma)
+	log.Printf("   Asunto: %s", mensaje.Asunto)
+	log.Printf("   Mensaje: %s", mensaje.Texto)
+	log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	return nil
+}
+
+func (s *SNSMock) Subscribe(ctx context.Context, to domain.Destinatario) error {
+	log.Printf("SNS Mock: suscripción de %s", to.Email)
+	return nil
+}
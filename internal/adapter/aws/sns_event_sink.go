@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+// Atributos de los eventos publicados. Una cola SQS suscrita al topic puede
+// filtrar con {"evento": ["alumno.creado"]} o {"entidad": ["profesor"]}
+const (
+	attrEvento  = "evento"
+	attrEntidad = "entidad"
+	attrID      = "id"
+)
+
+// SNSEventSink - Publica los eventos de dominio como JSON en un topic de SNS
+type SNSEventSink struct {
+	client   *sns.Client
+	topicARN string
+}
+
+func NewSNSEventSink(client *sns.Client, topicARN string) *SNSEventSink {
+	return &SNSEventSink{
+		client:   client,
+		topicARN: topicARN,
+	}
+}
+
+func (s *SNSEventSink) Nombre() string { return "sns" }
+
+// Handle publica el EventoPublicado tal como se serializó al encolarlo
+func (s *SNSEventSink) Handle(ctx context.Context, evento *domain.EventoSalida) error {
+	entidad, _, _ := strings.Cut(evento.Evento, ".")
+	_, err := s.client.Publish(ctx, &sns.PublishInput{
+		TopicArn: aws.String(s.topicARN),
+		Message:  aws.String(evento.Payload),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			attrEvento:  stringAttribute(evento.Evento),
+			attrEntidad: stringAttribute(entidad),
+			attrID:      stringAttribute(evento.EventoID),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error al publicar evento en SNS: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Handler - Suscriptor en proceso
+type Handler func(ctx context.Context, evento domain.EventoPublicado) error
+
+// Bus - Bus de eventos de dominio. Los suscriptores en proceso corren dentro del
+// ctx del publicador (misma transacción: si fallan, el cambio se revierte). Los
+// sinks externos no se llaman desde aquí: un suscriptor (usecase.EventoSalidaUseCase)
+// los encola en la bandeja de salida y se entregan con reintentos
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler // por nombre de evento; domain.EventoTodos para todos
+}
+
+func New() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe registra handler para el evento nombre (o domain.EventoTodos)
+func (b *Bus) Subscribe(nombre string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[nombre] = append(b.handlers[nombre], handler)
+}
+
+// On registra un suscriptor tipado: eventbus.On(bus, func(ctx, e domain.AlumnoCreated) error {...}).
+// Acepta el evento publicado por valor o como puntero; otro tipo con el mismo
+// nombre es un error del publicador y se devuelve como tal
+func On[E domain.Evento](b *Bus, handler func(ctx context.Context, evento E) error) {
+	var zero E
+	b.Subscribe(zero.Nombre(), func(ctx context.Context, evento domain.EventoPublicado) error {
+		if e, ok := evento.Evento.(E); ok {
+			return handler(ctx, e)
+		}
+		if e, ok := any(evento.Evento).(*E); ok && e != nil {
+			return handler(ctx, *e)
+		}
+		return fmt.Errorf("evento %s: se esperaba %T, se publicó %T", evento.Nombre, zero, evento.Evento)
+	})
+}
+
+func (b *Bus) Publish(ctx context.Context, eventos ...domain.Evento) error {
+	for _, evento := range eventos {
+		publicado := domain.EventoPublicado{
+			ID:     uuid.NewString(),
+			Nombre: evento.Nombre(),
+			Fecha:  time.Now().UTC(),
+			Evento: evento,
+		}
+
+		for _, handler := range b.handlersFor(publicado.Nombre) {
+			if err := handler(ctx, publicado); err != nil {
+				return fmt.Errorf("suscriptor de %s: %w", publicado.Nombre, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (b *Bus) handlersFor(nombre string) []Handler {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	handlers := make([]Handler, 0, len(b.handlers[nombre])+len(b.handlers[domain.EventoTodos]))
+	handlers = append(handlers, b.handlers[nombre]...)
+	return append(handlers, b.handlers[domain.EventoTodos]...)
+}
@@ -0,0 +1,39 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+)
+
+func TestOnAceptaValorYPuntero(t *testing.T) {
+	bus := New()
+	var recibidos []string
+	On(bus, func(ctx context.Context, e domain.SesionStarted) error {
+		recibidos = append(recibidos, e.SesionID)
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), domain.SesionStarted{SesionID: "valor"}, &domain.SesionStarted{SesionID: "puntero"})
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if len(recibidos) != 2 || recibidos[0] != "valor" || recibidos[1] != "puntero" {
+		t.Fatalf("recibidos = %v", recibidos)
+	}
+}
+
+// sesionFalsa usa el nombre de SesionStarted con otro tipo
+type sesionFalsa struct{}
+
+func (sesionFalsa) Nombre() string { return domain.EventoSesionIniciada }
+
+func TestOnTipoInesperadoDevuelveError(t *testing.T) {
+	bus := New()
+	On(bus, func(ctx context.Context, e domain.SesionStarted) error { return nil })
+
+	if err := bus.Publish(context.Background(), sesionFalsa{}); err == nil {
+		t.Fatal("Publish con un tipo distinto al del suscriptor debería fallar")
+	}
+}
@@ -0,0 +1,23 @@
+package eventbus
+
+import (
+	"context"
+	"log"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+)
+
+// LogSink - Registra el nombre y el ID de cada evento; no escribe el payload,
+// que lleva datos personales
+type LogSink struct{}
+
+func NewLogSink() *LogSink {
+	return &LogSink{}
+}
+
+func (s *LogSink) Nombre() string { return "log" }
+
+func (s *LogSink) Handle(ctx context.Context, evento *domain.EventoSalida) error {
+	log.Printf("Evento %s (%s)", evento.Evento, evento.EventoID)
+	return nil
+}
@@ -0,0 +1,72 @@
+package graphql
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+// resolverError - Error de un resolver con el mismo code, status y errores de
+// campo que el problem+json de REST, en el idioma de la petición
+type resolverError struct {
+	problem problem.Problem
+}
+
+func (e *resolverError) Error() string {
+	return e.problem.Detail
+}
+
+func (e *resolverError) Extensions() map[string]any {
+	extensions := map[string]any{
+		"code":   e.problem.Code,
+		"status": e.problem.Status,
+	}
+	if len(e.problem.Errors) > 0 {
+		extensions["errors"] = e.problem.Errors
+	}
+	return extensions
+}
+
+// toError traduce err como lo haría problem.Error; los 500 se registran en el log
+func toError(ctx context.Context, operacion string, err error) error {
+	p := problem.From(err)
+	if p.Status == http.StatusInternalServerError {
+		log.Printf("Error en GraphQL %s: %v", operacion, err)
+	}
+	return &resolverError{problem: problem.Localize(p, i18n.FromContext(ctx))}
+}
+
+// verDocumentos exige que la petición sea del alumno dueño o del administrador,
+// como en las rutas REST de documentos
+func verDocumentos(ctx context.Context, alumnoID uint) error {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return apperrors.ErrUnauthorized
+	}
+	if !principal.PuedeActuarSobre(alumnoID) {
+		return apperrors.ErrForbidden
+	}
+	return nil
+}
+
+// parseID convierte un ID de GraphQL en el ID numérico de los servicios
+func parseID(field string, id graphqlgo.ID) (uint, error) {
+	n, err := strconv.ParseUint(string(id), 10, 32)
+	if err != nil || n == 0 {
+		errs := &apperrors.ValidationErrors{}
+		errs.Add(field, i18n.MsgTipoInvalido, field, "ID")
+		return 0, errs
+	}
+	return uint(n), nil
+}
+
+func formatID(id uint) graphqlgo.ID {
+	return graphqlgo.ID(strconv.FormatUint(uint64(id), 10))
+}
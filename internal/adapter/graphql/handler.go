@@ -0,0 +1,84 @@
+// Package graphql expone los servicios de port en POST /graphql. Las
+// relaciones (documentos de un alumno, alumno de un documento) se cargan por
+// lotes con dataloaders por petición para no consultar una vez por elemento
+package graphql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// maxDepth evita consultas que recorren alumno -> documentos -> alumno sin fin
+const maxDepth = 8
+
+// Request - Cuerpo de POST /graphql
+type Request struct {
+	Query         string         `json:"query" openapi:"required,minLength=1"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type Handler struct {
+	schema        *graphqlgo.Schema
+	alumnos       port.AlumnoService
+	documentos    port.DocumentoService
+	authenticator *auth.Authenticator
+}
+
+func NewHandler(
+	alumnos port.AlumnoService,
+	profesores port.ProfesorService,
+	documentos port.DocumentoService,
+	sesiones port.SesionService,
+	authenticator *auth.Authenticator,
+) *Handler {
+	resolver := &resolver{
+		alumnos:    alumnos,
+		profesores: profesores,
+		documentos: documentos,
+		sesiones:   sesiones,
+	}
+	return &Handler{
+		schema:        graphqlgo.MustParseSchema(schema, resolver, graphqlgo.MaxDepth(maxDepth)),
+		alumnos:       alumnos,
+		documentos:    documentos,
+		authenticator: authenticator,
+	}
+}
+
+// ServeHTTP ejecuta la operación. Los errores de los resolvers van en errors
+// con el code y el status que tendrían en REST; la respuesta siempre es 200
+// salvo que el cuerpo no se pueda leer o las credenciales enviadas no sean
+// válidas. Sin credenciales la petición es anónima y los documentos no se ven
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		principal, err := h.authenticator.Authenticate(ctx, r.Header.Get(auth.HeaderAlumnoID), authorization)
+		if err != nil {
+			problem.Write(w, r, problem.New(http.StatusUnauthorized, problem.CodeInvalidSession, i18n.ErrorMsg(problem.CodeInvalidSession)))
+			return
+		}
+		ctx = auth.WithPrincipal(ctx, principal)
+	}
+
+	ctx = withLoaders(ctx, newLoaders(h.alumnos, h.documentos))
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	utils.JSON(w, http.StatusOK, response)
+}
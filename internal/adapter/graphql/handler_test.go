@@ -0,0 +1,174 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/local"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/usecase"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+// contador cuenta las consultas de documentos por lote
+type contador struct {
+	port.DocumentoRepository
+	lotes int
+}
+
+func (c *contador) GetByAlumnos(ctx context.Context, alumnoIDs []uint) ([]domain.Documento, error) {
+	c.lotes++
+	return c.DocumentoRepository.GetByAlumnos(ctx, alumnoIDs)
+}
+
+// sesiones acepta "Bearer sesion-{id}" como la sesión del alumno id
+type sesiones struct{}
+
+func (sesiones) Verify(ctx context.Context, alumnoID uint, sessionString string) error {
+	if sessionString != fmt.Sprintf("sesion-%d", alumnoID) {
+		return apperrors.ErrInvalidSession
+	}
+	return nil
+}
+
+// nuevoHandler arma el handler sobre SQLite con tres alumnos de dos documentos cada uno
+func nuevoHandler(t *testing.T) (*Handler, *contador) {
+	t.Helper()
+	ctx := context.Background()
+	db := storagetest.NewSQLite(t)
+	alumnoRepo := relational.NewAlumnoRepository(db)
+	documentoRepo := &contador{DocumentoRepository: relational.NewDocumentoRepository(db)}
+	fileStorage := local.NewFileStorage(t.TempDir(), "http://localhost", []byte("clave"), time.Minute)
+
+	for i := 1; i <= 3; i++ {
+		alumno := &domain.Alumno{Nombres: "Ana", Apellidos: "García", Matricula: fmt.Sprintf("A00%d", i), Password: "x"}
+		if err := alumnoRepo.Create(ctx, alumno); err != nil {
+			t.Fatal(err)
+		}
+		for _, tipo := range []string{"kardex", "acta_nacimiento"} {
+			documento := &domain.Documento{AlumnoID: alumno.ID, Tipo: tipo, NombreArchivo: tipo + ".pdf", ContentType: "application/pdf", Checksum: "x", Key: "k", SubidoPor: "admin"}
+			if err := documentoRepo.Create(ctx, documento); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	alumnos := usecase.NewAlumnoUseCase(alumnoRepo, documentoRepo, fileStorage, storage.NewTransactor(db), relational.NewNotificacionRepository(db), nil, nil)
+	documentos := usecase.NewDocumentoUseCase(documentoRepo, alumnoRepo, fileStorage)
+	return NewHandler(alumnos, nil, documentos, nil, auth.NewAuthenticator(sesiones{}, "admin")), documentoRepo
+}
+
+type respuesta struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string `json:"message"`
+		Path       []any  `json:"path"`
+		Extensions struct {
+			Code   string `json:"code"`
+			Status int    `json:"status"`
+		} `json:"extensions"`
+	} `json:"errors"`
+}
+
+// consultar ejecuta query como el alumno indicado (0 es anónimo, -1 el administrador)
+func consultar(t *testing.T, h http.Handler, alumnoID int, query string) respuesta {
+	t.Helper()
+	body, err := json.Marshal(Request{Query: query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	switch {
+	case alumnoID < 0:
+		r.Header.Set("Authorization", "Bearer admin")
+	case alumnoID > 0:
+		r.Header.Set("Authorization", fmt.Sprintf("Bearer sesion-%d", alumnoID))
+		r.Header.Set(auth.HeaderAlumnoID, fmt.Sprint(alumnoID))
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", w.Code, w.Body)
+	}
+	var resp respuesta
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+// Los documentos de todos los alumnos de una lista salen de una sola consulta
+func TestAlumnosDocumentosEnUnLote(t *testing.T) {
+	h, repo := nuevoHandler(t)
+
+	resp := consultar(t, h, -1, `{ alumnos { id documentos { id tipo } } }`)
+	if len(resp.Errors) > 0 {
+		t.Fatalf("errores: %+v", resp.Errors)
+	}
+	var alumnos []struct {
+		ID         string
+		Documentos []struct{ ID string }
+	}
+	if err := json.Unmarshal(resp.Data["alumnos"], &alumnos); err != nil {
+		t.Fatal(err)
+	}
+	if len(alumnos) != 3 {
+		t.Fatalf("alumnos = %d, se esperaban 3", len(alumnos))
+	}
+	for _, alumno := range alumnos {
+		if len(alumno.Documentos) != 2 {
+			t.Errorf("el alumno %s tiene %d documentos, se esperaban 2", alumno.ID, len(alumno.Documentos))
+		}
+	}
+	if repo.lotes != 1 {
+		t.Errorf("GetByAlumnos se llamó %d veces, se esperaba 1", repo.lotes)
+	}
+}
+
+// documento y documentos responden como las rutas REST: 401 sin credenciales,
+// 403 para otro alumno, con code y status en extensions
+func TestDocumentosDeOtroAlumno(t *testing.T) {
+	h, _ := nuevoHandler(t)
+
+	for _, tc := range []struct {
+		nombre   string
+		alumnoID int
+		query    string
+		code     string
+		status   int
+	}{
+		{"anónimo", 0, `{ documento(alumnoId: "1", id: "1") { id } }`, "unauthorized", http.StatusUnauthorized},
+		{"otro alumno", 2, `{ documento(alumnoId: "1", id: "1") { id } }`, "forbidden", http.StatusForbidden},
+		{"lista de otro alumno", 2, `{ alumno(id: "1") { id documentos { id } } }`, "forbidden", http.StatusForbidden},
+		{"ID inválido", -1, `{ documento(alumnoId: "abc", id: "1") { id } }`, "validation_failed", http.StatusBadRequest},
+	} {
+		resp := consultar(t, h, tc.alumnoID, tc.query)
+		if len(resp.Errors) != 1 {
+			t.Errorf("%s: errores = %+v", tc.nombre, resp.Errors)
+			continue
+		}
+		if got := resp.Errors[0].Extensions; got.Code != tc.code || got.Status != tc.status {
+			t.Errorf("%s: extensions = %+v, se esperaba %s %d", tc.nombre, got, tc.code, tc.status)
+		}
+		if resp.Errors[0].Message == "" {
+			t.Errorf("%s: error sin mensaje", tc.nombre)
+		}
+	}
+
+	// El dueño sí lo ve
+	resp := consultar(t, h, 1, `{ documento(alumnoId: "1", id: "1") { id tipo } alumno(id: "1") { documentos { id } } }`)
+	if len(resp.Errors) > 0 || !strings.Contains(string(resp.Data["documento"]), `"id":"1"`) {
+		t.Errorf("dueño: data=%s errores=%+v", resp.Data["documento"], resp.Errors)
+	}
+}
@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+// loaderWait - Tiempo que un loader junta llaves antes de consultar. Los
+// campos de una lista se resuelven en paralelo, así que basta con poco
+const loaderWait = time.Millisecond
+
+type loadersKey struct{}
+
+// loaders - Cargadores de una petición; su caché dura lo que la petición
+type loaders struct {
+	alumnos    *dataloader.Loader[uint, *domain.Alumno]
+	documentos *dataloader.Loader[uint, []domain.Documento]
+}
+
+func newLoaders(alumnos port.AlumnoService, documentos port.DocumentoService) *loaders {
+	return &loaders{
+		alumnos: dataloader.NewBatchedLoader(
+			func(ctx context.Context, ids []uint) []*dataloader.Result[*domain.Alumno] {
+				encontrados, err := alumnos.GetByIDs(ctx, ids)
+				porID := make(map[uint]*domain.Alumno, len(encontrados))
+				for i := range encontrados {
+					porID[encontrados[i].ID] = &encontrados[i]
+				}
+				// Un alumno que no existe se resuelve como null
+				results := make([]*dataloader.Result[*domain.Alumno], len(ids))
+				for i, id := range ids {
+					results[i] = &dataloader.Result[*domain.Alumno]{Data: porID[id], Error: err}
+				}
+				return results
+			},
+			dataloader.WithWait[uint, *domain.Alumno](loaderWait),
+		),
+		documentos: dataloader.NewBatchedLoader(
+			func(ctx context.Context, alumnoIDs []uint) []*dataloader.Result[[]domain.Documento] {
+				encontrados, err := documentos.GetByAlumnos(ctx, alumnoIDs)
+				porAlumno := make(map[uint][]domain.Documento, len(alumnoIDs))
+				for _, documento := range encontrados {
+					porAlumno[documento.AlumnoID] = append(porAlumno[documento.AlumnoID], documento)
+				}
+				results := make([]*dataloader.Result[[]domain.Documento], len(alumnoIDs))
+				for i, id := range alumnoIDs {
+					results[i] = &dataloader.Result[[]domain.Documento]{Data: porAlumno[id], Error: err}
+				}
+				return results
+			},
+			dataloader.WithWait[uint, []domain.Documento](loaderWait),
+		),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,267 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+// resolver - Raíz de Query y Mutation
+type resolver struct {
+	alumnos    port.AlumnoService
+	profesores port.ProfesorService
+	documentos port.DocumentoService
+	sesiones   port.SesionService
+}
+
+type alumnoInput struct {
+	Nombres   string
+	Apellidos string
+	Matricula string
+	Promedio  float64
+	Email     *string
+	Telefono  *string
+	Idioma    *string
+	Password  *string
+}
+
+func (in alumnoInput) domain() domain.Alumno {
+	return domain.Alumno{
+		Nombres:   in.Nombres,
+		Apellidos: in.Apellidos,
+		Matricula: in.Matricula,
+		Promedio:  in.Promedio,
+		Email:     valor(in.Email),
+		Telefono:  valor(in.Telefono),
+		Idioma:    valor(in.Idioma),
+		Password:  valor(in.Password),
+	}
+}
+
+type profesorInput struct {
+	NumeroEmpleado int32
+	Nombres        string
+	Apellidos      string
+	HorasClase     int32
+}
+
+func (in profesorInput) domain() domain.Profesor {
+	return domain.Profesor{
+		NumeroEmpleado: int(in.NumeroEmpleado),
+		Nombres:        in.Nombres,
+		Apellidos:      in.Apellidos,
+		HorasClase:     int(in.HorasClase),
+	}
+}
+
+// Consultas. Las de un solo elemento devuelven null si no existe
+
+func (r *resolver) Alumnos(ctx context.Context) ([]*alumnoResolver, error) {
+	alumnos, err := r.alumnos.GetAll(ctx)
+	if err != nil {
+		return nil, toError(ctx, "alumnos", err)
+	}
+	resolvers := make([]*alumnoResolver, len(alumnos))
+	for i := range alumnos {
+		resolvers[i] = &alumnoResolver{alumno: &alumnos[i]}
+	}
+	return resolvers, nil
+}
+
+// Alumno usa el loader para que varios alumno(id) de una misma consulta se
+// resuelvan con una sola lectura
+func (r *resolver) Alumno(ctx context.Context, args struct{ ID graphqlgo.ID }) (*alumnoResolver, error) {
+	id, err := parseID("id", args.ID)
+	if err != nil {
+		return nil, toError(ctx, "alumno", err)
+	}
+	return loadAlumno(ctx, id)
+}
+
+func (r *resolver) Profesores(ctx context.Context) ([]*profesorResolver, error) {
+	profesores, err := r.profesores.GetAll(ctx)
+	if err != nil {
+		return nil, toError(ctx, "profesores", err)
+	}
+	resolvers := make([]*profesorResolver, len(profesores))
+	for i := range profesores {
+		resolvers[i] = &profesorResolver{profesor: &profesores[i]}
+	}
+	return resolvers, nil
+}
+
+func (r *resolver) Profesor(ctx context.Context, args struct{ ID graphqlgo.ID }) (*profesorResolver, error) {
+	id, err := parseID("id", args.ID)
+	if err != nil {
+		return nil, toError(ctx, "profesor", err)
+	}
+	profesor, err := r.profesores.GetByID(ctx, id)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, toError(ctx, "profesor", err)
+	}
+	return &profesorResolver{profesor: profesor}, nil
+}
+
+func (r *resolver) Documento(ctx context.Context, args struct {
+	AlumnoID graphqlgo.ID
+	ID       graphqlgo.ID
+}) (*documentoResolver, error) {
+	alumnoID, err := parseID("alumnoId", args.AlumnoID)
+	if err != nil {
+		return nil, toError(ctx, "documento", err)
+	}
+	if err := verDocumentos(ctx, alumnoID); err != nil {
+		return nil, toError(ctx, "documento", err)
+	}
+	id, err := parseID("id", args.ID)
+	if err != nil {
+		return nil, toError(ctx, "documento", err)
+	}
+	documento, err := r.documentos.GetByID(ctx, alumnoID, id)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, toError(ctx, "documento", err)
+	}
+	return &documentoResolver{documento: documento}, nil
+}
+
+// Mutaciones
+
+func (r *resolver) CrearAlumno(ctx context.Context, args struct{ Input alumnoInput }) (*alumnoResolver, error) {
+	alumno := args.Input.domain()
+	if err := r.alumnos.Create(ctx, &alumno); err != nil {
+		return nil, toError(ctx, "crearAlumno", err)
+	}
+	return &alumnoResolver{alumno: &alumno}, nil
+}
+
+func (r *resolver) ActualizarAlumno(ctx context.Context, args struct {
+	ID    graphqlgo.ID
+	Input alumnoInput
+}) (*alumnoResolver, error) {
+	id, err := parseID("id", args.ID)
+	if err != nil {
+		return nil, toError(ctx, "actualizarAlumno", err)
+	}
+	alumno := args.Input.domain()
+	if err := r.alumnos.Update(ctx, id, &alumno); err != nil {
+		return nil, toError(ctx, "actualizarAlumno", err)
+	}
+	loadersFrom(ctx).alumnos.Clear(ctx, id)
+
+	actualizado, err := r.alumnos.GetByID(ctx, id)
+	if err != nil {
+		return nil, toError(ctx, "actualizarAlumno", err)
+	}
+	return &alumnoResolver{alumno: actualizado}, nil
+}
+
+func (r *resolver) EliminarAlumno(ctx context.Context, args struct{ ID graphqlgo.ID }) (graphqlgo.ID, error) {
+	id, err := parseID("id", args.ID)
+	if err != nil {
+		return "", toError(ctx, "eliminarAlumno", err)
+	}
+	if err := r.alumnos.Delete(ctx, id); err != nil {
+		return "", toError(ctx, "eliminarAlumno", err)
+	}
+	loadersFrom(ctx).alumnos.Clear(ctx, id)
+	return args.ID, nil
+}
+
+func (r *resolver) CrearProfesor(ctx context.Context, args struct{ Input profesorInput }) (*profesorResolver, error) {
+	profesor := args.Input.domain()
+	if err := r.profesores.Create(ctx, &profesor); err != nil {
+		return nil, toError(ctx, "crearProfesor", err)
+	}
+	return &profesorResolver{profesor: &profesor}, nil
+}
+
+func (r *resolver) ActualizarProfesor(ctx context.Context, args struct {
+	ID    graphqlgo.ID
+	Input profesorInput
+}) (*profesorResolver, error) {
+	id, err := parseID("id", args.ID)
+	if err != nil {
+		return nil, toError(ctx, "actualizarProfesor", err)
+	}
+	profesor := args.Input.domain()
+	if err := r.profesores.Update(ctx, id, &profesor); err != nil {
+		return nil, toError(ctx, "actualizarProfesor", err)
+	}
+
+	actualizado, err := r.profesores.GetByID(ctx, id)
+	if err != nil {
+		return nil, toError(ctx, "actualizarProfesor", err)
+	}
+	return &profesorResolver{profesor: actualizado}, nil
+}
+
+func (r *resolver) EliminarProfesor(ctx context.Context, args struct{ ID graphqlgo.ID }) (graphqlgo.ID, error) {
+	id, err := parseID("id", args.ID)
+	if err != nil {
+		return "", toError(ctx, "eliminarProfesor", err)
+	}
+	if err := r.profesores.Delete(ctx, id); err != nil {
+		return "", toError(ctx, "eliminarProfesor", err)
+	}
+	return args.ID, nil
+}
+
+func (r *resolver) Login(ctx context.Context, args struct {
+	AlumnoID graphqlgo.ID
+	Password string
+}) (*sesionResolver, error) {
+	alumnoID, err := parseID("alumnoId", args.AlumnoID)
+	if err != nil {
+		return nil, toError(ctx, "login", err)
+	}
+	sesion, err := r.sesiones.Login(ctx, alumnoID, args.Password)
+	if err != nil {
+		return nil, toError(ctx, "login", err)
+	}
+	return &sesionResolver{sesion: sesion}, nil
+}
+
+func (r *resolver) VerificarSesion(ctx context.Context, args struct {
+	AlumnoID      graphqlgo.ID
+	SessionString string
+}) (bool, error) {
+	alumnoID, err := parseID("alumnoId", args.AlumnoID)
+	if err != nil {
+		return false, toError(ctx, "verificarSesion", err)
+	}
+	if err := r.sesiones.Verify(ctx, alumnoID, args.SessionString); err != nil {
+		return false, toError(ctx, "verificarSesion", err)
+	}
+	return true, nil
+}
+
+func (r *resolver) Logout(ctx context.Context, args struct {
+	AlumnoID      graphqlgo.ID
+	SessionString string
+}) (bool, error) {
+	alumnoID, err := parseID("alumnoId", args.AlumnoID)
+	if err != nil {
+		return false, toError(ctx, "logout", err)
+	}
+	if err := r.sesiones.Logout(ctx, alumnoID, args.SessionString); err != nil {
+		return false, toError(ctx, "logout", err)
+	}
+	return true, nil
+}
+
+func valor(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,111 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+type alumnoResolver struct {
+	alumno *domain.Alumno
+}
+
+// loadAlumno resuelve un alumno por ID con el loader de la petición; nil si no existe
+func loadAlumno(ctx context.Context, id uint) (*alumnoResolver, error) {
+	alumno, err := loadersFrom(ctx).alumnos.Load(ctx, id)()
+	if err != nil {
+		return nil, toError(ctx, "alumno", err)
+	}
+	if alumno == nil {
+		return nil, nil
+	}
+	return &alumnoResolver{alumno: alumno}, nil
+}
+
+func (r *alumnoResolver) ID() graphqlgo.ID       { return formatID(r.alumno.ID) }
+func (r *alumnoResolver) Nombres() string        { return r.alumno.Nombres }
+func (r *alumnoResolver) Apellidos() string      { return r.alumno.Apellidos }
+func (r *alumnoResolver) Matricula() string      { return r.alumno.Matricula }
+func (r *alumnoResolver) Promedio() float64      { return r.alumno.Promedio }
+func (r *alumnoResolver) Email() *string         { return opcional(r.alumno.Email) }
+func (r *alumnoResolver) Telefono() *string      { return opcional(r.alumno.Telefono) }
+func (r *alumnoResolver) Idioma() *string        { return opcional(r.alumno.Idioma) }
+func (r *alumnoResolver) FotoPerfilUrl() *string { return opcional(r.alumno.FotoPerfilUrl) }
+
+// Documentos junta los alumnos de una lista en una sola consulta; solo los ve
+// el alumno dueño o el administrador (para el resto es null con error)
+func (r *alumnoResolver) Documentos(ctx context.Context) (*[]*documentoResolver, error) {
+	if err := verDocumentos(ctx, r.alumno.ID); err != nil {
+		return nil, toError(ctx, "documentos", err)
+	}
+	documentos, err := loadersFrom(ctx).documentos.Load(ctx, r.alumno.ID)()
+	if err != nil {
+		return nil, toError(ctx, "documentos", err)
+	}
+	resolvers := make([]*documentoResolver, len(documentos))
+	for i := range documentos {
+		resolvers[i] = &documentoResolver{documento: &documentos[i]}
+	}
+	return &resolvers, nil
+}
+
+type documentoResolver struct {
+	documento *domain.Documento
+}
+
+func (r *documentoResolver) ID() graphqlgo.ID      { return formatID(r.documento.ID) }
+func (r *documentoResolver) Tipo() string          { return r.documento.Tipo }
+func (r *documentoResolver) Estado() string        { return r.documento.Estado }
+func (r *documentoResolver) NombreArchivo() string { return r.documento.NombreArchivo }
+func (r *documentoResolver) ContentType() string   { return r.documento.ContentType }
+func (r *documentoResolver) Size() int32           { return int32(r.documento.Size) }
+func (r *documentoResolver) Checksum() string      { return r.documento.Checksum }
+func (r *documentoResolver) SubidoPor() string     { return r.documento.SubidoPor }
+func (r *documentoResolver) RevisadoPor() *string  { return opcional(r.documento.RevisadoPor) }
+func (r *documentoResolver) Comentario() *string   { return opcional(r.documento.Comentario) }
+func (r *documentoResolver) CreatedAt() string     { return r.documento.CreatedAt.Format(time.RFC3339) }
+
+func (r *documentoResolver) RevisadoEn() *string {
+	if r.documento.RevisadoEn == nil {
+		return nil
+	}
+	return opcional(r.documento.RevisadoEn.Format(time.RFC3339))
+}
+
+func (r *documentoResolver) Alumno(ctx context.Context) (*alumnoResolver, error) {
+	return loadAlumno(ctx, r.documento.AlumnoID)
+}
+
+type profesorResolver struct {
+	profesor *domain.Profesor
+}
+
+func (r *profesorResolver) ID() graphqlgo.ID      { return formatID(r.profesor.ID) }
+func (r *profesorResolver) NumeroEmpleado() int32 { return int32(r.profesor.NumeroEmpleado) }
+func (r *profesorResolver) Nombres() string       { return r.profesor.Nombres }
+func (r *profesorResolver) Apellidos() string     { return r.profesor.Apellidos }
+func (r *profesorResolver) HorasClase() int32     { return int32(r.profesor.HorasClase) }
+
+type sesionResolver struct {
+	sesion *domain.Sesion
+}
+
+func (r *sesionResolver) ID() graphqlgo.ID      { return graphqlgo.ID(r.sesion.ID) }
+func (r *sesionResolver) SessionString() string { return r.sesion.SessionString }
+
+func (r *sesionResolver) Fecha() string {
+	return time.Unix(r.sesion.Fecha, 0).UTC().Format(time.RFC3339)
+}
+
+func (r *sesionResolver) Alumno(ctx context.Context) (*alumnoResolver, error) {
+	return loadAlumno(ctx, r.sesion.AlumnoID)
+}
+
+func opcional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
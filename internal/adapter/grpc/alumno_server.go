@@ -0,0 +1,231 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pb"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type alumnoServer struct {
+	pb.UnimplementedAlumnoServiceServer
+	service port.AlumnoService
+}
+
+func (s *alumnoServer) ListAlumnos(ctx context.Context, _ *pb.ListAlumnosRequest) (*pb.ListAlumnosResponse, error) {
+	alumnos, err := s.service.GetAll(ctx)
+	if err != nil {
+		return nil, toStatus(ctx, "ListAlumnos", err)
+	}
+	return &pb.ListAlumnosResponse{Alumnos: toAlumnos(alumnos)}, nil
+}
+
+func (s *alumnoServer) GetAlumno(ctx context.Context, req *pb.GetAlumnoRequest) (*pb.Alumno, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "GetAlumno", err)
+	}
+	alumno, err := s.service.GetByID(ctx, id)
+	if err != nil {
+		return nil, toStatus(ctx, "GetAlumno", err)
+	}
+	return toAlumno(alumno), nil
+}
+
+func (s *alumnoServer) BatchGetAlumnos(ctx context.Context, req *pb.BatchGetAlumnosRequest) (*pb.ListAlumnosResponse, error) {
+	ids := make([]uint, len(req.GetIds()))
+	for i, id := range req.GetIds() {
+		ids[i] = uint(id)
+	}
+	alumnos, err := s.service.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, toStatus(ctx, "BatchGetAlumnos", err)
+	}
+	return &pb.ListAlumnosResponse{Alumnos: toAlumnos(alumnos)}, nil
+}
+
+func (s *alumnoServer) CreateAlumno(ctx context.Context, req *pb.CreateAlumnoRequest) (*pb.Alumno, error) {
+	alumno := fromAlumnoInput(req.GetAlumno())
+	if err := s.service.Create(ctx, alumno); err != nil {
+		return nil, toStatus(ctx, "CreateAlumno", err)
+	}
+	return toAlumno(alumno), nil
+}
+
+func (s *alumnoServer) UpdateAlumno(ctx context.Context, req *pb.UpdateAlumnoRequest) (*pb.Alumno, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "UpdateAlumno", err)
+	}
+	if err := s.service.Update(ctx, id, fromAlumnoInput(req.GetAlumno())); err != nil {
+		return nil, toStatus(ctx, "UpdateAlumno", err)
+	}
+
+	actualizado, err := s.service.GetByID(ctx, id)
+	if err != nil {
+		return nil, toStatus(ctx, "UpdateAlumno", err)
+	}
+	return toAlumno(actualizado), nil
+}
+
+func (s *alumnoServer) DeleteAlumno(ctx context.Context, req *pb.DeleteAlumnoRequest) (*emptypb.Empty, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "DeleteAlumno", err)
+	}
+	if err := s.service.Delete(ctx, id); err != nil {
+		return nil, toStatus(ctx, "DeleteAlumno", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *alumnoServer) SendEmail(ctx context.Context, req *pb.SendEmailRequest) (*emptypb.Empty, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "SendEmail", err)
+	}
+	if err := s.service.SendEmail(ctx, id); err != nil {
+		return nil, toStatus(ctx, "SendEmail", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UploadFotoPerfil recibe el ID en el primer mensaje; el tipo real de la imagen
+// se detecta por contenido en el caso de uso
+func (s *alumnoServer) UploadFotoPerfil(stream grpcgo.ClientStreamingServer[pb.UploadFotoPerfilRequest, pb.FotoPerfil]) error {
+	ctx := stream.Context()
+	primero, err := stream.Recv()
+	if err != nil {
+		return toStatus(ctx, "UploadFotoPerfil", err)
+	}
+	id, err := requireID("id", primero.GetId())
+	if err != nil {
+		return toStatus(ctx, "UploadFotoPerfil", err)
+	}
+
+	// El caso de uso lee hasta un byte más del límite para rechazar la foto
+	foto := &chunkReader{max: utils.MaxFotoPerfilSize + 1, recv: func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return msg.GetChunk(), nil
+	}}
+	variantes, err := s.service.UploadFotoPerfil(ctx, id, foto)
+	if err != nil {
+		return toStatus(ctx, "UploadFotoPerfil", err)
+	}
+	return stream.SendAndClose(toFotoPerfil(variantes))
+}
+
+func (s *alumnoServer) CreateFotoPerfilUploadUrl(ctx context.Context, req *pb.CreateFotoPerfilUploadUrlRequest) (*pb.FotoPerfilUpload, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "CreateFotoPerfilUploadUrl", err)
+	}
+	upload, err := s.service.CreateFotoPerfilUploadURL(ctx, id, req.GetContentType(), req.GetSize())
+	if err != nil {
+		return nil, toStatus(ctx, "CreateFotoPerfilUploadUrl", err)
+	}
+	return &pb.FotoPerfilUpload{
+		Key:       upload.Key,
+		UploadUrl: upload.URL,
+		Method:    upload.Method,
+		Headers:   upload.Headers,
+		ExpiresAt: timestamppb.New(upload.ExpiresAt),
+	}, nil
+}
+
+func (s *alumnoServer) ConfirmFotoPerfil(ctx context.Context, req *pb.ConfirmFotoPerfilRequest) (*pb.FotoPerfil, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "ConfirmFotoPerfil", err)
+	}
+	variantes, err := s.service.ConfirmFotoPerfil(ctx, id, req.GetKey())
+	if err != nil {
+		return nil, toStatus(ctx, "ConfirmFotoPerfil", err)
+	}
+	return toFotoPerfil(variantes), nil
+}
+
+func (s *alumnoServer) DeleteFotoPerfil(ctx context.Context, req *pb.DeleteFotoPerfilRequest) (*emptypb.Empty, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "DeleteFotoPerfil", err)
+	}
+	if err := s.service.DeleteFotoPerfil(ctx, id); err != nil {
+		return nil, toStatus(ctx, "DeleteFotoPerfil", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *alumnoServer) ImportAlumnos(stream grpcgo.ClientStreamingServer[pb.ImportRequest, pb.ResultadoImportacion]) error {
+	return recvImport(stream, "ImportAlumnos", s.service.Import)
+}
+
+func (s *alumnoServer) ExportAlumnos(req *pb.ExportRequest, stream grpcgo.ServerStreamingServer[pb.ExportChunk]) error {
+	return sendExport(req, stream, "ExportAlumnos", s.service.Export)
+}
+
+func (s *alumnoServer) BatchAlumnos(ctx context.Context, req *pb.BatchAlumnosRequest) (*pb.ResultadoLote, error) {
+	ops := make([]domain.OperacionLote[domain.Alumno], len(req.GetOperaciones()))
+	for i, op := range req.GetOperaciones() {
+		ops[i] = domain.OperacionLote[domain.Alumno]{Op: op.GetOp(), ID: uint(op.GetId())}
+		if op.GetDatos() != nil {
+			ops[i].Datos = fromAlumnoInput(op.GetDatos())
+		}
+	}
+	resultado, err := s.service.Batch(ctx, req.GetModo(), ops)
+	if err != nil {
+		return nil, toStatus(ctx, "BatchAlumnos", err)
+	}
+	return resultadoLote(ctx, resultado), nil
+}
+
+func fromAlumnoInput(in *pb.AlumnoInput) *domain.Alumno {
+	return &domain.Alumno{
+		Nombres:   in.GetNombres(),
+		Apellidos: in.GetApellidos(),
+		Matricula: in.GetMatricula(),
+		Promedio:  in.GetPromedio(),
+		Email:     in.GetEmail(),
+		Telefono:  in.GetTelefono(),
+		Idioma:    in.GetIdioma(),
+		Password:  in.GetPassword(),
+	}
+}
+
+func toAlumno(alumno *domain.Alumno) *pb.Alumno {
+	return &pb.Alumno{
+		Id:                  uint32(alumno.ID),
+		Nombres:             alumno.Nombres,
+		Apellidos:           alumno.Apellidos,
+		Matricula:           alumno.Matricula,
+		Promedio:            alumno.Promedio,
+		Email:               alumno.Email,
+		Telefono:            alumno.Telefono,
+		Idioma:              alumno.Idioma,
+		FotoPerfilUrl:       alumno.FotoPerfilUrl,
+		FotoPerfilVariantes: alumno.FotoPerfilVariantes,
+	}
+}
+
+func toAlumnos(alumnos []domain.Alumno) []*pb.Alumno {
+	out := make([]*pb.Alumno, len(alumnos))
+	for i := range alumnos {
+		out[i] = toAlumno(&alumnos[i])
+	}
+	return out
+}
+
+func toFotoPerfil(variantes map[string]string) *pb.FotoPerfil {
+	return &pb.FotoPerfil{
+		FotoPerfilUrl:       variantes["original"],
+		FotoPerfilVariantes: variantes,
+	}
+}
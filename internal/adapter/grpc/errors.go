@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pb"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain - Dominio de los ErrorInfo que acompañan a cada status
+const errorDomain = "escolar.v1"
+
+// errArchivoMuyGrande - El archivo recibido en partes superó el límite
+var errArchivoMuyGrande = errors.New("archivo demasiado grande")
+
+// grpcCodes - Código gRPC equivalente a cada code de problem+json
+var grpcCodes = map[string]codes.Code{
+	problem.CodeInvalidInput:         codes.InvalidArgument,
+	problem.CodeValidationFailed:     codes.InvalidArgument,
+	problem.CodeUnsupportedMediaType: codes.InvalidArgument,
+	problem.CodeNotAcceptable:        codes.InvalidArgument,
+	problem.CodeNotFound:             codes.NotFound,
+	problem.CodeUnauthorized:         codes.Unauthenticated,
+	problem.CodeInvalidSession:       codes.Unauthenticated,
+	problem.CodeInvalidCredentials:   codes.Unauthenticated,
+	problem.CodeForbidden:            codes.PermissionDenied,
+	problem.CodeAlreadyExists:        codes.AlreadyExists,
+	problem.CodeConflict:             codes.FailedPrecondition,
+	problem.CodeUnprocessable:        codes.FailedPrecondition,
+	problem.CodePayloadTooLarge:      codes.ResourceExhausted,
+}
+
+// toStatus traduce err como lo haría problem.Error; los internos se registran en el log
+func toStatus(ctx context.Context, metodo string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	p := problem.From(err)
+	if p.Status == http.StatusInternalServerError {
+		log.Printf("Error en gRPC %s: %v", metodo, err)
+	}
+	return statusFrom(ctx, p)
+}
+
+// statusFrom arma el status de p en el idioma de la petición. El code de REST
+// viaja en un ErrorInfo y los errores de campo en un BadRequest
+func statusFrom(ctx context.Context, p problem.Problem) error {
+	p = problem.Localize(p, i18n.FromContext(ctx))
+	code, ok := grpcCodes[p.Code]
+	if !ok {
+		code = codes.Internal
+	}
+
+	st := status.New(code, p.Detail)
+	detalles := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: p.Code, Domain: errorDomain}}
+	if len(p.Errors) > 0 {
+		violaciones := make([]*errdetails.BadRequest_FieldViolation, len(p.Errors))
+		for i, e := range p.Errors {
+			violaciones[i] = &errdetails.BadRequest_FieldViolation{Field: e.Field, Description: e.Message, Reason: e.Code}
+		}
+		detalles = append(detalles, &errdetails.BadRequest{FieldViolations: violaciones})
+	}
+	if conDetalles, err := st.WithDetails(detalles...); err == nil {
+		st = conDetalles
+	}
+	return st.Err()
+}
+
+// invalid equivale a problem.Invalid para las validaciones del propio adaptador
+func invalid(ctx context.Context, mensaje string, params ...any) error {
+	return statusFrom(ctx, problem.New(http.StatusBadRequest, problem.CodeInvalidInput, mensaje, params...))
+}
+
+// requireID valida un ID de la petición; en proto3 el 0 significa que no vino
+func requireID(field string, id uint32) (uint, error) {
+	if id == 0 {
+		errs := &apperrors.ValidationErrors{}
+		errs.Add(field, i18n.MsgCampoRequerido, field)
+		return 0, errs
+	}
+	return uint(id), nil
+}
+
+func validationErrors(errs []apperrors.ValidationError, lang string) []*pb.ValidationError {
+	if len(errs) == 0 {
+		return nil
+	}
+	errs = apperrors.Localize(errs, lang)
+	out := make([]*pb.ValidationError, len(errs))
+	for i, e := range errs {
+		out[i] = &pb.ValidationError{Field: e.Field, Code: e.Code, Message: e.Message}
+	}
+	return out
+}
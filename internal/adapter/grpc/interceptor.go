@@ -0,0 +1,184 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+	"strings"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pb"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// Metadatos de la sesión: el alumno y "authorization: Bearer <sessionString>"
+const (
+	metadataAlumnoID      = "x-alumno-id"
+	metadataAuthorization = "authorization"
+	metadataIdioma        = "accept-language"
+	metadataContentLang   = "content-language"
+)
+
+// publicos - Servicios que no requieren sesión. SesionService recibe las
+// credenciales en la petición y la reflexión solo describe los servicios
+var publicos = map[string]bool{
+	pb.SesionService_ServiceDesc.ServiceName:                   true,
+	reflectionv1.ServerReflection_ServiceDesc.ServiceName:      true,
+	reflectionv1alpha.ServerReflection_ServiceDesc.ServiceName: true,
+}
+
+// acceso - Quién puede llamar a un método; el valor cero es el más restrictivo
+type acceso int
+
+const (
+	accesoAdmin  acceso = iota
+	accesoAlumno        // el alumno del campo id de la petición o el administrador
+	accesoPublico
+)
+
+// accesos - Métodos abiertos a alumnos o sin sesión. CreateAlumno es el
+// registro y queda público como POST /alumnos; los listados, lotes,
+// importaciones, exportaciones y todo ProfesorService son del administrador
+var accesos = map[string]acceso{
+	pb.AlumnoService_CreateAlumno_FullMethodName:              accesoPublico,
+	pb.AlumnoService_GetAlumno_FullMethodName:                 accesoAlumno,
+	pb.AlumnoService_UpdateAlumno_FullMethodName:              accesoAlumno,
+	pb.AlumnoService_DeleteAlumno_FullMethodName:              accesoAlumno,
+	pb.AlumnoService_SendEmail_FullMethodName:                 accesoAlumno,
+	pb.AlumnoService_UploadFotoPerfil_FullMethodName:          accesoAlumno,
+	pb.AlumnoService_CreateFotoPerfilUploadUrl_FullMethodName: accesoAlumno,
+	pb.AlumnoService_ConfirmFotoPerfil_FullMethodName:         accesoAlumno,
+	pb.AlumnoService_DeleteFotoPerfil_FullMethodName:          accesoAlumno,
+}
+
+// accesoDe devuelve el acceso de fullMethod; lo que no está en publicos ni en
+// accesos exige el token de administración
+func accesoDe(fullMethod string) acceso {
+	servicio, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if publicos[servicio] {
+		return accesoPublico
+	}
+	return accesos[fullMethod]
+}
+
+// conID - Peticiones dirigidas a un alumno
+type conID interface {
+	GetId() uint32
+}
+
+// interceptor - Idioma, sesión y recuperación de panics, en ese orden, para
+// llamadas unarias y streams
+type interceptor struct {
+	authenticator *auth.Authenticator
+}
+
+func (i *interceptor) unary(ctx context.Context, req any, info *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (resp any, err error) {
+	ctx = withIdioma(ctx)
+	defer recuperar(ctx, info.FullMethod, &err)
+	_ = grpcgo.SetHeader(ctx, metadata.Pairs(metadataContentLang, i18n.FromContext(ctx)))
+	ctx, verificar, err := i.autenticar(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if verificar != nil {
+		if err := verificar(req); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+func (i *interceptor) stream(srv any, ss grpcgo.ServerStream, info *grpcgo.StreamServerInfo, handler grpcgo.StreamHandler) (err error) {
+	ctx := withIdioma(ss.Context())
+	defer recuperar(ctx, info.FullMethod, &err)
+	_ = ss.SetHeader(metadata.Pairs(metadataContentLang, i18n.FromContext(ctx)))
+	ctx, verificar, err := i.autenticar(ctx, info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ctx, verificar: verificar})
+}
+
+// autenticar resuelve el principal de los metadatos x-alumno-id y
+// authorization como el middleware Auth de REST y lo deja en el contexto. En
+// los métodos de un alumno devuelve además cómo verificar el id de la
+// petición, que en los streams llega en el primer mensaje
+func (i *interceptor) autenticar(ctx context.Context, fullMethod string) (context.Context, func(any) error, error) {
+	a := accesoDe(fullMethod)
+	if a == accesoPublico {
+		return ctx, nil, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	p, err := i.authenticator.Authenticate(ctx, primero(md, metadataAlumnoID), primero(md, metadataAuthorization))
+	if err != nil {
+		return ctx, nil, toStatus(ctx, fullMethod, err)
+	}
+	ctx = auth.WithPrincipal(ctx, p)
+
+	switch {
+	case p.Admin:
+		return ctx, nil, nil
+	case a == accesoAdmin:
+		return ctx, nil, toStatus(ctx, fullMethod, apperrors.ErrForbidden)
+	}
+	return ctx, func(req any) error {
+		if r, ok := req.(conID); ok && p.PuedeActuarSobre(uint(r.GetId())) {
+			return nil
+		}
+		return toStatus(ctx, fullMethod, apperrors.ErrForbidden)
+	}, nil
+}
+
+// withIdioma negocia el idioma con accept-language como el middleware de REST
+func withIdioma(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if lang := i18n.Negotiate(primero(md, metadataIdioma)); lang != "" {
+		return i18n.WithLang(ctx, lang)
+	}
+	return ctx
+}
+
+// recuperar convierte un panic del handler en Internal para no tirar el servidor
+func recuperar(ctx context.Context, metodo string, err *error) {
+	if r := recover(); r != nil {
+		log.Printf("Panic en gRPC %s: %v\n%s", metodo, r, debug.Stack())
+		*err = statusFrom(ctx, problem.From(apperrors.ErrInternalServer))
+	}
+}
+
+func primero(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// serverStream - Stream con el contexto que armó el interceptor; verificar,
+// si existe, revisa el primer mensaje recibido
+type serverStream struct {
+	grpcgo.ServerStream
+	ctx       context.Context
+	verificar func(any) error
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if verificar := s.verificar; verificar != nil {
+		s.verificar = nil
+		return verificar(m)
+	}
+	return nil
+}
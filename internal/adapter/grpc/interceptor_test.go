@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pb"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// sesionesFalsas acepta solo la sesión "s-1" del alumno 1
+type sesionesFalsas struct{}
+
+func (sesionesFalsas) Verify(_ context.Context, alumnoID uint, sessionString string) error {
+	if alumnoID == 1 && sessionString == "s-1" {
+		return nil
+	}
+	return apperrors.ErrInvalidSession
+}
+
+func llamar(metodo string, req any, md metadata.MD) codes.Code {
+	i := &interceptor{authenticator: auth.NewAuthenticator(sesionesFalsas{}, "admin")}
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := i.unary(ctx, req, &grpcgo.UnaryServerInfo{FullMethod: metodo}, func(ctx context.Context, _ any) (any, error) {
+		if _, ok := auth.FromContext(ctx); !ok && accesoDe(metodo) != accesoPublico {
+			return nil, status.Error(codes.Internal, "sin principal")
+		}
+		return nil, nil
+	})
+	return status.Code(err)
+}
+
+func TestInterceptorAutoriza(t *testing.T) {
+	alumno := metadata.Pairs(metadataAlumnoID, "1", metadataAuthorization, "Bearer s-1")
+	admin := metadata.Pairs(metadataAuthorization, "Bearer admin")
+	ajena := metadata.Pairs(metadataAlumnoID, "2", metadataAuthorization, "Bearer s-1")
+
+	for _, tc := range []struct {
+		nombre string
+		metodo string
+		req    any
+		md     metadata.MD
+		want   codes.Code
+	}{
+		{"registro sin sesión", pb.AlumnoService_CreateAlumno_FullMethodName, &pb.CreateAlumnoRequest{}, nil, codes.OK},
+		{"login sin sesión", pb.SesionService_Login_FullMethodName, &pb.LoginRequest{}, nil, codes.OK},
+		{"alumno sin sesión", pb.AlumnoService_GetAlumno_FullMethodName, &pb.GetAlumnoRequest{Id: 1}, nil, codes.Unauthenticated},
+		{"sesión de otro alumno", pb.AlumnoService_GetAlumno_FullMethodName, &pb.GetAlumnoRequest{Id: 2}, ajena, codes.Unauthenticated},
+		{"alumno sobre sí mismo", pb.AlumnoService_UpdateAlumno_FullMethodName, &pb.UpdateAlumnoRequest{Id: 1}, alumno, codes.OK},
+		{"alumno sobre otro", pb.AlumnoService_DeleteAlumno_FullMethodName, &pb.DeleteAlumnoRequest{Id: 2}, alumno, codes.PermissionDenied},
+		{"admin sobre cualquier alumno", pb.AlumnoService_GetAlumno_FullMethodName, &pb.GetAlumnoRequest{Id: 2}, admin, codes.OK},
+		{"listado como alumno", pb.AlumnoService_ListAlumnos_FullMethodName, &pb.ListAlumnosRequest{}, alumno, codes.PermissionDenied},
+		{"lote como alumno", pb.AlumnoService_BatchAlumnos_FullMethodName, &pb.BatchAlumnosRequest{}, alumno, codes.PermissionDenied},
+		{"varios alumnos como alumno", pb.AlumnoService_BatchGetAlumnos_FullMethodName, &pb.BatchGetAlumnosRequest{Ids: []uint32{1}}, alumno, codes.PermissionDenied},
+		{"profesores como alumno", pb.ProfesorService_GetProfesor_FullMethodName, &pb.GetProfesorRequest{Id: 1}, alumno, codes.PermissionDenied},
+		{"listado como admin", pb.AlumnoService_ListAlumnos_FullMethodName, &pb.ListAlumnosRequest{}, admin, codes.OK},
+		{"profesores como admin", pb.ProfesorService_ListProfesores_FullMethodName, &pb.ListProfesoresRequest{}, admin, codes.OK},
+	} {
+		if got := llamar(tc.metodo, tc.req, tc.md); got != tc.want {
+			t.Errorf("%s: %s = %s, se esperaba %s", tc.nombre, tc.metodo, got, tc.want)
+		}
+	}
+}
+
+// streamFalso entrega un único UploadFotoPerfilRequest con el id dado
+type streamFalso struct {
+	grpcgo.ServerStream
+	ctx context.Context
+	id  uint32
+}
+
+func (s *streamFalso) Context() context.Context    { return s.ctx }
+func (s *streamFalso) SetHeader(metadata.MD) error { return nil }
+
+func (s *streamFalso) RecvMsg(m any) error {
+	m.(*pb.UploadFotoPerfilRequest).Contenido = &pb.UploadFotoPerfilRequest_Id{Id: s.id}
+	return nil
+}
+
+// En los streams el id llega en el primer mensaje y se verifica al recibirlo
+func TestInterceptorVerificaPrimerMensaje(t *testing.T) {
+	i := &interceptor{authenticator: auth.NewAuthenticator(sesionesFalsas{}, "admin")}
+	md := metadata.Pairs(metadataAlumnoID, "1", metadataAuthorization, "Bearer s-1")
+	info := &grpcgo.StreamServerInfo{FullMethod: pb.AlumnoService_UploadFotoPerfil_FullMethodName, IsClientStream: true}
+
+	for id, want := range map[uint32]codes.Code{1: codes.OK, 2: codes.PermissionDenied} {
+		ss := &streamFalso{ctx: metadata.NewIncomingContext(context.Background(), md), id: id}
+		err := i.stream(nil, ss, info, func(_ any, stream grpcgo.ServerStream) error {
+			return stream.RecvMsg(&pb.UploadFotoPerfilRequest{})
+		})
+		if got := status.Code(err); got != want {
+			t.Errorf("foto del alumno %d = %s, se esperaba %s", id, got, want)
+		}
+	}
+}
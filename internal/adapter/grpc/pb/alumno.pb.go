@@ -0,0 +1,1288 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: alumno.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Alumno struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nombres             string                 `protobuf:"bytes,2,opt,name=nombres,proto3" json:"nombres,omitempty"`
+	Apellidos           string                 `protobuf:"bytes,3,opt,name=apellidos,proto3" json:"apellidos,omitempty"`
+	Matricula           string                 `protobuf:"bytes,4,opt,name=matricula,proto3" json:"matricula,omitempty"`
+	Promedio            float64                `protobuf:"fixed64,5,opt,name=promedio,proto3" json:"promedio,omitempty"`
+	Email               string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Telefono            string                 `protobuf:"bytes,7,opt,name=telefono,proto3" json:"telefono,omitempty"`
+	Idioma              string                 `protobuf:"bytes,8,opt,name=idioma,proto3" json:"idioma,omitempty"`
+	FotoPerfilUrl       string                 `protobuf:"bytes,9,opt,name=foto_perfil_url,json=fotoPerfilUrl,proto3" json:"foto_perfil_url,omitempty"`
+	FotoPerfilVariantes map[string]string      `protobuf:"bytes,10,rep,name=foto_perfil_variantes,json=fotoPerfilVariantes,proto3" json:"foto_perfil_variantes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Alumno) Reset() {
+	*x = Alumno{}
+	mi := &file_alumno_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alumno) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alumno) ProtoMessage() {}
+
+func (x *Alumno) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alumno.ProtoReflect.Descriptor instead.
+func (*Alumno) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Alumno) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Alumno) GetNombres() string {
+	if x != nil {
+		return x.Nombres
+	}
+	return ""
+}
+
+func (x *Alumno) GetApellidos() string {
+	if x != nil {
+		return x.Apellidos
+	}
+	return ""
+}
+
+func (x *Alumno) GetMatricula() string {
+	if x != nil {
+		return x.Matricula
+	}
+	return ""
+}
+
+func (x *Alumno) GetPromedio() float64 {
+	if x != nil {
+		return x.Promedio
+	}
+	return 0
+}
+
+func (x *Alumno) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Alumno) GetTelefono() string {
+	if x != nil {
+		return x.Telefono
+	}
+	return ""
+}
+
+func (x *Alumno) GetIdioma() string {
+	if x != nil {
+		return x.Idioma
+	}
+	return ""
+}
+
+func (x *Alumno) GetFotoPerfilUrl() string {
+	if x != nil {
+		return x.FotoPerfilUrl
+	}
+	return ""
+}
+
+func (x *Alumno) GetFotoPerfilVariantes() map[string]string {
+	if x != nil {
+		return x.FotoPerfilVariantes
+	}
+	return nil
+}
+
+type AlumnoInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nombres       string                 `protobuf:"bytes,1,opt,name=nombres,proto3" json:"nombres,omitempty"`
+	Apellidos     string                 `protobuf:"bytes,2,opt,name=apellidos,proto3" json:"apellidos,omitempty"`
+	Matricula     string                 `protobuf:"bytes,3,opt,name=matricula,proto3" json:"matricula,omitempty"`
+	Promedio      float64                `protobuf:"fixed64,4,opt,name=promedio,proto3" json:"promedio,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Telefono      string                 `protobuf:"bytes,6,opt,name=telefono,proto3" json:"telefono,omitempty"`
+	Idioma        string                 `protobuf:"bytes,7,opt,name=idioma,proto3" json:"idioma,omitempty"`
+	Password      string                 `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlumnoInput) Reset() {
+	*x = AlumnoInput{}
+	mi := &file_alumno_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlumnoInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlumnoInput) ProtoMessage() {}
+
+func (x *AlumnoInput) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlumnoInput.ProtoReflect.Descriptor instead.
+func (*AlumnoInput) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AlumnoInput) GetNombres() string {
+	if x != nil {
+		return x.Nombres
+	}
+	return ""
+}
+
+func (x *AlumnoInput) GetApellidos() string {
+	if x != nil {
+		return x.Apellidos
+	}
+	return ""
+}
+
+func (x *AlumnoInput) GetMatricula() string {
+	if x != nil {
+		return x.Matricula
+	}
+	return ""
+}
+
+func (x *AlumnoInput) GetPromedio() float64 {
+	if x != nil {
+		return x.Promedio
+	}
+	return 0
+}
+
+func (x *AlumnoInput) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AlumnoInput) GetTelefono() string {
+	if x != nil {
+		return x.Telefono
+	}
+	return ""
+}
+
+func (x *AlumnoInput) GetIdioma() string {
+	if x != nil {
+		return x.Idioma
+	}
+	return ""
+}
+
+func (x *AlumnoInput) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ListAlumnosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlumnosRequest) Reset() {
+	*x = ListAlumnosRequest{}
+	mi := &file_alumno_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlumnosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlumnosRequest) ProtoMessage() {}
+
+func (x *ListAlumnosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlumnosRequest.ProtoReflect.Descriptor instead.
+func (*ListAlumnosRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{2}
+}
+
+type ListAlumnosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alumnos       []*Alumno              `protobuf:"bytes,1,rep,name=alumnos,proto3" json:"alumnos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlumnosResponse) Reset() {
+	*x = ListAlumnosResponse{}
+	mi := &file_alumno_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlumnosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlumnosResponse) ProtoMessage() {}
+
+func (x *ListAlumnosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlumnosResponse.ProtoReflect.Descriptor instead.
+func (*ListAlumnosResponse) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAlumnosResponse) GetAlumnos() []*Alumno {
+	if x != nil {
+		return x.Alumnos
+	}
+	return nil
+}
+
+type GetAlumnoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlumnoRequest) Reset() {
+	*x = GetAlumnoRequest{}
+	mi := &file_alumno_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlumnoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlumnoRequest) ProtoMessage() {}
+
+func (x *GetAlumnoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlumnoRequest.ProtoReflect.Descriptor instead.
+func (*GetAlumnoRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAlumnoRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BatchGetAlumnosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint32               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetAlumnosRequest) Reset() {
+	*x = BatchGetAlumnosRequest{}
+	mi := &file_alumno_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetAlumnosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAlumnosRequest) ProtoMessage() {}
+
+func (x *BatchGetAlumnosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAlumnosRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetAlumnosRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetAlumnosRequest) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type CreateAlumnoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alumno        *AlumnoInput           `protobuf:"bytes,1,opt,name=alumno,proto3" json:"alumno,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlumnoRequest) Reset() {
+	*x = CreateAlumnoRequest{}
+	mi := &file_alumno_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlumnoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlumnoRequest) ProtoMessage() {}
+
+func (x *CreateAlumnoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlumnoRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlumnoRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateAlumnoRequest) GetAlumno() *AlumnoInput {
+	if x != nil {
+		return x.Alumno
+	}
+	return nil
+}
+
+type UpdateAlumnoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Alumno        *AlumnoInput           `protobuf:"bytes,2,opt,name=alumno,proto3" json:"alumno,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlumnoRequest) Reset() {
+	*x = UpdateAlumnoRequest{}
+	mi := &file_alumno_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlumnoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlumnoRequest) ProtoMessage() {}
+
+func (x *UpdateAlumnoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlumnoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlumnoRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateAlumnoRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAlumnoRequest) GetAlumno() *AlumnoInput {
+	if x != nil {
+		return x.Alumno
+	}
+	return nil
+}
+
+type DeleteAlumnoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlumnoRequest) Reset() {
+	*x = DeleteAlumnoRequest{}
+	mi := &file_alumno_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlumnoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlumnoRequest) ProtoMessage() {}
+
+func (x *DeleteAlumnoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlumnoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlumnoRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAlumnoRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SendEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEmailRequest) Reset() {
+	*x = SendEmailRequest{}
+	mi := &file_alumno_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEmailRequest) ProtoMessage() {}
+
+func (x *SendEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendEmailRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SendEmailRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UploadFotoPerfilRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Contenido:
+	//
+	//	*UploadFotoPerfilRequest_Id
+	//	*UploadFotoPerfilRequest_Chunk
+	Contenido     isUploadFotoPerfilRequest_Contenido `protobuf_oneof:"contenido"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFotoPerfilRequest) Reset() {
+	*x = UploadFotoPerfilRequest{}
+	mi := &file_alumno_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFotoPerfilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFotoPerfilRequest) ProtoMessage() {}
+
+func (x *UploadFotoPerfilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFotoPerfilRequest.ProtoReflect.Descriptor instead.
+func (*UploadFotoPerfilRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UploadFotoPerfilRequest) GetContenido() isUploadFotoPerfilRequest_Contenido {
+	if x != nil {
+		return x.Contenido
+	}
+	return nil
+}
+
+func (x *UploadFotoPerfilRequest) GetId() uint32 {
+	if x != nil {
+		if x, ok := x.Contenido.(*UploadFotoPerfilRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+func (x *UploadFotoPerfilRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Contenido.(*UploadFotoPerfilRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadFotoPerfilRequest_Contenido interface {
+	isUploadFotoPerfilRequest_Contenido()
+}
+
+type UploadFotoPerfilRequest_Id struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type UploadFotoPerfilRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadFotoPerfilRequest_Id) isUploadFotoPerfilRequest_Contenido() {}
+
+func (*UploadFotoPerfilRequest_Chunk) isUploadFotoPerfilRequest_Contenido() {}
+
+type CreateFotoPerfilUploadUrlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFotoPerfilUploadUrlRequest) Reset() {
+	*x = CreateFotoPerfilUploadUrlRequest{}
+	mi := &file_alumno_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFotoPerfilUploadUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFotoPerfilUploadUrlRequest) ProtoMessage() {}
+
+func (x *CreateFotoPerfilUploadUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFotoPerfilUploadUrlRequest.ProtoReflect.Descriptor instead.
+func (*CreateFotoPerfilUploadUrlRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateFotoPerfilUploadUrlRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateFotoPerfilUploadUrlRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateFotoPerfilUploadUrlRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// Instrucciones para subir la foto directamente al almacenamiento
+type FotoPerfilUpload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FotoPerfilUpload) Reset() {
+	*x = FotoPerfilUpload{}
+	mi := &file_alumno_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FotoPerfilUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FotoPerfilUpload) ProtoMessage() {}
+
+func (x *FotoPerfilUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FotoPerfilUpload.ProtoReflect.Descriptor instead.
+func (*FotoPerfilUpload) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FotoPerfilUpload) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FotoPerfilUpload) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *FotoPerfilUpload) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *FotoPerfilUpload) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *FotoPerfilUpload) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ConfirmFotoPerfilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmFotoPerfilRequest) Reset() {
+	*x = ConfirmFotoPerfilRequest{}
+	mi := &file_alumno_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmFotoPerfilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmFotoPerfilRequest) ProtoMessage() {}
+
+func (x *ConfirmFotoPerfilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmFotoPerfilRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmFotoPerfilRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmFotoPerfilRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfirmFotoPerfilRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteFotoPerfilRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFotoPerfilRequest) Reset() {
+	*x = DeleteFotoPerfilRequest{}
+	mi := &file_alumno_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFotoPerfilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFotoPerfilRequest) ProtoMessage() {}
+
+func (x *DeleteFotoPerfilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFotoPerfilRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFotoPerfilRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteFotoPerfilRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FotoPerfil struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	FotoPerfilUrl       string                 `protobuf:"bytes,1,opt,name=foto_perfil_url,json=fotoPerfilUrl,proto3" json:"foto_perfil_url,omitempty"`
+	FotoPerfilVariantes map[string]string      `protobuf:"bytes,2,rep,name=foto_perfil_variantes,json=fotoPerfilVariantes,proto3" json:"foto_perfil_variantes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *FotoPerfil) Reset() {
+	*x = FotoPerfil{}
+	mi := &file_alumno_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FotoPerfil) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FotoPerfil) ProtoMessage() {}
+
+func (x *FotoPerfil) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FotoPerfil.ProtoReflect.Descriptor instead.
+func (*FotoPerfil) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FotoPerfil) GetFotoPerfilUrl() string {
+	if x != nil {
+		return x.FotoPerfilUrl
+	}
+	return ""
+}
+
+func (x *FotoPerfil) GetFotoPerfilVariantes() map[string]string {
+	if x != nil {
+		return x.FotoPerfilVariantes
+	}
+	return nil
+}
+
+type OperacionAlumno struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"` // crear | actualizar | eliminar
+	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Datos         *AlumnoInput           `protobuf:"bytes,3,opt,name=datos,proto3" json:"datos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperacionAlumno) Reset() {
+	*x = OperacionAlumno{}
+	mi := &file_alumno_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperacionAlumno) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperacionAlumno) ProtoMessage() {}
+
+func (x *OperacionAlumno) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperacionAlumno.ProtoReflect.Descriptor instead.
+func (*OperacionAlumno) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OperacionAlumno) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *OperacionAlumno) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OperacionAlumno) GetDatos() *AlumnoInput {
+	if x != nil {
+		return x.Datos
+	}
+	return nil
+}
+
+type BatchAlumnosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modo          string                 `protobuf:"bytes,1,opt,name=modo,proto3" json:"modo,omitempty"` // atomico (por defecto) | parcial
+	Operaciones   []*OperacionAlumno     `protobuf:"bytes,2,rep,name=operaciones,proto3" json:"operaciones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAlumnosRequest) Reset() {
+	*x = BatchAlumnosRequest{}
+	mi := &file_alumno_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAlumnosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAlumnosRequest) ProtoMessage() {}
+
+func (x *BatchAlumnosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alumno_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAlumnosRequest.ProtoReflect.Descriptor instead.
+func (*BatchAlumnosRequest) Descriptor() ([]byte, []int) {
+	return file_alumno_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchAlumnosRequest) GetModo() string {
+	if x != nil {
+		return x.Modo
+	}
+	return ""
+}
+
+func (x *BatchAlumnosRequest) GetOperaciones() []*OperacionAlumno {
+	if x != nil {
+		return x.Operaciones
+	}
+	return nil
+}
+
+var File_alumno_proto protoreflect.FileDescriptor
+
+const file_alumno_proto_rawDesc = "" +
+	"\n" +
+	"\falumno.proto\x12\n" +
+	"escolar.v1\x1a\vcomun.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x03\n" +
+	"\x06Alumno\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
+	"\anombres\x18\x02 \x01(\tR\anombres\x12\x1c\n" +
+	"\tapellidos\x18\x03 \x01(\tR\tapellidos\x12\x1c\n" +
+	"\tmatricula\x18\x04 \x01(\tR\tmatricula\x12\x1a\n" +
+	"\bpromedio\x18\x05 \x01(\x01R\bpromedio\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\x12\x1a\n" +
+	"\btelefono\x18\a \x01(\tR\btelefono\x12\x16\n" +
+	"\x06idioma\x18\b \x01(\tR\x06idioma\x12&\n" +
+	"\x0ffoto_perfil_url\x18\t \x01(\tR\rfotoPerfilUrl\x12_\n" +
+	"\x15foto_perfil_variantes\x18\n" +
+	" \x03(\v2+.escolar.v1.Alumno.FotoPerfilVariantesEntryR\x13fotoPerfilVariantes\x1aF\n" +
+	"\x18FotoPerfilVariantesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe5\x01\n" +
+	"\vAlumnoInput\x12\x18\n" +
+	"\anombres\x18\x01 \x01(\tR\anombres\x12\x1c\n" +
+	"\tapellidos\x18\x02 \x01(\tR\tapellidos\x12\x1c\n" +
+	"\tmatricula\x18\x03 \x01(\tR\tmatricula\x12\x1a\n" +
+	"\bpromedio\x18\x04 \x01(\x01R\bpromedio\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x1a\n" +
+	"\btelefono\x18\x06 \x01(\tR\btelefono\x12\x16\n" +
+	"\x06idioma\x18\a \x01(\tR\x06idioma\x12\x1a\n" +
+	"\bpassword\x18\b \x01(\tR\bpassword\"\x14\n" +
+	"\x12ListAlumnosRequest\"C\n" +
+	"\x13ListAlumnosResponse\x12,\n" +
+	"\aalumnos\x18\x01 \x03(\v2\x12.escolar.v1.AlumnoR\aalumnos\"\"\n" +
+	"\x10GetAlumnoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"*\n" +
+	"\x16BatchGetAlumnosRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\rR\x03ids\"F\n" +
+	"\x13CreateAlumnoRequest\x12/\n" +
+	"\x06alumno\x18\x01 \x01(\v2\x17.escolar.v1.AlumnoInputR\x06alumno\"V\n" +
+	"\x13UpdateAlumnoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12/\n" +
+	"\x06alumno\x18\x02 \x01(\v2\x17.escolar.v1.AlumnoInputR\x06alumno\"%\n" +
+	"\x13DeleteAlumnoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\"\n" +
+	"\x10SendEmailRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"P\n" +
+	"\x17UploadFotoPerfilRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\v\n" +
+	"\tcontenido\"i\n" +
+	" CreateFotoPerfilUploadUrlRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"\x97\x02\n" +
+	"\x10FotoPerfilUpload\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12C\n" +
+	"\aheaders\x18\x04 \x03(\v2).escolar.v1.FotoPerfilUpload.HeadersEntryR\aheaders\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
+	"\x18ConfirmFotoPerfilRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\")\n" +
+	"\x17DeleteFotoPerfilRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\xe1\x01\n" +
+	"\n" +
+	"FotoPerfil\x12&\n" +
+	"\x0ffoto_perfil_url\x18\x01 \x01(\tR\rfotoPerfilUrl\x12c\n" +
+	"\x15foto_perfil_variantes\x18\x02 \x03(\v2/.escolar.v1.FotoPerfil.FotoPerfilVariantesEntryR\x13fotoPerfilVariantes\x1aF\n" +
+	"\x18FotoPerfilVariantesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"`\n" +
+	"\x0fOperacionAlumno\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\x12-\n" +
+	"\x05datos\x18\x03 \x01(\v2\x17.escolar.v1.AlumnoInputR\x05datos\"h\n" +
+	"\x13BatchAlumnosRequest\x12\x12\n" +
+	"\x04modo\x18\x01 \x01(\tR\x04modo\x12=\n" +
+	"\voperaciones\x18\x02 \x03(\v2\x1b.escolar.v1.OperacionAlumnoR\voperaciones2\xcf\b\n" +
+	"\rAlumnoService\x12N\n" +
+	"\vListAlumnos\x12\x1e.escolar.v1.ListAlumnosRequest\x1a\x1f.escolar.v1.ListAlumnosResponse\x12=\n" +
+	"\tGetAlumno\x12\x1c.escolar.v1.GetAlumnoRequest\x1a\x12.escolar.v1.Alumno\x12V\n" +
+	"\x0fBatchGetAlumnos\x12\".escolar.v1.BatchGetAlumnosRequest\x1a\x1f.escolar.v1.ListAlumnosResponse\x12C\n" +
+	"\fCreateAlumno\x12\x1f.escolar.v1.CreateAlumnoRequest\x1a\x12.escolar.v1.Alumno\x12C\n" +
+	"\fUpdateAlumno\x12\x1f.escolar.v1.UpdateAlumnoRequest\x1a\x12.escolar.v1.Alumno\x12G\n" +
+	"\fDeleteAlumno\x12\x1f.escolar.v1.DeleteAlumnoRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\tSendEmail\x12\x1c.escolar.v1.SendEmailRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x10UploadFotoPerfil\x12#.escolar.v1.UploadFotoPerfilRequest\x1a\x16.escolar.v1.FotoPerfil(\x01\x12g\n" +
+	"\x19CreateFotoPerfilUploadUrl\x12,.escolar.v1.CreateFotoPerfilUploadUrlRequest\x1a\x1c.escolar.v1.FotoPerfilUpload\x12Q\n" +
+	"\x11ConfirmFotoPerfil\x12$.escolar.v1.ConfirmFotoPerfilRequest\x1a\x16.escolar.v1.FotoPerfil\x12O\n" +
+	"\x10DeleteFotoPerfil\x12#.escolar.v1.DeleteFotoPerfilRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\rImportAlumnos\x12\x19.escolar.v1.ImportRequest\x1a .escolar.v1.ResultadoImportacion(\x01\x12E\n" +
+	"\rExportAlumnos\x12\x19.escolar.v1.ExportRequest\x1a\x17.escolar.v1.ExportChunk0\x01\x12J\n" +
+	"\fBatchAlumnos\x12\x1f.escolar.v1.BatchAlumnosRequest\x1a\x19.escolar.v1.ResultadoLoteBEZCgithub.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pbb\x06proto3"
+
+var (
+	file_alumno_proto_rawDescOnce sync.Once
+	file_alumno_proto_rawDescData []byte
+)
+
+func file_alumno_proto_rawDescGZIP() []byte {
+	file_alumno_proto_rawDescOnce.Do(func() {
+		file_alumno_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_alumno_proto_rawDesc), len(file_alumno_proto_rawDesc)))
+	})
+	return file_alumno_proto_rawDescData
+}
+
+var file_alumno_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_alumno_proto_goTypes = []any{
+	(*Alumno)(nil),                           // 0: escolar.v1.Alumno
+	(*AlumnoInput)(nil),                      // 1: escolar.v1.AlumnoInput
+	(*ListAlumnosRequest)(nil),               // 2: escolar.v1.ListAlumnosRequest
+	(*ListAlumnosResponse)(nil),              // 3: escolar.v1.ListAlumnosResponse
+	(*GetAlumnoRequest)(nil),                 // 4: escolar.v1.GetAlumnoRequest
+	(*BatchGetAlumnosRequest)(nil),           // 5: escolar.v1.BatchGetAlumnosRequest
+	(*CreateAlumnoRequest)(nil),              // 6: escolar.v1.CreateAlumnoRequest
+	(*UpdateAlumnoRequest)(nil),              // 7: escolar.v1.UpdateAlumnoRequest
+	(*DeleteAlumnoRequest)(nil),              // 8: escolar.v1.DeleteAlumnoRequest
+	(*SendEmailRequest)(nil),                 // 9: escolar.v1.SendEmailRequest
+	(*UploadFotoPerfilRequest)(nil),          // 10: escolar.v1.UploadFotoPerfilRequest
+	(*CreateFotoPerfilUploadUrlRequest)(nil), // 11: escolar.v1.CreateFotoPerfilUploadUrlRequest
+	(*FotoPerfilUpload)(nil),                 // 12: escolar.v1.FotoPerfilUpload
+	(*ConfirmFotoPerfilRequest)(nil),         // 13: escolar.v1.ConfirmFotoPerfilRequest
+	(*DeleteFotoPerfilRequest)(nil),          // 14: escolar.v1.DeleteFotoPerfilRequest
+	(*FotoPerfil)(nil),                       // 15: escolar.v1.FotoPerfil
+	(*OperacionAlumno)(nil),                  // 16: escolar.v1.OperacionAlumno
+	(*BatchAlumnosRequest)(nil),              // 17: escolar.v1.BatchAlumnosRequest
+	nil,                                      // 18: escolar.v1.Alumno.FotoPerfilVariantesEntry
+	nil,                                      // 19: escolar.v1.FotoPerfilUpload.HeadersEntry
+	nil,                                      // 20: escolar.v1.FotoPerfil.FotoPerfilVariantesEntry
+	(*timestamppb.Timestamp)(nil),            // 21: google.protobuf.Timestamp
+	(*ImportRequest)(nil),                    // 22: escolar.v1.ImportRequest
+	(*ExportRequest)(nil),                    // 23: escolar.v1.ExportRequest
+	(*emptypb.Empty)(nil),                    // 24: google.protobuf.Empty
+	(*ResultadoImportacion)(nil),             // 25: escolar.v1.ResultadoImportacion
+	(*ExportChunk)(nil),                      // 26: escolar.v1.ExportChunk
+	(*ResultadoLote)(nil),                    // 27: escolar.v1.ResultadoLote
+}
+var file_alumno_proto_depIdxs = []int32{
+	18, // 0: escolar.v1.Alumno.foto_perfil_variantes:type_name -> escolar.v1.Alumno.FotoPerfilVariantesEntry
+	0,  // 1: escolar.v1.ListAlumnosResponse.alumnos:type_name -> escolar.v1.Alumno
+	1,  // 2: escolar.v1.CreateAlumnoRequest.alumno:type_name -> escolar.v1.AlumnoInput
+	1,  // 3: escolar.v1.UpdateAlumnoRequest.alumno:type_name -> escolar.v1.AlumnoInput
+	19, // 4: escolar.v1.FotoPerfilUpload.headers:type_name -> escolar.v1.FotoPerfilUpload.HeadersEntry
+	21, // 5: escolar.v1.FotoPerfilUpload.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 6: escolar.v1.FotoPerfil.foto_perfil_variantes:type_name -> escolar.v1.FotoPerfil.FotoPerfilVariantesEntry
+	1,  // 7: escolar.v1.OperacionAlumno.datos:type_name -> escolar.v1.AlumnoInput
+	16, // 8: escolar.v1.BatchAlumnosRequest.operaciones:type_name -> escolar.v1.OperacionAlumno
+	2,  // 9: escolar.v1.AlumnoService.ListAlumnos:input_type -> escolar.v1.ListAlumnosRequest
+	4,  // 10: escolar.v1.AlumnoService.GetAlumno:input_type -> escolar.v1.GetAlumnoRequest
+	5,  // 11: escolar.v1.AlumnoService.BatchGetAlumnos:input_type -> escolar.v1.BatchGetAlumnosRequest
+	6,  // 12: escolar.v1.AlumnoService.CreateAlumno:input_type -> escolar.v1.CreateAlumnoRequest
+	7,  // 13: escolar.v1.AlumnoService.UpdateAlumno:input_type -> escolar.v1.UpdateAlumnoRequest
+	8,  // 14: escolar.v1.AlumnoService.DeleteAlumno:input_type -> escolar.v1.DeleteAlumnoRequest
+	9,  // 15: escolar.v1.AlumnoService.SendEmail:input_type -> escolar.v1.SendEmailRequest
+	10, // 16: escolar.v1.AlumnoService.UploadFotoPerfil:input_type -> escolar.v1.UploadFotoPerfilRequest
+	11, // 17: escolar.v1.AlumnoService.CreateFotoPerfilUploadUrl:input_type -> escolar.v1.CreateFotoPerfilUploadUrlRequest
+	13, // 18: escolar.v1.AlumnoService.ConfirmFotoPerfil:input_type -> escolar.v1.ConfirmFotoPerfilRequest
+	14, // 19: escolar.v1.AlumnoService.DeleteFotoPerfil:input_type -> escolar.v1.DeleteFotoPerfilRequest
+	22, // 20: escolar.v1.AlumnoService.ImportAlumnos:input_type -> escolar.v1.ImportRequest
+	23, // 21: escolar.v1.AlumnoService.ExportAlumnos:input_type -> escolar.v1.ExportRequest
+	17, // 22: escolar.v1.AlumnoService.BatchAlumnos:input_type -> escolar.v1.BatchAlumnosRequest
+	3,  // 23: escolar.v1.AlumnoService.ListAlumnos:output_type -> escolar.v1.ListAlumnosResponse
+	0,  // 24: escolar.v1.AlumnoService.GetAlumno:output_type -> escolar.v1.Alumno
+	3,  // 25: escolar.v1.AlumnoService.BatchGetAlumnos:output_type -> escolar.v1.ListAlumnosResponse
+	0,  // 26: escolar.v1.AlumnoService.CreateAlumno:output_type -> escolar.v1.Alumno
+	0,  // 27: escolar.v1.AlumnoService.UpdateAlumno:output_type -> escolar.v1.Alumno
+	24, // 28: escolar.v1.AlumnoService.DeleteAlumno:output_type -> google.protobuf.Empty
+	24, // 29: escolar.v1.AlumnoService.SendEmail:output_type -> google.protobuf.Empty
+	15, // 30: escolar.v1.AlumnoService.UploadFotoPerfil:output_type -> escolar.v1.FotoPerfil
+	12, // 31: escolar.v1.AlumnoService.CreateFotoPerfilUploadUrl:output_type -> escolar.v1.FotoPerfilUpload
+	15, // 32: escolar.v1.AlumnoService.ConfirmFotoPerfil:output_type -> escolar.v1.FotoPerfil
+	24, // 33: escolar.v1.AlumnoService.DeleteFotoPerfil:output_type -> google.protobuf.Empty
+	25, // 34: escolar.v1.AlumnoService.ImportAlumnos:output_type -> escolar.v1.ResultadoImportacion
+	26, // 35: escolar.v1.AlumnoService.ExportAlumnos:output_type -> escolar.v1.ExportChunk
+	27, // 36: escolar.v1.AlumnoService.BatchAlumnos:output_type -> escolar.v1.ResultadoLote
+	23, // [23:37] is the sub-list for method output_type
+	9,  // [9:23] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_alumno_proto_init() }
+func file_alumno_proto_init() {
+	if File_alumno_proto != nil {
+		return
+	}
+	file_comun_proto_init()
+	file_alumno_proto_msgTypes[10].OneofWrappers = []any{
+		(*UploadFotoPerfilRequest_Id)(nil),
+		(*UploadFotoPerfilRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alumno_proto_rawDesc), len(file_alumno_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_alumno_proto_goTypes,
+		DependencyIndexes: file_alumno_proto_depIdxs,
+		MessageInfos:      file_alumno_proto_msgTypes,
+	}.Build()
+	File_alumno_proto = out.File
+	file_alumno_proto_goTypes = nil
+	file_alumno_proto_depIdxs = nil
+}
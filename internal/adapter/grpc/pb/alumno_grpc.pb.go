@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: alumno.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AlumnoService_ListAlumnos_FullMethodName               = "/escolar.v1.AlumnoService/ListAlumnos"
+	AlumnoService_GetAlumno_FullMethodName                 = "/escolar.v1.AlumnoService/GetAlumno"
+	AlumnoService_BatchGetAlumnos_FullMethodName           = "/escolar.v1.AlumnoService/BatchGetAlumnos"
+	AlumnoService_CreateAlumno_FullMethodName              = "/escolar.v1.AlumnoService/CreateAlumno"
+	AlumnoService_UpdateAlumno_FullMethodName              = "/escolar.v1.AlumnoService/UpdateAlumno"
+	AlumnoService_DeleteAlumno_FullMethodName              = "/escolar.v1.AlumnoService/DeleteAlumno"
+	AlumnoService_SendEmail_FullMethodName                 = "/escolar.v1.AlumnoService/SendEmail"
+	AlumnoService_UploadFotoPerfil_FullMethodName          = "/escolar.v1.AlumnoService/UploadFotoPerfil"
+	AlumnoService_CreateFotoPerfilUploadUrl_FullMethodName = "/escolar.v1.AlumnoService/CreateFotoPerfilUploadUrl"
+	AlumnoService_ConfirmFotoPerfil_FullMethodName         = "/escolar.v1.AlumnoService/ConfirmFotoPerfil"
+	AlumnoService_DeleteFotoPerfil_FullMethodName          = "/escolar.v1.AlumnoService/DeleteFotoPerfil"
+	AlumnoService_ImportAlumnos_FullMethodName             = "/escolar.v1.AlumnoService/ImportAlumnos"
+	AlumnoService_ExportAlumnos_FullMethodName             = "/escolar.v1.AlumnoService/ExportAlumnos"
+	AlumnoService_BatchAlumnos_FullMethodName              = "/escolar.v1.AlumnoService/BatchAlumnos"
+)
+
+// AlumnoServiceClient is the client API for AlumnoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AlumnoService - Mismas operaciones que /v1/alumnos; requiere sesión
+type AlumnoServiceClient interface {
+	ListAlumnos(ctx context.Context, in *ListAlumnosRequest, opts ...grpc.CallOption) (*ListAlumnosResponse, error)
+	GetAlumno(ctx context.Context, in *GetAlumnoRequest, opts ...grpc.CallOption) (*Alumno, error)
+	// BatchGetAlumnos omite los IDs que no existen
+	BatchGetAlumnos(ctx context.Context, in *BatchGetAlumnosRequest, opts ...grpc.CallOption) (*ListAlumnosResponse, error)
+	CreateAlumno(ctx context.Context, in *CreateAlumnoRequest, opts ...grpc.CallOption) (*Alumno, error)
+	UpdateAlumno(ctx context.Context, in *UpdateAlumnoRequest, opts ...grpc.CallOption) (*Alumno, error)
+	DeleteAlumno(ctx context.Context, in *DeleteAlumnoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SendEmail(ctx context.Context, in *SendEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UploadFotoPerfil recibe primero el ID y después la imagen en partes
+	UploadFotoPerfil(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadFotoPerfilRequest, FotoPerfil], error)
+	CreateFotoPerfilUploadUrl(ctx context.Context, in *CreateFotoPerfilUploadUrlRequest, opts ...grpc.CallOption) (*FotoPerfilUpload, error)
+	ConfirmFotoPerfil(ctx context.Context, in *ConfirmFotoPerfilRequest, opts ...grpc.CallOption) (*FotoPerfil, error)
+	DeleteFotoPerfil(ctx context.Context, in *DeleteFotoPerfilRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ImportAlumnos(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ResultadoImportacion], error)
+	ExportAlumnos(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	BatchAlumnos(ctx context.Context, in *BatchAlumnosRequest, opts ...grpc.CallOption) (*ResultadoLote, error)
+}
+
+type alumnoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlumnoServiceClient(cc grpc.ClientConnInterface) AlumnoServiceClient {
+	return &alumnoServiceClient{cc}
+}
+
+func (c *alumnoServiceClient) ListAlumnos(ctx context.Context, in *ListAlumnosRequest, opts ...grpc.CallOption) (*ListAlumnosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlumnosResponse)
+	err := c.cc.Invoke(ctx, AlumnoService_ListAlumnos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) GetAlumno(ctx context.Context, in *GetAlumnoRequest, opts ...grpc.CallOption) (*Alumno, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alumno)
+	err := c.cc.Invoke(ctx, AlumnoService_GetAlumno_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) BatchGetAlumnos(ctx context.Context, in *BatchGetAlumnosRequest, opts ...grpc.CallOption) (*ListAlumnosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlumnosResponse)
+	err := c.cc.Invoke(ctx, AlumnoService_BatchGetAlumnos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) CreateAlumno(ctx context.Context, in *CreateAlumnoRequest, opts ...grpc.CallOption) (*Alumno, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alumno)
+	err := c.cc.Invoke(ctx, AlumnoService_CreateAlumno_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) UpdateAlumno(ctx context.Context, in *UpdateAlumnoRequest, opts ...grpc.CallOption) (*Alumno, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alumno)
+	err := c.cc.Invoke(ctx, AlumnoService_UpdateAlumno_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) DeleteAlumno(ctx context.Context, in *DeleteAlumnoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AlumnoService_DeleteAlumno_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) SendEmail(ctx context.Context, in *SendEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AlumnoService_SendEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) UploadFotoPerfil(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadFotoPerfilRequest, FotoPerfil], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AlumnoService_ServiceDesc.Streams[0], AlumnoService_UploadFotoPerfil_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadFotoPerfilRequest, FotoPerfil]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AlumnoService_UploadFotoPerfilClient = grpc.ClientStreamingClient[UploadFotoPerfilRequest, FotoPerfil]
+
+func (c *alumnoServiceClient) CreateFotoPerfilUploadUrl(ctx context.Context, in *CreateFotoPerfilUploadUrlRequest, opts ...grpc.CallOption) (*FotoPerfilUpload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FotoPerfilUpload)
+	err := c.cc.Invoke(ctx, AlumnoService_CreateFotoPerfilUploadUrl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) ConfirmFotoPerfil(ctx context.Context, in *ConfirmFotoPerfilRequest, opts ...grpc.CallOption) (*FotoPerfil, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FotoPerfil)
+	err := c.cc.Invoke(ctx, AlumnoService_ConfirmFotoPerfil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) DeleteFotoPerfil(ctx context.Context, in *DeleteFotoPerfilRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AlumnoService_DeleteFotoPerfil_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alumnoServiceClient) ImportAlumnos(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ResultadoImportacion], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AlumnoService_ServiceDesc.Streams[1], AlumnoService_ImportAlumnos_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRequest, ResultadoImportacion]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AlumnoService_ImportAlumnosClient = grpc.ClientStreamingClient[ImportRequest, ResultadoImportacion]
+
+func (c *alumnoServiceClient) ExportAlumnos(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AlumnoService_ServiceDesc.Streams[2], AlumnoService_ExportAlumnos_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AlumnoService_ExportAlumnosClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *alumnoServiceClient) BatchAlumnos(ctx context.Context, in *BatchAlumnosRequest, opts ...grpc.CallOption) (*ResultadoLote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultadoLote)
+	err := c.cc.Invoke(ctx, AlumnoService_BatchAlumnos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AlumnoServiceServer is the server API for AlumnoService service.
+// All implementations must embed UnimplementedAlumnoServiceServer
+// for forward compatibility.
+//
+// AlumnoService - Mismas operaciones que /v1/alumnos; requiere sesión
+type AlumnoServiceServer interface {
+	ListAlumnos(context.Context, *ListAlumnosRequest) (*ListAlumnosResponse, error)
+	GetAlumno(context.Context, *GetAlumnoRequest) (*Alumno, error)
+	// BatchGetAlumnos omite los IDs que no existen
+	BatchGetAlumnos(context.Context, *BatchGetAlumnosRequest) (*ListAlumnosResponse, error)
+	CreateAlumno(context.Context, *CreateAlumnoRequest) (*Alumno, error)
+	UpdateAlumno(context.Context, *UpdateAlumnoRequest) (*Alumno, error)
+	DeleteAlumno(context.Context, *DeleteAlumnoRequest) (*emptypb.Empty, error)
+	SendEmail(context.Context, *SendEmailRequest) (*emptypb.Empty, error)
+	// UploadFotoPerfil recibe primero el ID y después la imagen en partes
+	UploadFotoPerfil(grpc.ClientStreamingServer[UploadFotoPerfilRequest, FotoPerfil]) error
+	CreateFotoPerfilUploadUrl(context.Context, *CreateFotoPerfilUploadUrlRequest) (*FotoPerfilUpload, error)
+	ConfirmFotoPerfil(context.Context, *ConfirmFotoPerfilRequest) (*FotoPerfil, error)
+	DeleteFotoPerfil(context.Context, *DeleteFotoPerfilRequest) (*emptypb.Empty, error)
+	ImportAlumnos(grpc.ClientStreamingServer[ImportRequest, ResultadoImportacion]) error
+	ExportAlumnos(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	BatchAlumnos(context.Context, *BatchAlumnosRequest) (*ResultadoLote, error)
+	mustEmbedUnimplementedAlumnoServiceServer()
+}
+
+// UnimplementedAlumnoServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAlumnoServiceServer struct{}
+
+func (UnimplementedAlumnoServiceServer) ListAlumnos(context.Context, *ListAlumnosRequest) (*ListAlumnosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlumnos not implemented")
+}
+func (UnimplementedAlumnoServiceServer) GetAlumno(context.Context, *GetAlumnoRequest) (*Alumno, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAlumno not implemented")
+}
+func (UnimplementedAlumnoServiceServer) BatchGetAlumnos(context.Context, *BatchGetAlumnosRequest) (*ListAlumnosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetAlumnos not implemented")
+}
+func (UnimplementedAlumnoServiceServer) CreateAlumno(context.Context, *CreateAlumnoRequest) (*Alumno, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAlumno not implemented")
+}
+func (UnimplementedAlumnoServiceServer) UpdateAlumno(context.Context, *UpdateAlumnoRequest) (*Alumno, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAlumno not implemented")
+}
+func (UnimplementedAlumnoServiceServer) DeleteAlumno(context.Context, *DeleteAlumnoRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAlumno not implemented")
+}
+func (UnimplementedAlumnoServiceServer) SendEmail(context.Context, *SendEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendEmail not implemented")
+}
+func (UnimplementedAlumnoServiceServer) UploadFotoPerfil(grpc.ClientStreamingServer[UploadFotoPerfilRequest, FotoPerfil]) error {
+	return status.Error(codes.Unimplemented, "method UploadFotoPerfil not implemented")
+}
+func (UnimplementedAlumnoServiceServer) CreateFotoPerfilUploadUrl(context.Context, *CreateFotoPerfilUploadUrlRequest) (*FotoPerfilUpload, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFotoPerfilUploadUrl not implemented")
+}
+func (UnimplementedAlumnoServiceServer) ConfirmFotoPerfil(context.Context, *ConfirmFotoPerfilRequest) (*FotoPerfil, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmFotoPerfil not implemented")
+}
+func (UnimplementedAlumnoServiceServer) DeleteFotoPerfil(context.Context, *DeleteFotoPerfilRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFotoPerfil not implemented")
+}
+func (UnimplementedAlumnoServiceServer) ImportAlumnos(grpc.ClientStreamingServer[ImportRequest, ResultadoImportacion]) error {
+	return status.Error(codes.Unimplemented, "method ImportAlumnos not implemented")
+}
+func (UnimplementedAlumnoServiceServer) ExportAlumnos(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportAlumnos not implemented")
+}
+func (UnimplementedAlumnoServiceServer) BatchAlumnos(context.Context, *BatchAlumnosRequest) (*ResultadoLote, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchAlumnos not implemented")
+}
+func (UnimplementedAlumnoServiceServer) mustEmbedUnimplementedAlumnoServiceServer() {}
+func (UnimplementedAlumnoServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeAlumnoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlumnoServiceServer will
+// result in compilation errors.
+type UnsafeAlumnoServiceServer interface {
+	mustEmbedUnimplementedAlumnoServiceServer()
+}
+
+func RegisterAlumnoServiceServer(s grpc.ServiceRegistrar, srv AlumnoServiceServer) {
+	// If the following call panics, it indicates UnimplementedAlumnoServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AlumnoService_ServiceDesc, srv)
+}
+
+func _AlumnoService_ListAlumnos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlumnosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).ListAlumnos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_ListAlumnos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).ListAlumnos(ctx, req.(*ListAlumnosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_GetAlumno_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlumnoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).GetAlumno(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_GetAlumno_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).GetAlumno(ctx, req.(*GetAlumnoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_BatchGetAlumnos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAlumnosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).BatchGetAlumnos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_BatchGetAlumnos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).BatchGetAlumnos(ctx, req.(*BatchGetAlumnosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_CreateAlumno_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlumnoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).CreateAlumno(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_CreateAlumno_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).CreateAlumno(ctx, req.(*CreateAlumnoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_UpdateAlumno_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlumnoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).UpdateAlumno(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_UpdateAlumno_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).UpdateAlumno(ctx, req.(*UpdateAlumnoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_DeleteAlumno_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlumnoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).DeleteAlumno(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_DeleteAlumno_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).DeleteAlumno(ctx, req.(*DeleteAlumnoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_SendEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).SendEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_SendEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).SendEmail(ctx, req.(*SendEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_UploadFotoPerfil_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AlumnoServiceServer).UploadFotoPerfil(&grpc.GenericServerStream[UploadFotoPerfilRequest, FotoPerfil]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AlumnoService_UploadFotoPerfilServer = grpc.ClientStreamingServer[UploadFotoPerfilRequest, FotoPerfil]
+
+func _AlumnoService_CreateFotoPerfilUploadUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFotoPerfilUploadUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).CreateFotoPerfilUploadUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_CreateFotoPerfilUploadUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).CreateFotoPerfilUploadUrl(ctx, req.(*CreateFotoPerfilUploadUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_ConfirmFotoPerfil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmFotoPerfilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).ConfirmFotoPerfil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_ConfirmFotoPerfil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).ConfirmFotoPerfil(ctx, req.(*ConfirmFotoPerfilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_DeleteFotoPerfil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFotoPerfilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).DeleteFotoPerfil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_DeleteFotoPerfil_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).DeleteFotoPerfil(ctx, req.(*DeleteFotoPerfilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlumnoService_ImportAlumnos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AlumnoServiceServer).ImportAlumnos(&grpc.GenericServerStream[ImportRequest, ResultadoImportacion]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AlumnoService_ImportAlumnosServer = grpc.ClientStreamingServer[ImportRequest, ResultadoImportacion]
+
+func _AlumnoService_ExportAlumnos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlumnoServiceServer).ExportAlumnos(m, &grpc.GenericServerStream[ExportRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AlumnoService_ExportAlumnosServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _AlumnoService_BatchAlumnos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAlumnosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlumnoServiceServer).BatchAlumnos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlumnoService_BatchAlumnos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlumnoServiceServer).BatchAlumnos(ctx, req.(*BatchAlumnosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AlumnoService_ServiceDesc is the grpc.ServiceDesc for AlumnoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AlumnoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "escolar.v1.AlumnoService",
+	HandlerType: (*AlumnoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAlumnos",
+			Handler:    _AlumnoService_ListAlumnos_Handler,
+		},
+		{
+			MethodName: "GetAlumno",
+			Handler:    _AlumnoService_GetAlumno_Handler,
+		},
+		{
+			MethodName: "BatchGetAlumnos",
+			Handler:    _AlumnoService_BatchGetAlumnos_Handler,
+		},
+		{
+			MethodName: "CreateAlumno",
+			Handler:    _AlumnoService_CreateAlumno_Handler,
+		},
+		{
+			MethodName: "UpdateAlumno",
+			Handler:    _AlumnoService_UpdateAlumno_Handler,
+		},
+		{
+			MethodName: "DeleteAlumno",
+			Handler:    _AlumnoService_DeleteAlumno_Handler,
+		},
+		{
+			MethodName: "SendEmail",
+			Handler:    _AlumnoService_SendEmail_Handler,
+		},
+		{
+			MethodName: "CreateFotoPerfilUploadUrl",
+			Handler:    _AlumnoService_CreateFotoPerfilUploadUrl_Handler,
+		},
+		{
+			MethodName: "ConfirmFotoPerfil",
+			Handler:    _AlumnoService_ConfirmFotoPerfil_Handler,
+		},
+		{
+			MethodName: "DeleteFotoPerfil",
+			Handler:    _AlumnoService_DeleteFotoPerfil_Handler,
+		},
+		{
+			MethodName: "BatchAlumnos",
+			Handler:    _AlumnoService_BatchAlumnos_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFotoPerfil",
+			Handler:       _AlumnoService_UploadFotoPerfil_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImportAlumnos",
+			Handler:       _AlumnoService_ImportAlumnos_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportAlumnos",
+			Handler:       _AlumnoService_ExportAlumnos_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "alumno.proto",
+}
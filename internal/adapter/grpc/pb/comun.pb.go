@@ -0,0 +1,753 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: comun.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Error de un campo; code es el mismo de problem+json en REST
+type ValidationError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationError) Reset() {
+	*x = ValidationError{}
+	mi := &file_comun_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationError) ProtoMessage() {}
+
+func (x *ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_comun_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationError.ProtoReflect.Descriptor instead.
+func (*ValidationError) Descriptor() ([]byte, []int) {
+	return file_comun_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidationError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ValidationError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ValidationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Primer mensaje de una importación; los siguientes traen el archivo en partes
+type ImportOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // csv | xlsx
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_comun_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_comun_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_comun_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportOptions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Contenido:
+	//
+	//	*ImportRequest_Opciones
+	//	*ImportRequest_Chunk
+	Contenido     isImportRequest_Contenido `protobuf_oneof:"contenido"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	mi := &file_comun_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comun_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_comun_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportRequest) GetContenido() isImportRequest_Contenido {
+	if x != nil {
+		return x.Contenido
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetOpciones() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Contenido.(*ImportRequest_Opciones); ok {
+			return x.Opciones
+		}
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Contenido.(*ImportRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportRequest_Contenido interface {
+	isImportRequest_Contenido()
+}
+
+type ImportRequest_Opciones struct {
+	Opciones *ImportOptions `protobuf:"bytes,1,opt,name=opciones,proto3,oneof"`
+}
+
+type ImportRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportRequest_Opciones) isImportRequest_Contenido() {}
+
+func (*ImportRequest_Chunk) isImportRequest_Contenido() {}
+
+type FilaImportada struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fila          int32                  `protobuf:"varint,1,opt,name=fila,proto3" json:"fila,omitempty"` // número de fila en el archivo; el encabezado es la 1
+	Clave         string                 `protobuf:"bytes,2,opt,name=clave,proto3" json:"clave,omitempty"`
+	Accion        string                 `protobuf:"bytes,3,opt,name=accion,proto3" json:"accion,omitempty"`
+	Errores       []*ValidationError     `protobuf:"bytes,4,rep,name=errores,proto3" json:"errores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilaImportada) Reset() {
+	*x = FilaImportada{}
+	mi := &file_comun_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilaImportada) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilaImportada) ProtoMessage() {}
+
+func (x *FilaImportada) ProtoReflect() protoreflect.Message {
+	mi := &file_comun_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilaImportada.ProtoReflect.Descriptor instead.
+func (*FilaImportada) Descriptor() ([]byte, []int) {
+	return file_comun_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FilaImportada) GetFila() int32 {
+	if x != nil {
+		return x.Fila
+	}
+	return 0
+}
+
+func (x *FilaImportada) GetClave() string {
+	if x != nil {
+		return x.Clave
+	}
+	return ""
+}
+
+func (x *FilaImportada) GetAccion() string {
+	if x != nil {
+		return x.Accion
+	}
+	return ""
+}
+
+func (x *FilaImportada) GetErrores() []*ValidationError {
+	if x != nil {
+		return x.Errores
+	}
+	return nil
+}
+
+// Reporte de una importación. Si alguna fila tiene errores no se guarda ninguna
+type ResultadoImportacion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Aplicado      bool                   `protobuf:"varint,2,opt,name=aplicado,proto3" json:"aplicado,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Creados       int32                  `protobuf:"varint,4,opt,name=creados,proto3" json:"creados,omitempty"`
+	Actualizados  int32                  `protobuf:"varint,5,opt,name=actualizados,proto3" json:"actualizados,omitempty"`
+	ConErrores    int32                  `protobuf:"varint,6,opt,name=con_errores,json=conErrores,proto3" json:"con_errores,omitempty"`
+	Filas         []*FilaImportada       `protobuf:"bytes,7,rep,name=filas,proto3" json:"filas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultadoImportacion) Reset() {
+	*x = ResultadoImportacion{}
+	mi := &file_comun_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultadoImportacion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultadoImportacion) ProtoMessage() {}
+
+func (x *ResultadoImportacion) ProtoReflect() protoreflect.Message {
+	mi := &file_comun_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultadoImportacion.ProtoReflect.Descriptor instead.
+func (*ResultadoImportacion) Descriptor() ([]byte, []int) {
+	return file_comun_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResultadoImportacion) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ResultadoImportacion) GetAplicado() bool {
+	if x != nil {
+		return x.Aplicado
+	}
+	return false
+}
+
+func (x *ResultadoImportacion) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ResultadoImportacion) GetCreados() int32 {
+	if x != nil {
+		return x.Creados
+	}
+	return 0
+}
+
+func (x *ResultadoImportacion) GetActualizados() int32 {
+	if x != nil {
+		return x.Actualizados
+	}
+	return 0
+}
+
+func (x *ResultadoImportacion) GetConErrores() int32 {
+	if x != nil {
+		return x.ConErrores
+	}
+	return 0
+}
+
+func (x *ResultadoImportacion) GetFilas() []*FilaImportada {
+	if x != nil {
+		return x.Filas
+	}
+	return nil
+}
+
+type ExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // csv | xlsx | ndjson
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_comun_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comun_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_comun_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_comun_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_comun_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_comun_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ResultadoOperacion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Indice        int32                  `protobuf:"varint,1,opt,name=indice,proto3" json:"indice,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Id            uint32                 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Estado        string                 `protobuf:"bytes,4,opt,name=estado,proto3" json:"estado,omitempty"`
+	Code          string                 `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Errores       []*ValidationError     `protobuf:"bytes,7,rep,name=errores,proto3" json:"errores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultadoOperacion) Reset() {
+	*x = ResultadoOperacion{}
+	mi := &file_comun_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultadoOperacion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultadoOperacion) ProtoMessage() {}
+
+func (x *ResultadoOperacion) ProtoReflect() protoreflect.Message {
+	mi := &file_comun_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultadoOperacion.ProtoReflect.Descriptor instead.
+func (*ResultadoOperacion) Descriptor() ([]byte, []int) {
+	return file_comun_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResultadoOperacion) GetIndice() int32 {
+	if x != nil {
+		return x.Indice
+	}
+	return 0
+}
+
+func (x *ResultadoOperacion) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *ResultadoOperacion) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResultadoOperacion) GetEstado() string {
+	if x != nil {
+		return x.Estado
+	}
+	return ""
+}
+
+func (x *ResultadoOperacion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ResultadoOperacion) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ResultadoOperacion) GetErrores() []*ValidationError {
+	if x != nil {
+		return x.Errores
+	}
+	return nil
+}
+
+// Reporte por operación de un lote; modo es atomico o parcial
+type ResultadoLote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modo          string                 `protobuf:"bytes,1,opt,name=modo,proto3" json:"modo,omitempty"`
+	Aplicado      bool                   `protobuf:"varint,2,opt,name=aplicado,proto3" json:"aplicado,omitempty"`
+	Exitosas      int32                  `protobuf:"varint,3,opt,name=exitosas,proto3" json:"exitosas,omitempty"`
+	Fallidas      int32                  `protobuf:"varint,4,opt,name=fallidas,proto3" json:"fallidas,omitempty"`
+	Resultados    []*ResultadoOperacion  `protobuf:"bytes,5,rep,name=resultados,proto3" json:"resultados,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultadoLote) Reset() {
+	*x = ResultadoLote{}
+	mi := &file_comun_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultadoLote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultadoLote) ProtoMessage() {}
+
+func (x *ResultadoLote) ProtoReflect() protoreflect.Message {
+	mi := &file_comun_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultadoLote.ProtoReflect.Descriptor instead.
+func (*ResultadoLote) Descriptor() ([]byte, []int) {
+	return file_comun_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResultadoLote) GetModo() string {
+	if x != nil {
+		return x.Modo
+	}
+	return ""
+}
+
+func (x *ResultadoLote) GetAplicado() bool {
+	if x != nil {
+		return x.Aplicado
+	}
+	return false
+}
+
+func (x *ResultadoLote) GetExitosas() int32 {
+	if x != nil {
+		return x.Exitosas
+	}
+	return 0
+}
+
+func (x *ResultadoLote) GetFallidas() int32 {
+	if x != nil {
+		return x.Fallidas
+	}
+	return 0
+}
+
+func (x *ResultadoLote) GetResultados() []*ResultadoOperacion {
+	if x != nil {
+		return x.Resultados
+	}
+	return nil
+}
+
+var File_comun_proto protoreflect.FileDescriptor
+
+const file_comun_proto_rawDesc = "" +
+	"\n" +
+	"\vcomun.proto\x12\n" +
+	"escolar.v1\"U\n" +
+	"\x0fValidationError\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"@\n" +
+	"\rImportOptions\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"m\n" +
+	"\rImportRequest\x127\n" +
+	"\bopciones\x18\x01 \x01(\v2\x19.escolar.v1.ImportOptionsH\x00R\bopciones\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\v\n" +
+	"\tcontenido\"\x88\x01\n" +
+	"\rFilaImportada\x12\x12\n" +
+	"\x04fila\x18\x01 \x01(\x05R\x04fila\x12\x14\n" +
+	"\x05clave\x18\x02 \x01(\tR\x05clave\x12\x16\n" +
+	"\x06accion\x18\x03 \x01(\tR\x06accion\x125\n" +
+	"\aerrores\x18\x04 \x03(\v2\x1b.escolar.v1.ValidationErrorR\aerrores\"\xf1\x01\n" +
+	"\x14ResultadoImportacion\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x1a\n" +
+	"\baplicado\x18\x02 \x01(\bR\baplicado\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreados\x18\x04 \x01(\x05R\acreados\x12\"\n" +
+	"\factualizados\x18\x05 \x01(\x05R\factualizados\x12\x1f\n" +
+	"\vcon_errores\x18\x06 \x01(\x05R\n" +
+	"conErrores\x12/\n" +
+	"\x05filas\x18\a \x03(\v2\x19.escolar.v1.FilaImportadaR\x05filas\"'\n" +
+	"\rExportRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\"!\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xc5\x01\n" +
+	"\x12ResultadoOperacion\x12\x16\n" +
+	"\x06indice\x18\x01 \x01(\x05R\x06indice\x12\x0e\n" +
+	"\x02op\x18\x02 \x01(\tR\x02op\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\rR\x02id\x12\x16\n" +
+	"\x06estado\x18\x04 \x01(\tR\x06estado\x12\x12\n" +
+	"\x04code\x18\x05 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x125\n" +
+	"\aerrores\x18\a \x03(\v2\x1b.escolar.v1.ValidationErrorR\aerrores\"\xb7\x01\n" +
+	"\rResultadoLote\x12\x12\n" +
+	"\x04modo\x18\x01 \x01(\tR\x04modo\x12\x1a\n" +
+	"\baplicado\x18\x02 \x01(\bR\baplicado\x12\x1a\n" +
+	"\bexitosas\x18\x03 \x01(\x05R\bexitosas\x12\x1a\n" +
+	"\bfallidas\x18\x04 \x01(\x05R\bfallidas\x12>\n" +
+	"\n" +
+	"resultados\x18\x05 \x03(\v2\x1e.escolar.v1.ResultadoOperacionR\n" +
+	"resultadosBEZCgithub.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pbb\x06proto3"
+
+var (
+	file_comun_proto_rawDescOnce sync.Once
+	file_comun_proto_rawDescData []byte
+)
+
+func file_comun_proto_rawDescGZIP() []byte {
+	file_comun_proto_rawDescOnce.Do(func() {
+		file_comun_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_comun_proto_rawDesc), len(file_comun_proto_rawDesc)))
+	})
+	return file_comun_proto_rawDescData
+}
+
+var file_comun_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_comun_proto_goTypes = []any{
+	(*ValidationError)(nil),      // 0: escolar.v1.ValidationError
+	(*ImportOptions)(nil),        // 1: escolar.v1.ImportOptions
+	(*ImportRequest)(nil),        // 2: escolar.v1.ImportRequest
+	(*FilaImportada)(nil),        // 3: escolar.v1.FilaImportada
+	(*ResultadoImportacion)(nil), // 4: escolar.v1.ResultadoImportacion
+	(*ExportRequest)(nil),        // 5: escolar.v1.ExportRequest
+	(*ExportChunk)(nil),          // 6: escolar.v1.ExportChunk
+	(*ResultadoOperacion)(nil),   // 7: escolar.v1.ResultadoOperacion
+	(*ResultadoLote)(nil),        // 8: escolar.v1.ResultadoLote
+}
+var file_comun_proto_depIdxs = []int32{
+	1, // 0: escolar.v1.ImportRequest.opciones:type_name -> escolar.v1.ImportOptions
+	0, // 1: escolar.v1.FilaImportada.errores:type_name -> escolar.v1.ValidationError
+	3, // 2: escolar.v1.ResultadoImportacion.filas:type_name -> escolar.v1.FilaImportada
+	0, // 3: escolar.v1.ResultadoOperacion.errores:type_name -> escolar.v1.ValidationError
+	7, // 4: escolar.v1.ResultadoLote.resultados:type_name -> escolar.v1.ResultadoOperacion
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_comun_proto_init() }
+func file_comun_proto_init() {
+	if File_comun_proto != nil {
+		return
+	}
+	file_comun_proto_msgTypes[2].OneofWrappers = []any{
+		(*ImportRequest_Opciones)(nil),
+		(*ImportRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_comun_proto_rawDesc), len(file_comun_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_comun_proto_goTypes,
+		DependencyIndexes: file_comun_proto_depIdxs,
+		MessageInfos:      file_comun_proto_msgTypes,
+	}.Build()
+	File_comun_proto = out.File
+	file_comun_proto_goTypes = nil
+	file_comun_proto_depIdxs = nil
+}
@@ -0,0 +1,678 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: profesor.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Profesor struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NumeroEmpleado int32                  `protobuf:"varint,2,opt,name=numero_empleado,json=numeroEmpleado,proto3" json:"numero_empleado,omitempty"`
+	Nombres        string                 `protobuf:"bytes,3,opt,name=nombres,proto3" json:"nombres,omitempty"`
+	Apellidos      string                 `protobuf:"bytes,4,opt,name=apellidos,proto3" json:"apellidos,omitempty"`
+	HorasClase     int32                  `protobuf:"varint,5,opt,name=horas_clase,json=horasClase,proto3" json:"horas_clase,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Profesor) Reset() {
+	*x = Profesor{}
+	mi := &file_profesor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profesor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profesor) ProtoMessage() {}
+
+func (x *Profesor) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profesor.ProtoReflect.Descriptor instead.
+func (*Profesor) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profesor) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Profesor) GetNumeroEmpleado() int32 {
+	if x != nil {
+		return x.NumeroEmpleado
+	}
+	return 0
+}
+
+func (x *Profesor) GetNombres() string {
+	if x != nil {
+		return x.Nombres
+	}
+	return ""
+}
+
+func (x *Profesor) GetApellidos() string {
+	if x != nil {
+		return x.Apellidos
+	}
+	return ""
+}
+
+func (x *Profesor) GetHorasClase() int32 {
+	if x != nil {
+		return x.HorasClase
+	}
+	return 0
+}
+
+type ProfesorInput struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NumeroEmpleado int32                  `protobuf:"varint,1,opt,name=numero_empleado,json=numeroEmpleado,proto3" json:"numero_empleado,omitempty"`
+	Nombres        string                 `protobuf:"bytes,2,opt,name=nombres,proto3" json:"nombres,omitempty"`
+	Apellidos      string                 `protobuf:"bytes,3,opt,name=apellidos,proto3" json:"apellidos,omitempty"`
+	HorasClase     int32                  `protobuf:"varint,4,opt,name=horas_clase,json=horasClase,proto3" json:"horas_clase,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProfesorInput) Reset() {
+	*x = ProfesorInput{}
+	mi := &file_profesor_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfesorInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfesorInput) ProtoMessage() {}
+
+func (x *ProfesorInput) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfesorInput.ProtoReflect.Descriptor instead.
+func (*ProfesorInput) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProfesorInput) GetNumeroEmpleado() int32 {
+	if x != nil {
+		return x.NumeroEmpleado
+	}
+	return 0
+}
+
+func (x *ProfesorInput) GetNombres() string {
+	if x != nil {
+		return x.Nombres
+	}
+	return ""
+}
+
+func (x *ProfesorInput) GetApellidos() string {
+	if x != nil {
+		return x.Apellidos
+	}
+	return ""
+}
+
+func (x *ProfesorInput) GetHorasClase() int32 {
+	if x != nil {
+		return x.HorasClase
+	}
+	return 0
+}
+
+type ListProfesoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfesoresRequest) Reset() {
+	*x = ListProfesoresRequest{}
+	mi := &file_profesor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfesoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfesoresRequest) ProtoMessage() {}
+
+func (x *ListProfesoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfesoresRequest.ProtoReflect.Descriptor instead.
+func (*ListProfesoresRequest) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{2}
+}
+
+type ListProfesoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profesores    []*Profesor            `protobuf:"bytes,1,rep,name=profesores,proto3" json:"profesores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfesoresResponse) Reset() {
+	*x = ListProfesoresResponse{}
+	mi := &file_profesor_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfesoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfesoresResponse) ProtoMessage() {}
+
+func (x *ListProfesoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfesoresResponse.ProtoReflect.Descriptor instead.
+func (*ListProfesoresResponse) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListProfesoresResponse) GetProfesores() []*Profesor {
+	if x != nil {
+		return x.Profesores
+	}
+	return nil
+}
+
+type GetProfesorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfesorRequest) Reset() {
+	*x = GetProfesorRequest{}
+	mi := &file_profesor_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfesorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfesorRequest) ProtoMessage() {}
+
+func (x *GetProfesorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfesorRequest.ProtoReflect.Descriptor instead.
+func (*GetProfesorRequest) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProfesorRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateProfesorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profesor      *ProfesorInput         `protobuf:"bytes,1,opt,name=profesor,proto3" json:"profesor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProfesorRequest) Reset() {
+	*x = CreateProfesorRequest{}
+	mi := &file_profesor_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProfesorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfesorRequest) ProtoMessage() {}
+
+func (x *CreateProfesorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfesorRequest.ProtoReflect.Descriptor instead.
+func (*CreateProfesorRequest) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateProfesorRequest) GetProfesor() *ProfesorInput {
+	if x != nil {
+		return x.Profesor
+	}
+	return nil
+}
+
+type UpdateProfesorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Profesor      *ProfesorInput         `protobuf:"bytes,2,opt,name=profesor,proto3" json:"profesor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfesorRequest) Reset() {
+	*x = UpdateProfesorRequest{}
+	mi := &file_profesor_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfesorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfesorRequest) ProtoMessage() {}
+
+func (x *UpdateProfesorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfesorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfesorRequest) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateProfesorRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProfesorRequest) GetProfesor() *ProfesorInput {
+	if x != nil {
+		return x.Profesor
+	}
+	return nil
+}
+
+type DeleteProfesorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProfesorRequest) Reset() {
+	*x = DeleteProfesorRequest{}
+	mi := &file_profesor_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProfesorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfesorRequest) ProtoMessage() {}
+
+func (x *DeleteProfesorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfesorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProfesorRequest) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteProfesorRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type OperacionProfesor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"` // crear | actualizar | eliminar
+	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Datos         *ProfesorInput         `protobuf:"bytes,3,opt,name=datos,proto3" json:"datos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperacionProfesor) Reset() {
+	*x = OperacionProfesor{}
+	mi := &file_profesor_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperacionProfesor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperacionProfesor) ProtoMessage() {}
+
+func (x *OperacionProfesor) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperacionProfesor.ProtoReflect.Descriptor instead.
+func (*OperacionProfesor) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OperacionProfesor) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *OperacionProfesor) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OperacionProfesor) GetDatos() *ProfesorInput {
+	if x != nil {
+		return x.Datos
+	}
+	return nil
+}
+
+type BatchProfesoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modo          string                 `protobuf:"bytes,1,opt,name=modo,proto3" json:"modo,omitempty"` // atomico (por defecto) | parcial
+	Operaciones   []*OperacionProfesor   `protobuf:"bytes,2,rep,name=operaciones,proto3" json:"operaciones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProfesoresRequest) Reset() {
+	*x = BatchProfesoresRequest{}
+	mi := &file_profesor_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProfesoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProfesoresRequest) ProtoMessage() {}
+
+func (x *BatchProfesoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profesor_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProfesoresRequest.ProtoReflect.Descriptor instead.
+func (*BatchProfesoresRequest) Descriptor() ([]byte, []int) {
+	return file_profesor_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchProfesoresRequest) GetModo() string {
+	if x != nil {
+		return x.Modo
+	}
+	return ""
+}
+
+func (x *BatchProfesoresRequest) GetOperaciones() []*OperacionProfesor {
+	if x != nil {
+		return x.Operaciones
+	}
+	return nil
+}
+
+var File_profesor_proto protoreflect.FileDescriptor
+
+const file_profesor_proto_rawDesc = "" +
+	"\n" +
+	"\x0eprofesor.proto\x12\n" +
+	"escolar.v1\x1a\vcomun.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x9c\x01\n" +
+	"\bProfesor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12'\n" +
+	"\x0fnumero_empleado\x18\x02 \x01(\x05R\x0enumeroEmpleado\x12\x18\n" +
+	"\anombres\x18\x03 \x01(\tR\anombres\x12\x1c\n" +
+	"\tapellidos\x18\x04 \x01(\tR\tapellidos\x12\x1f\n" +
+	"\vhoras_clase\x18\x05 \x01(\x05R\n" +
+	"horasClase\"\x91\x01\n" +
+	"\rProfesorInput\x12'\n" +
+	"\x0fnumero_empleado\x18\x01 \x01(\x05R\x0enumeroEmpleado\x12\x18\n" +
+	"\anombres\x18\x02 \x01(\tR\anombres\x12\x1c\n" +
+	"\tapellidos\x18\x03 \x01(\tR\tapellidos\x12\x1f\n" +
+	"\vhoras_clase\x18\x04 \x01(\x05R\n" +
+	"horasClase\"\x17\n" +
+	"\x15ListProfesoresRequest\"N\n" +
+	"\x16ListProfesoresResponse\x124\n" +
+	"\n" +
+	"profesores\x18\x01 \x03(\v2\x14.escolar.v1.ProfesorR\n" +
+	"profesores\"$\n" +
+	"\x12GetProfesorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"N\n" +
+	"\x15CreateProfesorRequest\x125\n" +
+	"\bprofesor\x18\x01 \x01(\v2\x19.escolar.v1.ProfesorInputR\bprofesor\"^\n" +
+	"\x15UpdateProfesorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x125\n" +
+	"\bprofesor\x18\x02 \x01(\v2\x19.escolar.v1.ProfesorInputR\bprofesor\"'\n" +
+	"\x15DeleteProfesorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"d\n" +
+	"\x11OperacionProfesor\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\x12/\n" +
+	"\x05datos\x18\x03 \x01(\v2\x19.escolar.v1.ProfesorInputR\x05datos\"m\n" +
+	"\x16BatchProfesoresRequest\x12\x12\n" +
+	"\x04modo\x18\x01 \x01(\tR\x04modo\x12?\n" +
+	"\voperaciones\x18\x02 \x03(\v2\x1d.escolar.v1.OperacionProfesorR\voperaciones2\x81\x05\n" +
+	"\x0fProfesorService\x12W\n" +
+	"\x0eListProfesores\x12!.escolar.v1.ListProfesoresRequest\x1a\".escolar.v1.ListProfesoresResponse\x12C\n" +
+	"\vGetProfesor\x12\x1e.escolar.v1.GetProfesorRequest\x1a\x14.escolar.v1.Profesor\x12I\n" +
+	"\x0eCreateProfesor\x12!.escolar.v1.CreateProfesorRequest\x1a\x14.escolar.v1.Profesor\x12I\n" +
+	"\x0eUpdateProfesor\x12!.escolar.v1.UpdateProfesorRequest\x1a\x14.escolar.v1.Profesor\x12K\n" +
+	"\x0eDeleteProfesor\x12!.escolar.v1.DeleteProfesorRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x10ImportProfesores\x12\x19.escolar.v1.ImportRequest\x1a .escolar.v1.ResultadoImportacion(\x01\x12H\n" +
+	"\x10ExportProfesores\x12\x19.escolar.v1.ExportRequest\x1a\x17.escolar.v1.ExportChunk0\x01\x12P\n" +
+	"\x0fBatchProfesores\x12\".escolar.v1.BatchProfesoresRequest\x1a\x19.escolar.v1.ResultadoLoteBEZCgithub.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pbb\x06proto3"
+
+var (
+	file_profesor_proto_rawDescOnce sync.Once
+	file_profesor_proto_rawDescData []byte
+)
+
+func file_profesor_proto_rawDescGZIP() []byte {
+	file_profesor_proto_rawDescOnce.Do(func() {
+		file_profesor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_profesor_proto_rawDesc), len(file_profesor_proto_rawDesc)))
+	})
+	return file_profesor_proto_rawDescData
+}
+
+var file_profesor_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_profesor_proto_goTypes = []any{
+	(*Profesor)(nil),               // 0: escolar.v1.Profesor
+	(*ProfesorInput)(nil),          // 1: escolar.v1.ProfesorInput
+	(*ListProfesoresRequest)(nil),  // 2: escolar.v1.ListProfesoresRequest
+	(*ListProfesoresResponse)(nil), // 3: escolar.v1.ListProfesoresResponse
+	(*GetProfesorRequest)(nil),     // 4: escolar.v1.GetProfesorRequest
+	(*CreateProfesorRequest)(nil),  // 5: escolar.v1.CreateProfesorRequest
+	(*UpdateProfesorRequest)(nil),  // 6: escolar.v1.UpdateProfesorRequest
+	(*DeleteProfesorRequest)(nil),  // 7: escolar.v1.DeleteProfesorRequest
+	(*OperacionProfesor)(nil),      // 8: escolar.v1.OperacionProfesor
+	(*BatchProfesoresRequest)(nil), // 9: escolar.v1.BatchProfesoresRequest
+	(*ImportRequest)(nil),          // 10: escolar.v1.ImportRequest
+	(*ExportRequest)(nil),          // 11: escolar.v1.ExportRequest
+	(*emptypb.Empty)(nil),          // 12: google.protobuf.Empty
+	(*ResultadoImportacion)(nil),   // 13: escolar.v1.ResultadoImportacion
+	(*ExportChunk)(nil),            // 14: escolar.v1.ExportChunk
+	(*ResultadoLote)(nil),          // 15: escolar.v1.ResultadoLote
+}
+var file_profesor_proto_depIdxs = []int32{
+	0,  // 0: escolar.v1.ListProfesoresResponse.profesores:type_name -> escolar.v1.Profesor
+	1,  // 1: escolar.v1.CreateProfesorRequest.profesor:type_name -> escolar.v1.ProfesorInput
+	1,  // 2: escolar.v1.UpdateProfesorRequest.profesor:type_name -> escolar.v1.ProfesorInput
+	1,  // 3: escolar.v1.OperacionProfesor.datos:type_name -> escolar.v1.ProfesorInput
+	8,  // 4: escolar.v1.BatchProfesoresRequest.operaciones:type_name -> escolar.v1.OperacionProfesor
+	2,  // 5: escolar.v1.ProfesorService.ListProfesores:input_type -> escolar.v1.ListProfesoresRequest
+	4,  // 6: escolar.v1.ProfesorService.GetProfesor:input_type -> escolar.v1.GetProfesorRequest
+	5,  // 7: escolar.v1.ProfesorService.CreateProfesor:input_type -> escolar.v1.CreateProfesorRequest
+	6,  // 8: escolar.v1.ProfesorService.UpdateProfesor:input_type -> escolar.v1.UpdateProfesorRequest
+	7,  // 9: escolar.v1.ProfesorService.DeleteProfesor:input_type -> escolar.v1.DeleteProfesorRequest
+	10, // 10: escolar.v1.ProfesorService.ImportProfesores:input_type -> escolar.v1.ImportRequest
+	11, // 11: escolar.v1.ProfesorService.ExportProfesores:input_type -> escolar.v1.ExportRequest
+	9,  // 12: escolar.v1.ProfesorService.BatchProfesores:input_type -> escolar.v1.BatchProfesoresRequest
+	3,  // 13: escolar.v1.ProfesorService.ListProfesores:output_type -> escolar.v1.ListProfesoresResponse
+	0,  // 14: escolar.v1.ProfesorService.GetProfesor:output_type -> escolar.v1.Profesor
+	0,  // 15: escolar.v1.ProfesorService.CreateProfesor:output_type -> escolar.v1.Profesor
+	0,  // 16: escolar.v1.ProfesorService.UpdateProfesor:output_type -> escolar.v1.Profesor
+	12, // 17: escolar.v1.ProfesorService.DeleteProfesor:output_type -> google.protobuf.Empty
+	13, // 18: escolar.v1.ProfesorService.ImportProfesores:output_type -> escolar.v1.ResultadoImportacion
+	14, // 19: escolar.v1.ProfesorService.ExportProfesores:output_type -> escolar.v1.ExportChunk
+	15, // 20: escolar.v1.ProfesorService.BatchProfesores:output_type -> escolar.v1.ResultadoLote
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_profesor_proto_init() }
+func file_profesor_proto_init() {
+	if File_profesor_proto != nil {
+		return
+	}
+	file_comun_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_profesor_proto_rawDesc), len(file_profesor_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_profesor_proto_goTypes,
+		DependencyIndexes: file_profesor_proto_depIdxs,
+		MessageInfos:      file_profesor_proto_msgTypes,
+	}.Build()
+	File_profesor_proto = out.File
+	file_profesor_proto_goTypes = nil
+	file_profesor_proto_depIdxs = nil
+}
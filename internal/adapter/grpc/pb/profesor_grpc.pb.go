@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: profesor.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ProfesorService_ListProfesores_FullMethodName   = "/escolar.v1.ProfesorService/ListProfesores"
+	ProfesorService_GetProfesor_FullMethodName      = "/escolar.v1.ProfesorService/GetProfesor"
+	ProfesorService_CreateProfesor_FullMethodName   = "/escolar.v1.ProfesorService/CreateProfesor"
+	ProfesorService_UpdateProfesor_FullMethodName   = "/escolar.v1.ProfesorService/UpdateProfesor"
+	ProfesorService_DeleteProfesor_FullMethodName   = "/escolar.v1.ProfesorService/DeleteProfesor"
+	ProfesorService_ImportProfesores_FullMethodName = "/escolar.v1.ProfesorService/ImportProfesores"
+	ProfesorService_ExportProfesores_FullMethodName = "/escolar.v1.ProfesorService/ExportProfesores"
+	ProfesorService_BatchProfesores_FullMethodName  = "/escolar.v1.ProfesorService/BatchProfesores"
+)
+
+// ProfesorServiceClient is the client API for ProfesorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ProfesorService - Mismas operaciones que /v1/profesores; requiere sesión
+type ProfesorServiceClient interface {
+	ListProfesores(ctx context.Context, in *ListProfesoresRequest, opts ...grpc.CallOption) (*ListProfesoresResponse, error)
+	GetProfesor(ctx context.Context, in *GetProfesorRequest, opts ...grpc.CallOption) (*Profesor, error)
+	CreateProfesor(ctx context.Context, in *CreateProfesorRequest, opts ...grpc.CallOption) (*Profesor, error)
+	UpdateProfesor(ctx context.Context, in *UpdateProfesorRequest, opts ...grpc.CallOption) (*Profesor, error)
+	DeleteProfesor(ctx context.Context, in *DeleteProfesorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ImportProfesores(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ResultadoImportacion], error)
+	ExportProfesores(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	BatchProfesores(ctx context.Context, in *BatchProfesoresRequest, opts ...grpc.CallOption) (*ResultadoLote, error)
+}
+
+type profesorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProfesorServiceClient(cc grpc.ClientConnInterface) ProfesorServiceClient {
+	return &profesorServiceClient{cc}
+}
+
+func (c *profesorServiceClient) ListProfesores(ctx context.Context, in *ListProfesoresRequest, opts ...grpc.CallOption) (*ListProfesoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProfesoresResponse)
+	err := c.cc.Invoke(ctx, ProfesorService_ListProfesores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profesorServiceClient) GetProfesor(ctx context.Context, in *GetProfesorRequest, opts ...grpc.CallOption) (*Profesor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Profesor)
+	err := c.cc.Invoke(ctx, ProfesorService_GetProfesor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profesorServiceClient) CreateProfesor(ctx context.Context, in *CreateProfesorRequest, opts ...grpc.CallOption) (*Profesor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Profesor)
+	err := c.cc.Invoke(ctx, ProfesorService_CreateProfesor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profesorServiceClient) UpdateProfesor(ctx context.Context, in *UpdateProfesorRequest, opts ...grpc.CallOption) (*Profesor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Profesor)
+	err := c.cc.Invoke(ctx, ProfesorService_UpdateProfesor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profesorServiceClient) DeleteProfesor(ctx context.Context, in *DeleteProfesorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProfesorService_DeleteProfesor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profesorServiceClient) ImportProfesores(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ResultadoImportacion], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProfesorService_ServiceDesc.Streams[0], ProfesorService_ImportProfesores_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRequest, ResultadoImportacion]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProfesorService_ImportProfesoresClient = grpc.ClientStreamingClient[ImportRequest, ResultadoImportacion]
+
+func (c *profesorServiceClient) ExportProfesores(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProfesorService_ServiceDesc.Streams[1], ProfesorService_ExportProfesores_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProfesorService_ExportProfesoresClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *profesorServiceClient) BatchProfesores(ctx context.Context, in *BatchProfesoresRequest, opts ...grpc.CallOption) (*ResultadoLote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResultadoLote)
+	err := c.cc.Invoke(ctx, ProfesorService_BatchProfesores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfesorServiceServer is the server API for ProfesorService service.
+// All implementations must embed UnimplementedProfesorServiceServer
+// for forward compatibility.
+//
+// ProfesorService - Mismas operaciones que /v1/profesores; requiere sesión
+type ProfesorServiceServer interface {
+	ListProfesores(context.Context, *ListProfesoresRequest) (*ListProfesoresResponse, error)
+	GetProfesor(context.Context, *GetProfesorRequest) (*Profesor, error)
+	CreateProfesor(context.Context, *CreateProfesorRequest) (*Profesor, error)
+	UpdateProfesor(context.Context, *UpdateProfesorRequest) (*Profesor, error)
+	DeleteProfesor(context.Context, *DeleteProfesorRequest) (*emptypb.Empty, error)
+	ImportProfesores(grpc.ClientStreamingServer[ImportRequest, ResultadoImportacion]) error
+	ExportProfesores(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	BatchProfesores(context.Context, *BatchProfesoresRequest) (*ResultadoLote, error)
+	mustEmbedUnimplementedProfesorServiceServer()
+}
+
+// UnimplementedProfesorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProfesorServiceServer struct{}
+
+func (UnimplementedProfesorServiceServer) ListProfesores(context.Context, *ListProfesoresRequest) (*ListProfesoresResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProfesores not implemented")
+}
+func (UnimplementedProfesorServiceServer) GetProfesor(context.Context, *GetProfesorRequest) (*Profesor, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProfesor not implemented")
+}
+func (UnimplementedProfesorServiceServer) CreateProfesor(context.Context, *CreateProfesorRequest) (*Profesor, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProfesor not implemented")
+}
+func (UnimplementedProfesorServiceServer) UpdateProfesor(context.Context, *UpdateProfesorRequest) (*Profesor, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProfesor not implemented")
+}
+func (UnimplementedProfesorServiceServer) DeleteProfesor(context.Context, *DeleteProfesorRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProfesor not implemented")
+}
+func (UnimplementedProfesorServiceServer) ImportProfesores(grpc.ClientStreamingServer[ImportRequest, ResultadoImportacion]) error {
+	return status.Error(codes.Unimplemented, "method ImportProfesores not implemented")
+}
+func (UnimplementedProfesorServiceServer) ExportProfesores(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportProfesores not implemented")
+}
+func (UnimplementedProfesorServiceServer) BatchProfesores(context.Context, *BatchProfesoresRequest) (*ResultadoLote, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchProfesores not implemented")
+}
+func (UnimplementedProfesorServiceServer) mustEmbedUnimplementedProfesorServiceServer() {}
+func (UnimplementedProfesorServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeProfesorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfesorServiceServer will
+// result in compilation errors.
+type UnsafeProfesorServiceServer interface {
+	mustEmbedUnimplementedProfesorServiceServer()
+}
+
+func RegisterProfesorServiceServer(s grpc.ServiceRegistrar, srv ProfesorServiceServer) {
+	// If the following call panics, it indicates UnimplementedProfesorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProfesorService_ServiceDesc, srv)
+}
+
+func _ProfesorService_ListProfesores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfesoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfesorServiceServer).ListProfesores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfesorService_ListProfesores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfesorServiceServer).ListProfesores(ctx, req.(*ListProfesoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfesorService_GetProfesor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfesorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfesorServiceServer).GetProfesor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfesorService_GetProfesor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfesorServiceServer).GetProfesor(ctx, req.(*GetProfesorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfesorService_CreateProfesor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfesorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfesorServiceServer).CreateProfesor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfesorService_CreateProfesor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfesorServiceServer).CreateProfesor(ctx, req.(*CreateProfesorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfesorService_UpdateProfesor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfesorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfesorServiceServer).UpdateProfesor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfesorService_UpdateProfesor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfesorServiceServer).UpdateProfesor(ctx, req.(*UpdateProfesorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfesorService_DeleteProfesor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfesorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfesorServiceServer).DeleteProfesor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfesorService_DeleteProfesor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfesorServiceServer).DeleteProfesor(ctx, req.(*DeleteProfesorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfesorService_ImportProfesores_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProfesorServiceServer).ImportProfesores(&grpc.GenericServerStream[ImportRequest, ResultadoImportacion]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProfesorService_ImportProfesoresServer = grpc.ClientStreamingServer[ImportRequest, ResultadoImportacion]
+
+func _ProfesorService_ExportProfesores_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProfesorServiceServer).ExportProfesores(m, &grpc.GenericServerStream[ExportRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProfesorService_ExportProfesoresServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _ProfesorService_BatchProfesores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchProfesoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfesorServiceServer).BatchProfesores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfesorService_BatchProfesores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfesorServiceServer).BatchProfesores(ctx, req.(*BatchProfesoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProfesorService_ServiceDesc is the grpc.ServiceDesc for ProfesorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProfesorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "escolar.v1.ProfesorService",
+	HandlerType: (*ProfesorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProfesores",
+			Handler:    _ProfesorService_ListProfesores_Handler,
+		},
+		{
+			MethodName: "GetProfesor",
+			Handler:    _ProfesorService_GetProfesor_Handler,
+		},
+		{
+			MethodName: "CreateProfesor",
+			Handler:    _ProfesorService_CreateProfesor_Handler,
+		},
+		{
+			MethodName: "UpdateProfesor",
+			Handler:    _ProfesorService_UpdateProfesor_Handler,
+		},
+		{
+			MethodName: "DeleteProfesor",
+			Handler:    _ProfesorService_DeleteProfesor_Handler,
+		},
+		{
+			MethodName: "BatchProfesores",
+			Handler:    _ProfesorService_BatchProfesores_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProfesores",
+			Handler:       _ProfesorService_ImportProfesores_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportProfesores",
+			Handler:       _ProfesorService_ExportProfesores_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "profesor.proto",
+}
@@ -0,0 +1,342 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: sesion.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Sesion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fecha         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=fecha,proto3" json:"fecha,omitempty"`
+	AlumnoId      uint32                 `protobuf:"varint,3,opt,name=alumno_id,json=alumnoId,proto3" json:"alumno_id,omitempty"`
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	SessionString string                 `protobuf:"bytes,5,opt,name=session_string,json=sessionString,proto3" json:"session_string,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sesion) Reset() {
+	*x = Sesion{}
+	mi := &file_sesion_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sesion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sesion) ProtoMessage() {}
+
+func (x *Sesion) ProtoReflect() protoreflect.Message {
+	mi := &file_sesion_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sesion.ProtoReflect.Descriptor instead.
+func (*Sesion) Descriptor() ([]byte, []int) {
+	return file_sesion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Sesion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sesion) GetFecha() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Fecha
+	}
+	return nil
+}
+
+func (x *Sesion) GetAlumnoId() uint32 {
+	if x != nil {
+		return x.AlumnoId
+	}
+	return 0
+}
+
+func (x *Sesion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Sesion) GetSessionString() string {
+	if x != nil {
+		return x.SessionString
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlumnoId      uint32                 `protobuf:"varint,1,opt,name=alumno_id,json=alumnoId,proto3" json:"alumno_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_sesion_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sesion_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_sesion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoginRequest) GetAlumnoId() uint32 {
+	if x != nil {
+		return x.AlumnoId
+	}
+	return 0
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type VerifyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlumnoId      uint32                 `protobuf:"varint,1,opt,name=alumno_id,json=alumnoId,proto3" json:"alumno_id,omitempty"`
+	SessionString string                 `protobuf:"bytes,2,opt,name=session_string,json=sessionString,proto3" json:"session_string,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyRequest) Reset() {
+	*x = VerifyRequest{}
+	mi := &file_sesion_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRequest) ProtoMessage() {}
+
+func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sesion_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRequest) Descriptor() ([]byte, []int) {
+	return file_sesion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VerifyRequest) GetAlumnoId() uint32 {
+	if x != nil {
+		return x.AlumnoId
+	}
+	return 0
+}
+
+func (x *VerifyRequest) GetSessionString() string {
+	if x != nil {
+		return x.SessionString
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlumnoId      uint32                 `protobuf:"varint,1,opt,name=alumno_id,json=alumnoId,proto3" json:"alumno_id,omitempty"`
+	SessionString string                 `protobuf:"bytes,2,opt,name=session_string,json=sessionString,proto3" json:"session_string,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_sesion_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sesion_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_sesion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LogoutRequest) GetAlumnoId() uint32 {
+	if x != nil {
+		return x.AlumnoId
+	}
+	return 0
+}
+
+func (x *LogoutRequest) GetSessionString() string {
+	if x != nil {
+		return x.SessionString
+	}
+	return ""
+}
+
+var File_sesion_proto protoreflect.FileDescriptor
+
+const file_sesion_proto_rawDesc = "" +
+	"\n" +
+	"\fsesion.proto\x12\n" +
+	"escolar.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x01\n" +
+	"\x06Sesion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x05fecha\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05fecha\x12\x1b\n" +
+	"\talumno_id\x18\x03 \x01(\rR\balumnoId\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x12%\n" +
+	"\x0esession_string\x18\x05 \x01(\tR\rsessionString\"G\n" +
+	"\fLoginRequest\x12\x1b\n" +
+	"\talumno_id\x18\x01 \x01(\rR\balumnoId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"S\n" +
+	"\rVerifyRequest\x12\x1b\n" +
+	"\talumno_id\x18\x01 \x01(\rR\balumnoId\x12%\n" +
+	"\x0esession_string\x18\x02 \x01(\tR\rsessionString\"S\n" +
+	"\rLogoutRequest\x12\x1b\n" +
+	"\talumno_id\x18\x01 \x01(\rR\balumnoId\x12%\n" +
+	"\x0esession_string\x18\x02 \x01(\tR\rsessionString2\xc0\x01\n" +
+	"\rSesionService\x125\n" +
+	"\x05Login\x12\x18.escolar.v1.LoginRequest\x1a\x12.escolar.v1.Sesion\x12;\n" +
+	"\x06Verify\x12\x19.escolar.v1.VerifyRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\x06Logout\x12\x19.escolar.v1.LogoutRequest\x1a\x16.google.protobuf.EmptyBEZCgithub.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pbb\x06proto3"
+
+var (
+	file_sesion_proto_rawDescOnce sync.Once
+	file_sesion_proto_rawDescData []byte
+)
+
+func file_sesion_proto_rawDescGZIP() []byte {
+	file_sesion_proto_rawDescOnce.Do(func() {
+		file_sesion_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sesion_proto_rawDesc), len(file_sesion_proto_rawDesc)))
+	})
+	return file_sesion_proto_rawDescData
+}
+
+var file_sesion_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_sesion_proto_goTypes = []any{
+	(*Sesion)(nil),                // 0: escolar.v1.Sesion
+	(*LoginRequest)(nil),          // 1: escolar.v1.LoginRequest
+	(*VerifyRequest)(nil),         // 2: escolar.v1.VerifyRequest
+	(*LogoutRequest)(nil),         // 3: escolar.v1.LogoutRequest
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 5: google.protobuf.Empty
+}
+var file_sesion_proto_depIdxs = []int32{
+	4, // 0: escolar.v1.Sesion.fecha:type_name -> google.protobuf.Timestamp
+	1, // 1: escolar.v1.SesionService.Login:input_type -> escolar.v1.LoginRequest
+	2, // 2: escolar.v1.SesionService.Verify:input_type -> escolar.v1.VerifyRequest
+	3, // 3: escolar.v1.SesionService.Logout:input_type -> escolar.v1.LogoutRequest
+	0, // 4: escolar.v1.SesionService.Login:output_type -> escolar.v1.Sesion
+	5, // 5: escolar.v1.SesionService.Verify:output_type -> google.protobuf.Empty
+	5, // 6: escolar.v1.SesionService.Logout:output_type -> google.protobuf.Empty
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_sesion_proto_init() }
+func file_sesion_proto_init() {
+	if File_sesion_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sesion_proto_rawDesc), len(file_sesion_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sesion_proto_goTypes,
+		DependencyIndexes: file_sesion_proto_depIdxs,
+		MessageInfos:      file_sesion_proto_msgTypes,
+	}.Build()
+	File_sesion_proto = out.File
+	file_sesion_proto_goTypes = nil
+	file_sesion_proto_depIdxs = nil
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pb"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type profesorServer struct {
+	pb.UnimplementedProfesorServiceServer
+	service port.ProfesorService
+}
+
+func (s *profesorServer) ListProfesores(ctx context.Context, _ *pb.ListProfesoresRequest) (*pb.ListProfesoresResponse, error) {
+	profesores, err := s.service.GetAll(ctx)
+	if err != nil {
+		return nil, toStatus(ctx, "ListProfesores", err)
+	}
+	out := make([]*pb.Profesor, len(profesores))
+	for i := range profesores {
+		out[i] = toProfesor(&profesores[i])
+	}
+	return &pb.ListProfesoresResponse{Profesores: out}, nil
+}
+
+func (s *profesorServer) GetProfesor(ctx context.Context, req *pb.GetProfesorRequest) (*pb.Profesor, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "GetProfesor", err)
+	}
+	profesor, err := s.service.GetByID(ctx, id)
+	if err != nil {
+		return nil, toStatus(ctx, "GetProfesor", err)
+	}
+	return toProfesor(profesor), nil
+}
+
+func (s *profesorServer) CreateProfesor(ctx context.Context, req *pb.CreateProfesorRequest) (*pb.Profesor, error) {
+	profesor := fromProfesorInput(req.GetProfesor())
+	if err := s.service.Create(ctx, profesor); err != nil {
+		return nil, toStatus(ctx, "CreateProfesor", err)
+	}
+	return toProfesor(profesor), nil
+}
+
+func (s *profesorServer) UpdateProfesor(ctx context.Context, req *pb.UpdateProfesorRequest) (*pb.Profesor, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "UpdateProfesor", err)
+	}
+	if err := s.service.Update(ctx, id, fromProfesorInput(req.GetProfesor())); err != nil {
+		return nil, toStatus(ctx, "UpdateProfesor", err)
+	}
+
+	actualizado, err := s.service.GetByID(ctx, id)
+	if err != nil {
+		return nil, toStatus(ctx, "UpdateProfesor", err)
+	}
+	return toProfesor(actualizado), nil
+}
+
+func (s *profesorServer) DeleteProfesor(ctx context.Context, req *pb.DeleteProfesorRequest) (*emptypb.Empty, error) {
+	id, err := requireID("id", req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, "DeleteProfesor", err)
+	}
+	if err := s.service.Delete(ctx, id); err != nil {
+		return nil, toStatus(ctx, "DeleteProfesor", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *profesorServer) ImportProfesores(stream grpcgo.ClientStreamingServer[pb.ImportRequest, pb.ResultadoImportacion]) error {
+	return recvImport(stream, "ImportProfesores", s.service.Import)
+}
+
+func (s *profesorServer) ExportProfesores(req *pb.ExportRequest, stream grpcgo.ServerStreamingServer[pb.ExportChunk]) error {
+	return sendExport(req, stream, "ExportProfesores", s.service.Export)
+}
+
+func (s *profesorServer) BatchProfesores(ctx context.Context, req *pb.BatchProfesoresRequest) (*pb.ResultadoLote, error) {
+	ops := make([]domain.OperacionLote[domain.Profesor], len(req.GetOperaciones()))
+	for i, op := range req.GetOperaciones() {
+		ops[i] = domain.OperacionLote[domain.Profesor]{Op: op.GetOp(), ID: uint(op.GetId())}
+		if op.GetDatos() != nil {
+			ops[i].Datos = fromProfesorInput(op.GetDatos())
+		}
+	}
+	resultado, err := s.service.Batch(ctx, req.GetModo(), ops)
+	if err != nil {
+		return nil, toStatus(ctx, "BatchProfesores", err)
+	}
+	return resultadoLote(ctx, resultado), nil
+}
+
+func fromProfesorInput(in *pb.ProfesorInput) *domain.Profesor {
+	return &domain.Profesor{
+		NumeroEmpleado: int(in.GetNumeroEmpleado()),
+		Nombres:        in.GetNombres(),
+		Apellidos:      in.GetApellidos(),
+		HorasClase:     int(in.GetHorasClase()),
+	}
+}
+
+func toProfesor(profesor *domain.Profesor) *pb.Profesor {
+	return &pb.Profesor{
+		Id:             uint32(profesor.ID),
+		NumeroEmpleado: int32(profesor.NumeroEmpleado),
+		Nombres:        profesor.Nombres,
+		Apellidos:      profesor.Apellidos,
+		HorasClase:     int32(profesor.HorasClase),
+	}
+}
@@ -0,0 +1,40 @@
+// Package grpc expone AlumnoService, ProfesorService y SesionService de port
+// por gRPC en un puerto aparte del HTTP. Los errores llevan el mismo code que
+// problem+json en un ErrorInfo. Las credenciales van en los metadatos
+// x-alumno-id y authorization como en REST: un alumno solo actúa sobre sí
+// mismo y el resto de los métodos, salvo SesionService y CreateAlumno, son
+// del administrador
+package grpc
+
+//go:generate buf generate
+
+import (
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pb"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// NewServer registra los servicios; con reflexion los clientes como grpcurl
+// pueden descubrirlos sin los .proto
+func NewServer(
+	alumnos port.AlumnoService,
+	profesores port.ProfesorService,
+	sesiones port.SesionService,
+	authenticator *auth.Authenticator,
+	reflexion bool,
+) *grpcgo.Server {
+	i := &interceptor{authenticator: authenticator}
+	server := grpcgo.NewServer(
+		grpcgo.ChainUnaryInterceptor(i.unary),
+		grpcgo.ChainStreamInterceptor(i.stream),
+	)
+	pb.RegisterAlumnoServiceServer(server, &alumnoServer{service: alumnos})
+	pb.RegisterProfesorServiceServer(server, &profesorServer{service: profesores})
+	pb.RegisterSesionServiceServer(server, &sesionServer{service: sesiones})
+	if reflexion {
+		reflection.Register(server)
+	}
+	return server
+}
@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pb"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type sesionServer struct {
+	pb.UnimplementedSesionServiceServer
+	service port.SesionService
+}
+
+func (s *sesionServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Sesion, error) {
+	alumnoID, err := requireID("alumnoId", req.GetAlumnoId())
+	if err != nil {
+		return nil, toStatus(ctx, "Login", err)
+	}
+	sesion, err := s.service.Login(ctx, alumnoID, req.GetPassword())
+	if err != nil {
+		return nil, toStatus(ctx, "Login", err)
+	}
+	return &pb.Sesion{
+		Id:            sesion.ID,
+		Fecha:         timestamppb.New(time.Unix(sesion.Fecha, 0)),
+		AlumnoId:      uint32(sesion.AlumnoID),
+		Active:        sesion.Active,
+		SessionString: sesion.SessionString,
+	}, nil
+}
+
+func (s *sesionServer) Verify(ctx context.Context, req *pb.VerifyRequest) (*emptypb.Empty, error) {
+	alumnoID, err := requireID("alumnoId", req.GetAlumnoId())
+	if err != nil {
+		return nil, toStatus(ctx, "Verify", err)
+	}
+	if err := s.service.Verify(ctx, alumnoID, req.GetSessionString()); err != nil {
+		return nil, toStatus(ctx, "Verify", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *sesionServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*emptypb.Empty, error) {
+	alumnoID, err := requireID("alumnoId", req.GetAlumnoId())
+	if err != nil {
+		return nil, toStatus(ctx, "Logout", err)
+	}
+	if err := s.service.Logout(ctx, alumnoID, req.GetSessionString()); err != nil {
+		return nil, toStatus(ctx, "Logout", err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/grpc/pb"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	grpcgo "google.golang.org/grpc"
+)
+
+// exportChunkSize - Tamaño de cada mensaje de una exportación
+const exportChunkSize = 32 << 10
+
+// chunkReader - io.Reader sobre las partes de un stream del cliente; falla con
+// errArchivoMuyGrande si se reciben más de max bytes
+type chunkReader struct {
+	recv   func() ([]byte, error)
+	buf    []byte
+	leidos int64
+	max    int64
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.leidos += int64(n)
+	if r.leidos > r.max {
+		return n, errArchivoMuyGrande
+	}
+	return n, nil
+}
+
+// chunkWriter - io.Writer que envía cada escritura como ExportChunk
+type chunkWriter struct {
+	send func(*pb.ExportChunk) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	if err := w.send(&pb.ExportChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+type importFunc func(ctx context.Context, records []tabular.Record, dryRun bool) (*domain.ResultadoImportacion, error)
+
+// recvImport lee las opciones y el archivo de una importación y devuelve el
+// reporte; con errores en filas no falla, el reporte trae aplicado en false
+func recvImport(stream grpcgo.ClientStreamingServer[pb.ImportRequest, pb.ResultadoImportacion], metodo string, importar importFunc) error {
+	ctx := stream.Context()
+	primero, err := stream.Recv()
+	if err != nil {
+		return toStatus(ctx, metodo, err)
+	}
+	opciones := primero.GetOpciones()
+	if opciones == nil {
+		return invalid(ctx, i18n.MsgCampoRequerido, "opciones")
+	}
+	if opciones.GetFormat() != tabular.FormatCSV && opciones.GetFormat() != tabular.FormatXLSX {
+		return statusFrom(ctx, problem.New(http.StatusUnsupportedMediaType, problem.CodeUnsupportedMediaType, i18n.MsgFormatoImportacion))
+	}
+
+	archivo := &chunkReader{max: utils.MaxImportacionSize, recv: func() ([]byte, error) {
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if msg.GetOpciones() != nil {
+			return nil, apperrors.ErrInvalidInput
+		}
+		return msg.GetChunk(), nil
+	}}
+	records, err := tabular.ReadRecords(archivo, opciones.GetFormat())
+	if errors.Is(err, errArchivoMuyGrande) {
+		return statusFrom(ctx, problem.New(http.StatusRequestEntityTooLarge, problem.CodePayloadTooLarge, i18n.MsgArchivoMuyGrande))
+	}
+	if err != nil {
+		return invalid(ctx, i18n.MsgArchivoIlegible, err)
+	}
+
+	resultado, err := importar(ctx, records, opciones.GetDryRun())
+	if err != nil {
+		return toStatus(ctx, metodo, err)
+	}
+
+	lang := i18n.FromContext(ctx)
+	filas := make([]*pb.FilaImportada, len(resultado.Filas))
+	for i, fila := range resultado.Filas {
+		filas[i] = &pb.FilaImportada{
+			Fila:    int32(fila.Fila),
+			Clave:   fila.Clave,
+			Accion:  fila.Accion,
+			Errores: validationErrors(fila.Errores, lang),
+		}
+	}
+	return stream.SendAndClose(&pb.ResultadoImportacion{
+		DryRun:       resultado.DryRun,
+		Aplicado:     resultado.Aplicado,
+		Total:        int32(resultado.Total),
+		Creados:      int32(resultado.Creados),
+		Actualizados: int32(resultado.Actualizados),
+		ConErrores:   int32(resultado.ConErrores),
+		Filas:        filas,
+	})
+}
+
+type exportFunc func(ctx context.Context, w io.Writer, format string, progress func(int)) error
+
+// sendExport escribe las filas en partes conforme se leen de la base de datos
+func sendExport(req *pb.ExportRequest, stream grpcgo.ServerStreamingServer[pb.ExportChunk], metodo string, export exportFunc) error {
+	ctx := stream.Context()
+	switch req.GetFormat() {
+	case tabular.FormatCSV, tabular.FormatXLSX, tabular.FormatNDJSON:
+	default:
+		return statusFrom(ctx, problem.New(http.StatusNotAcceptable, problem.CodeNotAcceptable, i18n.MsgFormatoExportacion))
+	}
+
+	w := bufio.NewWriterSize(&chunkWriter{send: stream.Send}, exportChunkSize)
+	if err := export(ctx, w, req.GetFormat(), nil); err != nil {
+		return toStatus(ctx, metodo, err)
+	}
+	if err := w.Flush(); err != nil {
+		return toStatus(ctx, metodo, err)
+	}
+	return nil
+}
+
+// resultadoLote asigna a cada operación fallida el code y el mensaje que habría
+// devuelto como petición individual
+func resultadoLote(ctx context.Context, resultado *domain.ResultadoLote) *pb.ResultadoLote {
+	lang := i18n.FromContext(ctx)
+	resultados := make([]*pb.ResultadoOperacion, len(resultado.Resultados))
+	for i, res := range resultado.Resultados {
+		out := &pb.ResultadoOperacion{
+			Indice: int32(res.Indice),
+			Op:     res.Op,
+			Id:     uint32(res.ID),
+			Estado: res.Estado,
+		}
+		if res.Estado == domain.OperacionFallida {
+			p := problem.Localize(problem.From(res.Err), lang)
+			out.Code, out.Error, out.Errores = p.Code, p.Detail, validationErrors(p.Errors, lang)
+		}
+		resultados[i] = out
+	}
+	return &pb.ResultadoLote{
+		Modo:       resultado.Modo,
+		Aplicado:   resultado.Aplicado,
+		Exitosas:   int32(resultado.Exitosas),
+		Fallidas:   int32(resultado.Fallidas),
+		Resultados: resultados,
+	}
+}
@@ -2,13 +2,14 @@ package handler
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
-	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
 	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
 	"github.com/go-chi/chi/v5"
 )
@@ -19,13 +20,32 @@ type AlumnoHandler struct {
 
 // AlumnoInput - DTO para crear/actualizar alumno (incluye password)
 type AlumnoInput struct {
-	ID            uint    `json:"id"`
-	Nombres       string  `json:"nombres"`
-	Apellidos     string  `json:"apellidos"`
-	Matricula     string  `json:"matricula"`
-	Promedio      float64 `json:"promedio"`
-	FotoPerfilUrl string  `json:"fotoPerfilUrl,omitempty"`
-	Password      string  `json:"password"`
+	ID        uint    `json:"id"`
+	Nombres   string  `json:"nombres"`
+	Apellidos string  `json:"apellidos"`
+	Matricula string  `json:"matricula"`
+	Promedio  float64 `json:"promedio" openapi:"minimum=0,maximum=10"`
+	Email     string  `json:"email"`
+	Telefono  string  `json:"telefono"`
+	Idioma    string  `json:"idioma"`
+	Password  string  `json:"password"`
+}
+
+// FotoPerfilUploadRequest - Datos de la foto que el navegador subirá directamente
+type FotoPerfilUploadRequest struct {
+	ContentType string `json:"contentType" openapi:"required,enum=image/jpeg|image/png|image/webp"`
+	Size        int64  `json:"size" openapi:"required,minimum=1"`
+}
+
+// FotoPerfilConfirmRequest - Llave devuelta por upload-url una vez subido el archivo
+type FotoPerfilConfirmRequest struct {
+	Key string `json:"key" openapi:"required,minLength=1"`
+}
+
+// FotoPerfilResponse - URLs temporales de la foto de perfil y sus variantes
+type FotoPerfilResponse struct {
+	FotoPerfilUrl       string            `json:"fotoPerfilUrl"`
+	FotoPerfilVariantes map[string]string `json:"fotoPerfilVariantes"`
 }
 
 func NewAlumnoHandler(service port.AlumnoService) *AlumnoHandler {
@@ -35,7 +55,7 @@ func NewAlumnoHandler(service port.AlumnoService) *AlumnoHandler {
 func (h *AlumnoHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 	alumnos, err := h.service.GetAll(r.Context())
 	if err != nil {
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 	utils.JSON(w, http.StatusOK, alumnos)
@@ -44,17 +64,13 @@ func (h *AlumnoHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 func (h *AlumnoHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	alumno, err := h.service.GetByID(r.Context(), uint(id))
 	if err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Alumno no encontrado")
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 	utils.JSON(w, http.StatusOK, alumno)
@@ -63,26 +79,24 @@ func (h *AlumnoHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 func (h *AlumnoHandler) Create(w http.ResponseWriter, r *http.Request) {
 	var input AlumnoInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "JSON inválido")
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
 		return
 	}
 
 	alumno := domain.Alumno{
-		ID:            input.ID,
-		Nombres:       input.Nombres,
-		Apellidos:     input.Apellidos,
-		Matricula:     input.Matricula,
-		Promedio:      input.Promedio,
-		FotoPerfilUrl: input.FotoPerfilUrl,
-		Password:      input.Password,
+		ID:        input.ID,
+		Nombres:   input.Nombres,
+		Apellidos: input.Apellidos,
+		Matricula: input.Matricula,
+		Promedio:  input.Promedio,
+		Email:     input.Email,
+		Telefono:  input.Telefono,
+		Idioma:    input.Idioma,
+		Password:  input.Password,
 	}
 
 	if err := h.service.Create(r.Context(), &alumno); err != nil {
-		if errors.Is(err, apperrors.ErrInvalidInput) {
-			utils.JSONError(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 
@@ -92,112 +106,198 @@ func (h *AlumnoHandler) Create(w http.ResponseWriter, r *http.Request) {
 func (h *AlumnoHandler) Update(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	var input AlumnoInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "JSON inválido")
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
 		return
 	}
 
 	alumno := domain.Alumno{
-		Nombres:       input.Nombres,
-		Apellidos:     input.Apellidos,
-		Matricula:     input.Matricula,
-		Promedio:      input.Promedio,
-		FotoPerfilUrl: input.FotoPerfilUrl,
-		Password:      input.Password,
+		Nombres:   input.Nombres,
+		Apellidos: input.Apellidos,
+		Matricula: input.Matricula,
+		Promedio:  input.Promedio,
+		Email:     input.Email,
+		Telefono:  input.Telefono,
+		Idioma:    input.Idioma,
+		Password:  input.Password,
 	}
 
 	if err := h.service.Update(r.Context(), uint(id), &alumno); err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Alumno no encontrado")
-			return
-		}
-		if errors.Is(err, apperrors.ErrInvalidInput) {
-			utils.JSONError(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 
-	utils.JSONMessage(w, http.StatusOK, "Alumno actualizado correctamente")
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgAlumnoActualizado))
 }
 
 func (h *AlumnoHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	if err := h.service.Delete(r.Context(), uint(id)); err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Alumno no encontrado")
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 
-	utils.JSONMessage(w, http.StatusOK, "Alumno eliminado correctamente")
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgAlumnoEliminado))
 }
 
 func (h *AlumnoHandler) UploadFotoPerfil(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
-	if err := r.ParseMultipartForm(10 << 20); err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "Error al procesar el archivo")
+	if err := r.ParseMultipartForm(utils.MaxFotoPerfilSize); err != nil {
+		problem.Invalid(w, r, i18n.MsgArchivoInvalido)
 		return
 	}
 
-	file, header, err := r.FormFile("foto")
+	file, _, err := r.FormFile("foto")
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "Archivo 'foto' requerido")
+		problem.Invalid(w, r, i18n.MsgArchivoRequerido, "foto")
 		return
 	}
 	defer file.Close()
 
-	contentType := header.Header.Get("Content-Type")
-	if contentType == "" {
-		contentType = "image/jpeg"
+	// El tipo real se detecta por contenido en el caso de uso; el Content-Type del cliente se ignora
+	variantes, err := h.service.UploadFotoPerfil(r.Context(), uint(id), file)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
 	}
 
-	url, err := h.service.UploadFotoPerfil(r.Context(), uint(id), file, header.Filename, contentType)
+	utils.JSON(w, http.StatusOK, fotoPerfilResponse(variantes))
+}
+
+func (h *AlumnoHandler) CreateFotoPerfilUploadURL(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Alumno no encontrado")
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
-	utils.JSON(w, http.StatusOK, map[string]string{"fotoPerfilUrl": url})
+	var req FotoPerfilUploadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	upload, err := h.service.CreateFotoPerfilUploadURL(r.Context(), uint(id), req.ContentType, req.Size)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.NoStore(w)
+	utils.JSON(w, http.StatusOK, upload)
+}
+
+func (h *AlumnoHandler) ConfirmFotoPerfil(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	var req FotoPerfilConfirmRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	variantes, err := h.service.ConfirmFotoPerfil(r.Context(), uint(id), req.Key)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSON(w, http.StatusOK, fotoPerfilResponse(variantes))
+}
+
+func (h *AlumnoHandler) DeleteFotoPerfil(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	if err := h.service.DeleteFotoPerfil(r.Context(), uint(id)); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgFotoPerfilEliminada))
+}
+
+func fotoPerfilResponse(variantes map[string]string) FotoPerfilResponse {
+	return FotoPerfilResponse{
+		FotoPerfilUrl:       variantes["original"],
+		FotoPerfilVariantes: variantes,
+	}
 }
 
 func (h *AlumnoHandler) SendEmail(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	if err := h.service.SendEmail(r.Context(), uint(id)); err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Alumno no encontrado")
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSONMessage(w, http.StatusAccepted, i18n.Tr(r.Context(), i18n.MsgEmailProgramado))
+}
+
+// Import da de alta o actualiza alumnos desde un CSV/XLSX; ?dryRun=true solo valida
+func (h *AlumnoHandler) Import(w http.ResponseWriter, r *http.Request) {
+	handleImport(w, r, func(r *http.Request, records []tabular.Record, dryRun bool) (*domain.ResultadoImportacion, error) {
+		return h.service.Import(r.Context(), records, dryRun)
+	})
+}
+
+// Export descarga todos los alumnos como CSV, XLSX o NDJSON (Accept o ?format=)
+func (h *AlumnoHandler) Export(w http.ResponseWriter, r *http.Request) {
+	handleExport(w, r, "alumnos", h.service.Export)
+}
+
+// Batch aplica varias operaciones de alta, cambio o baja en una sola transacción
+func (h *AlumnoHandler) Batch(w http.ResponseWriter, r *http.Request) {
+	var input LoteRequest[AlumnoInput]
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
 		return
 	}
 
-	utils.JSONMessage(w, http.StatusOK, "Email enviado correctamente")
+	ops := make([]domain.OperacionLote[domain.Alumno], len(input.Operaciones))
+	for i, op := range input.Operaciones {
+		ops[i] = domain.OperacionLote[domain.Alumno]{Op: op.Op, ID: op.ID}
+		if op.Datos != nil {
+			ops[i].Datos = &domain.Alumno{
+				Nombres:   op.Datos.Nombres,
+				Apellidos: op.Datos.Apellidos,
+				Matricula: op.Datos.Matricula,
+				Promedio:  op.Datos.Promedio,
+				Email:     op.Datos.Email,
+				Telefono:  op.Datos.Telefono,
+				Idioma:    op.Datos.Idioma,
+				Password:  op.Datos.Password,
+			}
+		}
+	}
+
+	resultado, err := h.service.Batch(r.Context(), input.Modo, ops)
+	writeLote(w, r, resultado, err)
 }
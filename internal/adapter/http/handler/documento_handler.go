@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+type DocumentoHandler struct {
+	service port.DocumentoService
+}
+
+// RevisionRequest - Resultado de la revisión de un documento
+type RevisionRequest struct {
+	Estado     string `json:"estado" openapi:"required,enum=aprobado|rechazado"`
+	Comentario string `json:"comentario"`
+}
+
+func NewDocumentoHandler(service port.DocumentoService) *DocumentoHandler {
+	return &DocumentoHandler{service: service}
+}
+
+func (h *DocumentoHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	alumnoID, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	documentos, err := h.service.GetByAlumno(r.Context(), uint(alumnoID))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, documentos)
+}
+
+func (h *DocumentoHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	alumnoID, documentoID, ok := documentoIDs(w, r)
+	if !ok {
+		return
+	}
+
+	documento, err := h.service.GetByID(r.Context(), alumnoID, documentoID)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, documento)
+}
+
+// Upload recibe el documento como multipart/form-data (campos archivo y tipo)
+func (h *DocumentoHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	alumnoID, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, utils.MaxDocumentoSize+1<<20)
+	if err := r.ParseMultipartForm(10 << 20); err != nil {
+		problem.Invalid(w, r, i18n.MsgArchivoInvalido)
+		return
+	}
+
+	file, header, err := r.FormFile("archivo")
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgArchivoRequerido, "archivo")
+		return
+	}
+	defer file.Close()
+
+	h.upload(w, r, uint(alumnoID), r.FormValue("tipo"), header.Filename, file)
+}
+
+// UploadStream recibe el documento como cuerpo crudo; tipo y nombre van en la query
+func (h *DocumentoHandler) UploadStream(w http.ResponseWriter, r *http.Request) {
+	alumnoID, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, utils.MaxDocumentoSize+1)
+	query := r.URL.Query()
+	h.upload(w, r, uint(alumnoID), query.Get("tipo"), query.Get("nombre"), body)
+}
+
+func (h *DocumentoHandler) upload(w http.ResponseWriter, r *http.Request, alumnoID uint, tipo, nombre string, file io.Reader) {
+	documento, err := h.service.Upload(r.Context(), alumnoID, tipo, nombre, file)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSON(w, http.StatusCreated, documento)
+}
+
+func (h *DocumentoHandler) Download(w http.ResponseWriter, r *http.Request) {
+	alumnoID, documentoID, ok := documentoIDs(w, r)
+	if !ok {
+		return
+	}
+
+	documento, file, err := h.service.Download(r.Context(), alumnoID, documentoID)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Type", documento.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(documento.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": documento.NombreArchivo}))
+	w.Header().Set("X-Checksum-Sha256", documento.Checksum)
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, file)
+}
+
+func (h *DocumentoHandler) Review(w http.ResponseWriter, r *http.Request) {
+	alumnoID, documentoID, ok := documentoIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var req RevisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	documento, err := h.service.Review(r.Context(), alumnoID, documentoID, req.Estado, req.Comentario)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSON(w, http.StatusOK, documento)
+}
+
+func (h *DocumentoHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	alumnoID, documentoID, ok := documentoIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), alumnoID, documentoID); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgDocumentoEliminado))
+}
+
+func documentoIDs(w http.ResponseWriter, r *http.Request) (uint, uint, bool) {
+	alumnoID, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return 0, 0, false
+	}
+
+	documentoID, err := strconv.ParseUint(chi.URLParam(r, "documentoId"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDDocumentoInvalido)
+		return 0, 0, false
+	}
+
+	return uint(alumnoID), uint(documentoID), true
+}
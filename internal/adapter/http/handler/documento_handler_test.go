@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/local"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/usecase"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+var pdf = []byte("%PDF-1.7\n1 0 obj << >> endobj\ntrailer << >>\n%%EOF\n")
+
+// documentosRouter monta las rutas de documentos con el alumno 1 como
+// principal, en lugar del middleware de autenticación
+func documentosRouter(t *testing.T) http.Handler {
+	t.Helper()
+	db := storagetest.NewSQLite(t)
+	alumnoRepo := relational.NewAlumnoRepository(db)
+	alumno := &domain.Alumno{Nombres: "Ana", Apellidos: "García", Matricula: "A001", Email: "ana@example.com", Password: "x"}
+	if err := alumnoRepo.Create(context.Background(), alumno); err != nil {
+		t.Fatal(err)
+	}
+	fileStorage := local.NewFileStorage(t.TempDir(), "http://localhost", []byte("clave"), time.Minute)
+	h := NewDocumentoHandler(usecase.NewDocumentoUseCase(relational.NewDocumentoRepository(db), alumnoRepo, fileStorage))
+
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{AlumnoID: alumno.ID})))
+		})
+	})
+	r.Post("/alumnos/{id}/documentos", h.Upload)
+	r.Post("/alumnos/{id}/documentos/stream", h.UploadStream)
+	r.Get("/alumnos/{id}/documentos/{documentoId}/download", h.Download)
+	return r
+}
+
+func multipartDocumento(t *testing.T, tipo, nombre, contentType string, data []byte) (*bytes.Buffer, string) {
+	t.Helper()
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if err := form.WriteField("tipo", tipo); err != nil {
+		t.Fatal(err)
+	}
+	header := make(map[string][]string)
+	header["Content-Disposition"] = []string{`form-data; name="archivo"; filename="` + nombre + `"`}
+	header["Content-Type"] = []string{contentType}
+	part, err := form.CreatePart(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(data)
+	form.Close()
+	return &body, form.FormDataContentType()
+}
+
+func TestDocumentoHandlerUpload(t *testing.T) {
+	r := documentosRouter(t)
+
+	for _, tc := range []struct {
+		nombre      string
+		contentType string // el que declara el cliente
+		data        []byte
+		status      int
+	}{
+		{"pdf", "application/pdf", pdf, http.StatusCreated},
+		{"texto declarado como pdf", "application/pdf", []byte("no soy un pdf"), http.StatusBadRequest},
+		{"pdf declarado como texto", "text/plain", pdf, http.StatusCreated},
+	} {
+		body, contentType := multipartDocumento(t, "kardex", "kardex.pdf", tc.contentType, tc.data)
+		req := httptest.NewRequest(http.MethodPost, "/alumnos/1/documentos", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != tc.status {
+			t.Fatalf("%s: status = %d: %s", tc.nombre, w.Code, w.Body)
+		}
+		if tc.status != http.StatusCreated {
+			continue
+		}
+		var documento domain.Documento
+		if err := json.Unmarshal(w.Body.Bytes(), &documento); err != nil {
+			t.Fatal(err)
+		}
+		if documento.ContentType != "application/pdf" || documento.SubidoPor != "alumno:1" {
+			t.Errorf("%s: documento = %+v", tc.nombre, documento)
+		}
+	}
+}
+
+func TestDocumentoHandlerUploadStream(t *testing.T) {
+	r := documentosRouter(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/alumnos/1/documentos/stream?tipo=acta_nacimiento&nombre=acta.pdf", bytes.NewReader(pdf))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d: %s", w.Code, w.Body)
+	}
+	var documento domain.Documento
+	if err := json.Unmarshal(w.Body.Bytes(), &documento); err != nil {
+		t.Fatal(err)
+	}
+
+	// La descarga entrega el mismo contenido con su checksum y nombre
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/alumnos/1/documentos/1/download", nil))
+	if w.Code != http.StatusOK || !bytes.Equal(w.Body.Bytes(), pdf) {
+		t.Fatalf("download = %d (%d bytes)", w.Code, w.Body.Len())
+	}
+	if w.Header().Get("X-Checksum-Sha256") != documento.Checksum || !strings.Contains(w.Header().Get("Content-Disposition"), "acta.pdf") {
+		t.Errorf("headers de descarga = %v", w.Header())
+	}
+
+	// Un cuerpo mayor que el máximo global se corta sin leerlo completo
+	grande := io.MultiReader(bytes.NewReader(pdf), strings.NewReader(strings.Repeat("0", utils.MaxDocumentoSize)))
+	req = httptest.NewRequest(http.MethodPost, "/alumnos/1/documentos/stream?tipo=kardex&nombre=k.pdf", grande)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("documento demasiado grande = %d: %s", w.Code, w.Body)
+	}
+}
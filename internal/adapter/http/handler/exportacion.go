@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
+)
+
+type exportFunc func(ctx context.Context, w io.Writer, format string, progress func(int)) error
+
+// exportWriteTimeout - Plazo para escribir una exportación síncrona; reemplaza
+// el WriteTimeout del servidor, pensado para respuestas cortas
+const exportWriteTimeout = 10 * time.Minute
+
+// handleExport elige el formato con ?format= o, si no viene, con Accept, y
+// escribe las filas conforme se leen de la base de datos
+func handleExport(w http.ResponseWriter, r *http.Request, nombre string, export exportFunc) {
+	format, err := exportFormat(r)
+	if err != nil {
+		problem.Write(w, r, problem.New(http.StatusNotAcceptable, problem.CodeNotAcceptable, i18n.MsgFormatoExportacion))
+		return
+	}
+
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(exportWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Printf("Error al extender el plazo de la exportación de %s: %v", nombre, err)
+	}
+
+	archivo := &exportWriter{w: w, format: format, nombre: nombre}
+	if err := export(r.Context(), archivo, format, nil); err != nil {
+		if !archivo.escrito {
+			problem.Error(w, r, err)
+			return
+		}
+		// La respuesta ya empezó a enviarse; solo se puede cortar y registrar el error
+		log.Printf("Error al exportar %s: %v", nombre, err)
+		panic(http.ErrAbortHandler)
+	}
+	// Un NDJSON sin filas no escribe nada, pero lleva los encabezados igual
+	archivo.Write(nil)
+}
+
+// exportWriter pone los encabezados del archivo con el primer byte; mientras
+// no se escriba nada, un error todavía se responde como problem+json
+type exportWriter struct {
+	w       http.ResponseWriter
+	format  string
+	nombre  string
+	escrito bool
+}
+
+func (e *exportWriter) Write(p []byte) (int, error) {
+	if !e.escrito {
+		e.escrito = true
+		e.w.Header().Set("Content-Type", tabular.ContentType(e.format))
+		e.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, e.nombre, e.format))
+	}
+	return e.w.Write(p)
+}
+
+// exportFormat toma ?format= o, si no viene, negocia con Accept
+func exportFormat(r *http.Request) (string, error) {
+	switch format := r.URL.Query().Get("format"); format {
+	case tabular.FormatCSV, tabular.FormatXLSX, tabular.FormatNDJSON:
+		return format, nil
+	case "":
+		return tabular.FormatFromAccept(r.Header.Get("Accept"))
+	default:
+		return "", tabular.ErrUnsupportedExportFormat
+	}
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
+)
+
+func exportarCon(export exportFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		handleExport(w, r, "alumnos", export)
+	}
+}
+
+func TestHandleExport(t *testing.T) {
+	for _, tc := range []struct {
+		nombre      string
+		format      string
+		export      exportFunc
+		status      int
+		contentType string
+		disposicion bool
+	}{
+		{"csv", tabular.FormatCSV, func(ctx context.Context, w io.Writer, format string, _ func(int)) error {
+			_, err := io.WriteString(w, "id\n1\n")
+			return err
+		}, http.StatusOK, tabular.ContentType(tabular.FormatCSV), true},
+		{"ndjson sin filas", tabular.FormatNDJSON, func(ctx context.Context, w io.Writer, format string, _ func(int)) error {
+			return nil
+		}, http.StatusOK, tabular.ContentType(tabular.FormatNDJSON), true},
+		{"error antes de escribir", tabular.FormatCSV, func(ctx context.Context, w io.Writer, format string, _ func(int)) error {
+			return fmt.Errorf("%w: formato", apperrors.ErrInvalidInput)
+		}, http.StatusBadRequest, problem.ContentType, false},
+		{"error interno antes de escribir", tabular.FormatXLSX, func(ctx context.Context, w io.Writer, format string, _ func(int)) error {
+			return errors.New("base de datos caída")
+		}, http.StatusInternalServerError, problem.ContentType, false},
+	} {
+		w := httptest.NewRecorder()
+		exportarCon(tc.export).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/alumnos/export?format="+tc.format, nil))
+
+		if w.Code != tc.status || w.Header().Get("Content-Type") != tc.contentType {
+			t.Errorf("%s: %d %s, se esperaba %d %s", tc.nombre, w.Code, w.Header().Get("Content-Type"), tc.status, tc.contentType)
+		}
+		if got := w.Header().Get("Content-Disposition") != ""; got != tc.disposicion {
+			t.Errorf("%s: Content-Disposition = %q", tc.nombre, w.Header().Get("Content-Disposition"))
+		}
+	}
+}
+
+// Con la respuesta ya empezada solo queda cortar la conexión
+func TestHandleExportAbortaAMedias(t *testing.T) {
+	defer func() {
+		if r := recover(); r != http.ErrAbortHandler {
+			t.Fatalf("recover = %v, se esperaba http.ErrAbortHandler", r)
+		}
+	}()
+	export := func(ctx context.Context, w io.Writer, format string, _ func(int)) error {
+		io.WriteString(w, "id\n1\n")
+		return errors.New("base de datos caída")
+	}
+	exportarCon(export).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/alumnos/export?format=csv", nil))
+}
+
+// Una exportación larga no la corta el WriteTimeout del servidor
+func TestHandleExportExtiendeElPlazo(t *testing.T) {
+	srv := httptest.NewUnstartedServer(exportarCon(func(ctx context.Context, w io.Writer, format string, _ func(int)) error {
+		time.Sleep(300 * time.Millisecond)
+		_, err := io.WriteString(w, "id\n1\n")
+		return err
+	}))
+	srv.Config.WriteTimeout = 100 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/alumnos/export?format=csv")
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != "id\n1\n" {
+		t.Fatalf("cuerpo = %q, %v", body, err)
+	}
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+type FileHandler struct {
+	storage port.SignedFileStorage
+}
+
+func NewFileHandler(storage port.SignedFileStorage) *FileHandler {
+	return &FileHandler{storage: storage}
+}
+
+func (h *FileHandler) Download(w http.ResponseWriter, r *http.Request) {
+	key := fileKey(r)
+
+	expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil {
+		problem.Write(w, r, problem.New(http.StatusForbidden, problem.CodeForbidden, i18n.MsgURLFirmadaInvalida))
+		return
+	}
+
+	file, modTime, err := h.storage.OpenSigned(r.Context(), key, expires, r.URL.Query().Get("signature"))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	defer file.Close()
+
+	// ServeContent deduce el Content-Type por extensión o contenido
+	w.Header().Del("Content-Type")
+	http.ServeContent(w, r, path.Base(key), modTime, file)
+}
+
+func (h *FileHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	key := fileKey(r)
+
+	expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil {
+		problem.Write(w, r, problem.New(http.StatusForbidden, problem.CodeForbidden, i18n.MsgURLFirmadaInvalida))
+		return
+	}
+
+	if r.ContentLength < 0 {
+		problem.Write(w, r, problem.New(http.StatusLengthRequired, problem.CodeLengthRequired, i18n.MsgContentLength))
+		return
+	}
+
+	err = h.storage.UploadSigned(
+		r.Context(),
+		key,
+		expires,
+		r.URL.Query().Get("signature"),
+		r.Body,
+		r.Header.Get("Content-Type"),
+		r.ContentLength,
+	)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgArchivoSubido))
+}
+
+// fileKey devuelve la llave sin escapar. chi enruta sobre RawPath cuando la
+// URL trae escapes que no son los de por omisión (p. ej. %2C), y entonces el
+// parámetro llega escapado
+func fileKey(r *http.Request) string {
+	key := chi.URLParam(r, "*")
+	if r.URL.RawPath == "" {
+		return key
+	}
+	if unescaped, err := url.PathUnescape(key); err == nil {
+		return unescaped
+	}
+	return key
+}
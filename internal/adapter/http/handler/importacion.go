@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+)
+
+type importFunc func(r *http.Request, records []tabular.Record, dryRun bool) (*domain.ResultadoImportacion, error)
+
+// handleImport responde 200 con el reporte, o 422 si alguna fila tiene errores y
+// no es dry run
+func handleImport(w http.ResponseWriter, r *http.Request, importar importFunc) {
+	file, format, ok := importFile(w, r)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	records, err := tabular.ReadRecords(file, format)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			problem.Write(w, r, problem.New(http.StatusRequestEntityTooLarge, problem.CodePayloadTooLarge, i18n.MsgArchivoMuyGrande))
+			return
+		}
+		problem.Invalid(w, r, i18n.MsgArchivoIlegible, err)
+		return
+	}
+
+	dryRun := r.URL.Query().Get("dryRun") == "true"
+	resultado, err := importar(r, records, dryRun)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	status := http.StatusOK
+	if !dryRun && resultado.ConErrores > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+	lang := i18n.FromContext(r.Context())
+	for i := range resultado.Filas {
+		resultado.Filas[i].Errores = apperrors.Localize(resultado.Filas[i].Errores, lang)
+	}
+	utils.JSON(w, status, resultado)
+}
+
+// importFile acepta el archivo como multipart (campo "archivo", formato por
+// extensión) o como cuerpo crudo (formato por Content-Type). Si falla ya
+// respondió al cliente.
+func importFile(w http.ResponseWriter, r *http.Request) (io.ReadCloser, string, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, utils.MaxImportacionSize+1<<20)
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		format, err := tabular.FormatFromContentType(r.Header.Get("Content-Type"))
+		if err != nil {
+			problem.Write(w, r, problem.New(http.StatusUnsupportedMediaType, problem.CodeUnsupportedMediaType, i18n.MsgFormatoImportacion))
+			return nil, "", false
+		}
+		return r.Body, format, true
+	}
+
+	if err := r.ParseMultipartForm(utils.MaxImportacionSize); err != nil {
+		problem.Invalid(w, r, i18n.MsgArchivoInvalido)
+		return nil, "", false
+	}
+	file, header, err := r.FormFile("archivo")
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgArchivoRequerido, "archivo")
+		return nil, "", false
+	}
+	format, err := tabular.FormatFromFilename(header.Filename)
+	if err != nil {
+		file.Close()
+		problem.Invalid(w, r, i18n.MsgFormatoImportacion)
+		return nil, "", false
+	}
+	return file, format, true
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// Tipos de job por recurso de la ruta /jobs/{recurso}/...
+var (
+	jobsImportacion = map[string]string{
+		"alumnos":    domain.JobImportarAlumnos,
+		"profesores": domain.JobImportarProfesores,
+	}
+	jobsExportacion = map[string]string{
+		"alumnos":    domain.JobExportarAlumnos,
+		"profesores": domain.JobExportarProfesores,
+	}
+)
+
+type JobHandler struct {
+	service port.JobService
+}
+
+func NewJobHandler(service port.JobService) *JobHandler {
+	return &JobHandler{service: service}
+}
+
+// CreateImport recibe el archivo igual que /{recurso}/import y responde 202 con el job
+func (h *JobHandler) CreateImport(w http.ResponseWriter, r *http.Request) {
+	tipo, ok := jobsImportacion[chi.URLParam(r, "recurso")]
+	if !ok {
+		problem.Write(w, r, problem.New(http.StatusNotFound, problem.CodeNotFound, i18n.MsgRecursoNoEncontrado))
+		return
+	}
+
+	file, format, ok := importFile(w, r)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	job, err := h.service.CreateImport(r.Context(), tipo, file, format, r.URL.Query().Get("dryRun") == "true")
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	jobAccepted(w, r, job)
+}
+
+// CreateExport elige el formato igual que /{recurso}/export y responde 202 con el job
+func (h *JobHandler) CreateExport(w http.ResponseWriter, r *http.Request) {
+	tipo, ok := jobsExportacion[chi.URLParam(r, "recurso")]
+	if !ok {
+		problem.Write(w, r, problem.New(http.StatusNotFound, problem.CodeNotFound, i18n.MsgRecursoNoEncontrado))
+		return
+	}
+
+	format, err := exportFormat(r)
+	if err != nil {
+		problem.Write(w, r, problem.New(http.StatusNotAcceptable, problem.CodeNotAcceptable, i18n.MsgFormatoExportacion))
+		return
+	}
+
+	job, err := h.service.CreateExport(r.Context(), tipo, format)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	jobAccepted(w, r, job)
+}
+
+// jobAccepted responde 202 con el job; Location conserva el prefijo de versión
+// de la petición (/v1/jobs/{recurso}/import -> /v1/jobs/{id})
+func jobAccepted(w http.ResponseWriter, r *http.Request, job *domain.Job) {
+	base, _, _ := strings.Cut(r.URL.Path, "/jobs/")
+	w.Header().Set("Location", fmt.Sprintf("%s/jobs/%d", base, job.ID))
+	utils.JSON(w, http.StatusAccepted, job)
+}
+
+func (h *JobHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	job, err := h.service.GetByID(r.Context(), uint(id))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, job)
+}
+
+func (h *JobHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	job, err := h.service.Cancel(r.Context(), uint(id))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, job)
+}
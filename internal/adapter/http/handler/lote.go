@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+)
+
+// LoteRequest - Cuerpo de POST /{recurso}/batch; modo es atomico (por defecto) o parcial
+type LoteRequest[T any] struct {
+	Modo        string                `json:"modo" openapi:"enum=atomico|parcial"`
+	Operaciones []OperacionRequest[T] `json:"operaciones" openapi:"required"`
+}
+
+type OperacionRequest[T any] struct {
+	Op    string `json:"op" openapi:"required,enum=crear|actualizar|eliminar"`
+	ID    uint   `json:"id"`
+	Datos *T     `json:"datos"`
+}
+
+// writeLote asigna a cada operación el código HTTP y el code de problem+json
+// que habría devuelto como petición individual y responde 200 si todo se aplicó, 207 si en modo parcial
+// alguna falló o 422 si el lote atómico se revirtió
+func writeLote(w http.ResponseWriter, r *http.Request, resultado *domain.ResultadoLote, err error) {
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	for i := range resultado.Resultados {
+		res := &resultado.Resultados[i]
+		switch res.Estado {
+		case domain.OperacionAplicada:
+			res.Status = operacionStatus(res.Op)
+		case domain.OperacionFallida:
+			p := problem.Localize(problem.From(res.Err), i18n.FromContext(r.Context()))
+			res.Status, res.Code, res.Error, res.Errores = p.Status, p.Code, p.Detail, p.Errors
+		}
+	}
+
+	status := http.StatusOK
+	switch {
+	case !resultado.Aplicado:
+		status = http.StatusUnprocessableEntity
+	case resultado.Fallidas > 0:
+		status = http.StatusMultiStatus
+	}
+	utils.JSON(w, status, resultado)
+}
+
+func operacionStatus(op string) int {
+	switch op {
+	case domain.OperacionCrear:
+		return http.StatusCreated
+	case domain.OperacionEliminar:
+		return http.StatusNoContent
+	default:
+		return http.StatusOK
+	}
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+type NotificacionHandler struct {
+	service port.OutboxService
+}
+
+func NewNotificacionHandler(service port.OutboxService) *NotificacionHandler {
+	return &NotificacionHandler{service: service}
+}
+
+// ReplayFallidasResponse - Número de notificaciones fallidas que se reencolaron
+type ReplayFallidasResponse struct {
+	Reencoladas int `json:"reencoladas"`
+}
+
+// GetAll lista las notificaciones más recientes; ?estado=fallida filtra por estado
+func (h *NotificacionHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	notificaciones, err := h.service.GetAll(r.Context(), r.URL.Query().Get("estado"))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, notificaciones)
+}
+
+func (h *NotificacionHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	notificacion, err := h.service.GetByID(r.Context(), uint(id))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, notificacion)
+}
+
+func (h *NotificacionHandler) Replay(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	notificacion, err := h.service.Replay(r.Context(), uint(id))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusAccepted, notificacion)
+}
+
+func (h *NotificacionHandler) ReplayFallidas(w http.ResponseWriter, r *http.Request) {
+	total, err := h.service.ReplayFallidas(r.Context())
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusAccepted, ReplayFallidasResponse{Reencoladas: total})
+}
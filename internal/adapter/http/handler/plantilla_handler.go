@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+type PlantillaHandler struct {
+	service port.PlantillaService
+}
+
+func NewPlantillaHandler(service port.PlantillaService) *PlantillaHandler {
+	return &PlantillaHandler{service: service}
+}
+
+func (h *PlantillaHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	plantillas, err := h.service.GetAll(r.Context())
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, plantillas)
+}
+
+func (h *PlantillaHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	plantilla, err := h.service.GetByID(r.Context(), uint(id))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, plantilla)
+}
+
+func (h *PlantillaHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var plantilla domain.PlantillaNotificacion
+	if err := json.NewDecoder(r.Body).Decode(&plantilla); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	if err := h.service.Create(r.Context(), &plantilla); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSON(w, http.StatusCreated, plantilla)
+}
+
+func (h *PlantillaHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	var plantilla domain.PlantillaNotificacion
+	if err := json.NewDecoder(r.Body).Decode(&plantilla); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	if err := h.service.Update(r.Context(), uint(id), &plantilla); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSON(w, http.StatusOK, plantilla)
+}
+
+func (h *PlantillaHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), uint(id)); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgPlantillaEliminada))
+}
+
+// Preview renderiza con datos de ejemplo una plantilla enviada en el cuerpo, sin guardarla
+func (h *PlantillaHandler) Preview(w http.ResponseWriter, r *http.Request) {
+	var plantilla domain.PlantillaNotificacion
+	if err := json.NewDecoder(r.Body).Decode(&plantilla); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	h.preview(w, r, &plantilla)
+}
+
+// PreviewByID renderiza con datos de ejemplo una plantilla guardada
+func (h *PlantillaHandler) PreviewByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	plantilla, err := h.service.GetByID(r.Context(), uint(id))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	h.preview(w, r, plantilla)
+}
+
+func (h *PlantillaHandler) preview(w http.ResponseWriter, r *http.Request, plantilla *domain.PlantillaNotificacion) {
+	mensaje, err := h.service.Preview(r.Context(), plantilla)
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSON(w, http.StatusOK, mensaje)
+}
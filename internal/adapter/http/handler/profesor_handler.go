@@ -2,13 +2,14 @@ package handler
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
-	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
 	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
 	"github.com/go-chi/chi/v5"
 )
@@ -24,7 +25,7 @@ func NewProfesorHandler(service port.ProfesorService) *ProfesorHandler {
 func (h *ProfesorHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 	profesores, err := h.service.GetAll(r.Context())
 	if err != nil {
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 	utils.JSON(w, http.StatusOK, profesores)
@@ -33,17 +34,13 @@ func (h *ProfesorHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 func (h *ProfesorHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	profesor, err := h.service.GetByID(r.Context(), uint(id))
 	if err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Profesor no encontrado")
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 	utils.JSON(w, http.StatusOK, profesor)
@@ -52,16 +49,12 @@ func (h *ProfesorHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 func (h *ProfesorHandler) Create(w http.ResponseWriter, r *http.Request) {
 	var profesor domain.Profesor
 	if err := json.NewDecoder(r.Body).Decode(&profesor); err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "JSON inválido")
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
 		return
 	}
 
 	if err := h.service.Create(r.Context(), &profesor); err != nil {
-		if errors.Is(err, apperrors.ErrInvalidInput) {
-			utils.JSONError(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 
@@ -71,47 +64,64 @@ func (h *ProfesorHandler) Create(w http.ResponseWriter, r *http.Request) {
 func (h *ProfesorHandler) Update(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	var profesor domain.Profesor
 	if err := json.NewDecoder(r.Body).Decode(&profesor); err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "JSON inválido")
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
 		return
 	}
 
 	if err := h.service.Update(r.Context(), uint(id), &profesor); err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Profesor no encontrado")
-			return
-		}
-		if errors.Is(err, apperrors.ErrInvalidInput) {
-			utils.JSONError(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 
-	utils.JSONMessage(w, http.StatusOK, "Profesor actualizado correctamente")
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgProfesorActualizado))
 }
 
 func (h *ProfesorHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	if err := h.service.Delete(r.Context(), uint(id)); err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Profesor no encontrado")
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 
-	utils.JSONMessage(w, http.StatusOK, "Profesor eliminado correctamente")
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgProfesorEliminado))
+}
+
+// Import da de alta o actualiza profesores desde un CSV/XLSX; ?dryRun=true solo valida
+func (h *ProfesorHandler) Import(w http.ResponseWriter, r *http.Request) {
+	handleImport(w, r, func(r *http.Request, records []tabular.Record, dryRun bool) (*domain.ResultadoImportacion, error) {
+		return h.service.Import(r.Context(), records, dryRun)
+	})
+}
+
+// Export descarga todos los profesores como CSV, XLSX o NDJSON (Accept o ?format=)
+func (h *ProfesorHandler) Export(w http.ResponseWriter, r *http.Request) {
+	handleExport(w, r, "profesores", h.service.Export)
+}
+
+// Batch aplica varias operaciones de alta, cambio o baja en una sola transacción
+func (h *ProfesorHandler) Batch(w http.ResponseWriter, r *http.Request) {
+	var input LoteRequest[domain.Profesor]
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	ops := make([]domain.OperacionLote[domain.Profesor], len(input.Operaciones))
+	for i, op := range input.Operaciones {
+		ops[i] = domain.OperacionLote[domain.Profesor]{Op: op.Op, ID: op.ID, Datos: op.Datos}
+	}
+
+	resultado, err := h.service.Batch(r.Context(), input.Modo, ops)
+	writeLote(w, r, resultado, err)
 }
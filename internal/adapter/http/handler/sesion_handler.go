@@ -2,12 +2,12 @@ package handler
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
-	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
 	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
 	"github.com/go-chi/chi/v5"
 )
@@ -21,110 +21,78 @@ func NewSesionHandler(service port.SesionService) *SesionHandler {
 }
 
 type LoginRequest struct {
-	Password string `json:"password"`
+	Password string `json:"password" openapi:"required,minLength=1"`
 }
 
 type SessionRequest struct {
+	SessionString string `json:"sessionString" openapi:"required,minLength=1"`
+}
+
+type LoginResponse struct {
 	SessionString string `json:"sessionString"`
 }
 
 func (h *SesionHandler) Login(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	var req LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "JSON inválido")
-		return
-	}
-
-	if req.Password == "" {
-		utils.JSONError(w, http.StatusBadRequest, "Password requerido")
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
 		return
 	}
 
 	sesion, err := h.service.Login(r.Context(), uint(id), req.Password)
 	if err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Alumno no encontrado")
-			return
-		}
-		if errors.Is(err, apperrors.ErrUnauthorized) {
-			utils.JSONError(w, http.StatusBadRequest, "Password incorrecto")
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 
-	utils.JSON(w, http.StatusOK, map[string]string{
-		"sessionString": sesion.SessionString,
-	})
+	utils.NoStore(w)
+	utils.JSON(w, http.StatusOK, LoginResponse{SessionString: sesion.SessionString})
 }
 
 func (h *SesionHandler) Verify(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	var req SessionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "JSON inválido")
-		return
-	}
-
-	if req.SessionString == "" {
-		utils.JSONError(w, http.StatusBadRequest, "SessionString requerido")
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
 		return
 	}
 
 	if err := h.service.Verify(r.Context(), uint(id), req.SessionString); err != nil {
-		if errors.Is(err, apperrors.ErrUnauthorized) || errors.Is(err, apperrors.ErrInvalidInput) {
-			utils.JSONError(w, http.StatusBadRequest, "Sesión inválida o inactiva")
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 
-	utils.JSONMessage(w, http.StatusOK, "Sesión válida")
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgSesionValida))
 }
 
 func (h *SesionHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
 	if err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "ID inválido")
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
 		return
 	}
 
 	var req SessionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		utils.JSONError(w, http.StatusBadRequest, "JSON inválido")
-		return
-	}
-
-	if req.SessionString == "" {
-		utils.JSONError(w, http.StatusBadRequest, "SessionString requerido")
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
 		return
 	}
 
 	if err := h.service.Logout(r.Context(), uint(id), req.SessionString); err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			utils.JSONError(w, http.StatusNotFound, "Sesión no encontrada")
-			return
-		}
-		if errors.Is(err, apperrors.ErrUnauthorized) {
-			utils.JSONError(w, http.StatusBadRequest, "Sesión no pertenece al alumno")
-			return
-		}
-		utils.JSONError(w, http.StatusInternalServerError, err.Error())
+		problem.Error(w, r, err)
 		return
 	}
 
-	utils.JSONMessage(w, http.StatusOK, "Sesión cerrada correctamente")
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgSesionCerrada))
 }
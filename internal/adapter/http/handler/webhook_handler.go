@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+type WebhookHandler struct {
+	service port.WebhookService
+}
+
+func NewWebhookHandler(service port.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+func (h *WebhookHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	suscripciones, err := h.service.GetAll(r.Context())
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, suscripciones)
+}
+
+func (h *WebhookHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	suscripcion, err := h.service.GetByID(r.Context(), uint(id))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, suscripcion)
+}
+
+func (h *WebhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var suscripcion domain.WebhookSuscripcion
+	if err := json.NewDecoder(r.Body).Decode(&suscripcion); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	if err := h.service.Create(r.Context(), &suscripcion); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.NoStore(w) // única respuesta que incluye el secreto
+	utils.JSON(w, http.StatusCreated, suscripcion)
+}
+
+func (h *WebhookHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	var suscripcion domain.WebhookSuscripcion
+	if err := json.NewDecoder(r.Body).Decode(&suscripcion); err != nil {
+		problem.Invalid(w, r, i18n.MsgJSONInvalido)
+		return
+	}
+
+	if err := h.service.Update(r.Context(), uint(id), &suscripcion); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSON(w, http.StatusOK, suscripcion)
+}
+
+func (h *WebhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), uint(id)); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+
+	utils.JSONMessage(w, http.StatusOK, i18n.Tr(r.Context(), i18n.MsgWebhookEliminado))
+}
+
+// GetEntregas devuelve la bitácora de entregas más recientes de la suscripción
+func (h *WebhookHandler) GetEntregas(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	entregas, err := h.service.GetEntregas(r.Context(), uint(id))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusOK, entregas)
+}
+
+func (h *WebhookHandler) ReplayEntrega(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDInvalido)
+		return
+	}
+
+	entregaID, err := strconv.ParseUint(chi.URLParam(r, "entregaId"), 10, 32)
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgIDEntregaInvalido)
+		return
+	}
+
+	entrega, err := h.service.ReplayEntrega(r.Context(), uint(id), uint(entregaID))
+	if err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	utils.JSON(w, http.StatusAccepted, entrega)
+}
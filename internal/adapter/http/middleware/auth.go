@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/go-chi/chi/v5"
+)
+
+// Auth - Exige credenciales en las rutas protegidas y deja el principal en el
+// contexto. Una sesión inválida responde 401; un principal válido sin permiso, 403
+type Auth struct {
+	authenticator *auth.Authenticator
+}
+
+func NewAuth(authenticator *auth.Authenticator) *Auth {
+	return &Auth{authenticator: authenticator}
+}
+
+// Alumno deja pasar al alumno del parámetro {id} de la ruta y al administrador
+func (a *Auth) Alumno(next http.Handler) http.Handler {
+	return a.require(next, func(r *http.Request, p auth.Principal) bool {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 32)
+		return err == nil && p.PuedeActuarSobre(uint(id))
+	})
+}
+
+// Admin deja pasar solo al administrador
+func (a *Auth) Admin(next http.Handler) http.Handler {
+	return a.require(next, func(_ *http.Request, p auth.Principal) bool {
+		return p.Admin
+	})
+}
+
+func (a *Auth) require(next http.Handler, permitido func(*http.Request, auth.Principal) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, err := a.authenticator.Authenticate(r.Context(), r.Header.Get(auth.HeaderAlumnoID), r.Header.Get("Authorization"))
+		switch {
+		case errors.Is(err, apperrors.ErrUnauthorized):
+			problem.Write(w, r, problem.New(http.StatusUnauthorized, problem.CodeUnauthorized, i18n.ErrorMsg(problem.CodeUnauthorized)))
+			return
+		case errors.Is(err, apperrors.ErrInvalidSession):
+			problem.Write(w, r, problem.New(http.StatusUnauthorized, problem.CodeInvalidSession, i18n.ErrorMsg(problem.CodeInvalidSession)))
+			return
+		case err != nil:
+			problem.Error(w, r, err)
+			return
+		}
+		if !permitido(r, p) {
+			problem.Write(w, r, problem.New(http.StatusForbidden, problem.CodeForbidden, i18n.ErrorMsg(problem.CodeForbidden)))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), p)))
+	})
+}
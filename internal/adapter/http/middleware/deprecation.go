@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Deprecation marca las respuestas de las rutas obsoletas con Deprecation
+// (RFC 9745), Sunset (RFC 8594) y un Link a la ruta que las reemplaza
+type Deprecation struct {
+	deprecation string
+	sunset      string
+}
+
+// NewDeprecation - desde es la fecha en que las rutas quedaron obsoletas y
+// sunset la fecha en que dejarán de responder
+func NewDeprecation(desde, sunset time.Time) *Deprecation {
+	return &Deprecation{
+		deprecation: "@" + strconv.FormatInt(desde.Unix(), 10),
+		sunset:      sunset.UTC().Format(http.TimeFormat),
+	}
+}
+
+// Successor devuelve el middleware de rutas cuya sucesora es la misma ruta
+// bajo prefix (/v1)
+func (m *Deprecation) Successor(prefix string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", m.deprecation)
+			w.Header().Set("Sunset", m.sunset)
+			w.Header().Add("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, prefix, r.URL.Path))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Montado como en el router: las mismas rutas bajo /v1 y sin prefijo
+func TestDeprecationSuccessor(t *testing.T) {
+	desde := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2026, time.June, 30, 12, 0, 0, 0, time.FixedZone("CST", -6*60*60))
+	rutas := func(r chi.Router) {
+		r.Get("/alumnos/{id}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+	}
+	r := chi.NewRouter()
+	r.Route("/v1", rutas)
+	r.Group(func(r chi.Router) {
+		r.Use(NewDeprecation(desde, sunset).Successor("/v1"))
+		rutas(r)
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/alumnos/7?campos=nombres", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("alias = %d", w.Code)
+	}
+	for header, want := range map[string]string{
+		"Deprecation": "@1735689600",
+		"Sunset":      "Tue, 30 Jun 2026 18:00:00 GMT",
+		"Link":        `</v1/alumnos/7>; rel="successor-version"`,
+	} {
+		if got := w.Header().Get(header); got != want {
+			t.Errorf("%s = %q, se esperaba %q", header, got, want)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/alumnos/7", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("/v1 = %d", w.Code)
+	}
+	for _, header := range []string{"Deprecation", "Sunset", "Link"} {
+		if got := w.Header().Get(header); got != "" {
+			t.Errorf("/v1 respondió %s: %q", header, got)
+		}
+	}
+}
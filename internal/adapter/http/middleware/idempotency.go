@@ -0,0 +1,243 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotencyRequestSize = utils.MaxDocumentoSize + 1<<20 // la ruta más grande (documentos); las mayores no se guardan
+	maxIdempotencyResponse    = 1 << 20                        // respuestas mayores no se guardan
+	idempotencyLock           = 5 * time.Minute                // vigencia de una clave en_proceso si el servidor se cae
+)
+
+// Idempotency guarda la respuesta de cada POST con Idempotency-Key durante ttl.
+// La clave vale por cliente y ruta: un reintento del mismo cliente a la misma
+// ruta con la misma petición recibe la respuesta original sin volver a
+// ejecutarse; con otra petición se rechaza. Las respuestas 5xx no se guardan
+// para que el cliente pueda reintentar, ni las marcadas con Cache-Control:
+// no-store (utils.NoStore), que llevan credenciales. En las rutas protegidas se
+// monta después de la autenticación, para no leer cuerpos de peticiones que se
+// van a rechazar.
+type Idempotency struct {
+	repo port.IdempotenciaRepository
+	ttl  time.Duration
+}
+
+func NewIdempotency(repo port.IdempotenciaRepository, ttl time.Duration) *Idempotency {
+	return &Idempotency{repo: repo, ttl: ttl}
+}
+
+func (m *Idempotency) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clave := r.Header.Get(IdempotencyKeyHeader)
+		if r.Method != http.MethodPost || clave == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(clave) > maxIdempotencyKeyLength {
+			problem.Invalid(w, r, i18n.MsgIdempotencyKeyLarga)
+			return
+		}
+
+		// El hash del cuerpo se calcula mientras el handler lo lee; hasta
+		// entonces la clave queda reservada sin hash
+		cuerpo := nuevoCuerpoConHash(r)
+		r.Body = cuerpo
+		registro := &domain.RegistroIdempotencia{
+			Clave:    claveConAlcance(r, clave),
+			Estado:   domain.IdempotenciaEnProceso,
+			ExpiraEn: time.Now().Add(idempotencyLock),
+		}
+		existente, reservado, err := m.repo.Reservar(r.Context(), registro)
+		if err != nil {
+			problem.Error(w, r, err)
+			return
+		}
+		if !reservado {
+			replay(w, r, cuerpo, existente)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		completed := false
+		defer func() {
+			if !completed {
+				// Panic en el handler: se libera la clave para permitir el reintento
+				m.release(r, registro.Clave)
+			}
+		}()
+		next.ServeHTTP(rec, r)
+		completed = true
+
+		// Lo que el handler no leyó también cuenta para el hash
+		hash, err := cuerpo.Sum()
+		if err != nil || rec.status >= http.StatusInternalServerError || rec.overflow || noStore(rec.headers) {
+			m.release(r, registro.Clave)
+			return
+		}
+
+		registro.Hash = hash
+		registro.Estado = domain.IdempotenciaCompletada
+		registro.Status = rec.status
+		registro.Headers = rec.headers
+		registro.Cuerpo = rec.body.Bytes()
+		registro.ExpiraEn = time.Now().Add(m.ttl)
+		if err := m.repo.Update(context.WithoutCancel(r.Context()), registro); err != nil {
+			log.Printf("Error al guardar respuesta idempotente %q: %v", clave, err)
+			m.release(r, registro.Clave)
+		}
+	})
+}
+
+func (m *Idempotency) release(r *http.Request, clave string) {
+	// Aunque el cliente se haya desconectado la clave debe quedar libre
+	if err := m.repo.Delete(context.WithoutCancel(r.Context()), clave); err != nil {
+		log.Printf("Error al liberar Idempotency-Key %s: %v", clave, err)
+	}
+}
+
+func replay(w http.ResponseWriter, r *http.Request, cuerpo *cuerpoConHash, existente *domain.RegistroIdempotencia) {
+	// Mientras la original está en proceso aún no hay hash con qué comparar
+	if existente.Estado != domain.IdempotenciaCompletada {
+		w.Header().Set("Retry-After", "1")
+		problem.Write(w, r, problem.New(http.StatusConflict, problem.CodeConflict, i18n.MsgIdempotencyEnCurso))
+		return
+	}
+	hash, err := cuerpo.Sum()
+	if errors.Is(err, errCuerpoMuyGrande) {
+		problem.Write(w, r, problem.New(http.StatusRequestEntityTooLarge, problem.CodePayloadTooLarge, i18n.MsgPeticionMuyGrande))
+		return
+	}
+	if err != nil {
+		problem.Invalid(w, r, i18n.MsgPeticionIlegible)
+		return
+	}
+	if existente.Hash != hash {
+		problem.Write(w, r, problem.New(http.StatusUnprocessableEntity, problem.CodeUnprocessable, i18n.MsgIdempotencyKeyReusada))
+		return
+	}
+
+	for name, values := range existente.Headers {
+		w.Header()[name] = values
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(existente.Status)
+	w.Write(existente.Cuerpo)
+}
+
+// claveConAlcance combina el Idempotency-Key con el cliente, el método y la
+// ruta, para que dos clientes que elijan la misma clave no compartan
+// respuestas. Se guarda solo el hash, que no revela las credenciales
+func claveConAlcance(r *http.Request, clave string) string {
+	hash := sha256.New()
+	io.WriteString(hash, cliente(r)+"\n"+r.Method+" "+r.URL.Path+"\n"+clave)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// cliente identifica a quien hace la petición por sus credenciales o, si no
+// envía ninguna, por su dirección IP
+func cliente(r *http.Request) string {
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		return "auth " + r.Header.Get(auth.HeaderAlumnoID) + " " + authorization
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip " + host
+}
+
+func noStore(headers http.Header) bool {
+	return strings.Contains(strings.ToLower(headers.Get("Cache-Control")), "no-store")
+}
+
+var errCuerpoMuyGrande = errors.New("cuerpo demasiado grande para idempotencia")
+
+// cuerpoConHash identifica la petición por método, ruta (con query) y cuerpo.
+// Calcula el hash a medida que se lee el cuerpo, sin guardarlo en memoria
+type cuerpoConHash struct {
+	io.ReadCloser
+	hash   hash.Hash
+	leidos int64
+}
+
+func nuevoCuerpoConHash(r *http.Request) *cuerpoConHash {
+	c := &cuerpoConHash{ReadCloser: r.Body, hash: sha256.New()}
+	io.WriteString(c.hash, r.Method+" "+r.URL.RequestURI()+"\n")
+	return c
+}
+
+func (c *cuerpoConHash) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.hash.Write(p[:n])
+	c.leidos += int64(n)
+	return n, err
+}
+
+// Sum lee lo que falte del cuerpo, hasta maxIdempotencyRequestSize, y
+// devuelve el hash de la petición
+func (c *cuerpoConHash) Sum() (string, error) {
+	if _, err := io.Copy(io.Discard, io.LimitReader(c, maxIdempotencyRequestSize+1-c.leidos)); err != nil {
+		return "", err
+	}
+	if c.leidos > maxIdempotencyRequestSize {
+		return "", errCuerpoMuyGrande
+	}
+	return hex.EncodeToString(c.hash.Sum(nil)), nil
+}
+
+// responseRecorder copia la respuesta mientras se envía al cliente
+type responseRecorder struct {
+	http.ResponseWriter
+	status   int
+	headers  http.Header
+	body     bytes.Buffer
+	wrote    bool
+	overflow bool
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if !rec.wrote {
+		rec.wrote = true
+		rec.status = status
+		rec.headers = rec.Header().Clone()
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(p []byte) (int, error) {
+	if !rec.wrote {
+		rec.WriteHeader(http.StatusOK)
+	}
+	if rec.body.Len()+len(p) > maxIdempotencyResponse {
+		rec.overflow = true
+	} else if !rec.overflow {
+		rec.body.Write(p)
+	}
+	return rec.ResponseWriter.Write(p)
+}
+
+func (rec *responseRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -0,0 +1,205 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// idempotente envuelve un handler que cuenta sus ejecuciones y responde con el
+// número de ejecución; /login responde como un inicio de sesión (no-store)
+func idempotente(t *testing.T) (http.Handler, *int, *gorm.DB) {
+	t.Helper()
+	db := storagetest.NewSQLite(t)
+	ejecuciones := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ejecuciones++
+		if strings.HasSuffix(r.URL.Path, "/login") {
+			utils.NoStore(w)
+		}
+		utils.JSON(w, http.StatusCreated, map[string]any{"ejecucion": ejecuciones, "sessionString": "s3cr3t"})
+	})
+	return NewIdempotency(relational.NewIdempotenciaRepository(db), time.Hour).Handler(next), &ejecuciones, db
+}
+
+func post(h http.Handler, path, clave string, headers map[string]string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"a":1}`))
+	r.Header.Set(IdempotencyKeyHeader, clave)
+	for name, value := range headers {
+		r.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestIdempotencyReplay(t *testing.T) {
+	h, ejecuciones, _ := idempotente(t)
+	admin := map[string]string{"Authorization": "Bearer admin"}
+
+	primera := post(h, "/v1/alumnos", "k-1", admin)
+	segunda := post(h, "/v1/alumnos", "k-1", admin)
+	if *ejecuciones != 1 {
+		t.Fatalf("el handler se ejecutó %d veces", *ejecuciones)
+	}
+	if segunda.Code != http.StatusCreated || segunda.Header().Get(IdempotentReplayedHeader) != "true" || segunda.Body.String() != primera.Body.String() {
+		t.Fatalf("reintento = %d %q (replayed=%q)", segunda.Code, segunda.Body, segunda.Header().Get(IdempotentReplayedHeader))
+	}
+
+	// La misma clave con otro cuerpo es un error del cliente
+	r := httptest.NewRequest(http.MethodPost, "/v1/alumnos", strings.NewReader(`{"a":2}`))
+	r.Header.Set(IdempotencyKeyHeader, "k-1")
+	r.Header.Set("Authorization", "Bearer admin")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("otra petición con la misma clave = %d", w.Code)
+	}
+}
+
+// La misma clave enviada por otro cliente o a otra ruta es una petición nueva
+func TestIdempotencyPorClienteYRuta(t *testing.T) {
+	h, ejecuciones, _ := idempotente(t)
+
+	for i, tc := range []struct {
+		path    string
+		headers map[string]string
+	}{
+		{"/v1/alumnos", map[string]string{"Authorization": "Bearer admin"}},
+		{"/v1/alumnos", map[string]string{"Authorization": "Bearer sesion-a", auth.HeaderAlumnoID: "1"}},
+		{"/v1/alumnos", map[string]string{"Authorization": "Bearer sesion-a", auth.HeaderAlumnoID: "2"}},
+		{"/v1/alumnos", nil}, // anónimo, por IP
+		{"/v1/profesores", map[string]string{"Authorization": "Bearer admin"}},
+	} {
+		w := post(h, tc.path, "compartida", tc.headers)
+		if w.Code != http.StatusCreated || w.Header().Get(IdempotentReplayedHeader) != "" {
+			t.Fatalf("petición %d: %d replayed=%q", i, w.Code, w.Header().Get(IdempotentReplayedHeader))
+		}
+		if want := fmt.Sprintf(`"ejecucion":%d`, i+1); !strings.Contains(w.Body.String(), want) {
+			t.Fatalf("petición %d recibió la respuesta de otra: %s", i, w.Body)
+		}
+	}
+	if *ejecuciones != 5 {
+		t.Fatalf("el handler se ejecutó %d veces, se esperaban 5", *ejecuciones)
+	}
+
+	// Otro cliente desde otra IP tampoco comparte la respuesta anónima
+	r := httptest.NewRequest(http.MethodPost, "/v1/alumnos", strings.NewReader(`{"a":1}`))
+	r.RemoteAddr = "198.51.100.7:4321"
+	r.Header.Set(IdempotencyKeyHeader, "compartida")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Header().Get(IdempotentReplayedHeader) != "" || *ejecuciones != 6 {
+		t.Fatalf("un cliente anónimo de otra IP recibió una respuesta guardada")
+	}
+}
+
+// Las respuestas con credenciales no se guardan y la clave no queda en claro
+func TestIdempotencyNoGuardaCredenciales(t *testing.T) {
+	h, ejecuciones, db := idempotente(t)
+
+	post(h, "/v1/alumnos/1/session/login", "login-1", nil)
+	w := post(h, "/v1/alumnos/1/session/login", "login-1", nil)
+	if *ejecuciones != 2 || w.Header().Get(IdempotentReplayedHeader) != "" {
+		t.Fatalf("el login se repitió desde la respuesta guardada (%d ejecuciones)", *ejecuciones)
+	}
+	post(h, "/v1/alumnos", "alta-1", nil)
+
+	var registros []domain.RegistroIdempotencia
+	if err := db.Find(&registros).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(registros) != 1 {
+		t.Fatalf("hay %d respuestas guardadas, se esperaba solo la del alta", len(registros))
+	}
+	if registros[0].Clave == "alta-1" || len(registros[0].Clave) != 64 {
+		t.Errorf("clave guardada = %q, se esperaba el hash", registros[0].Clave)
+	}
+}
+
+// El handler lee el cuerpo completo y el hash cubre también lo que no lee
+func TestIdempotencyHashMientrasSeLee(t *testing.T) {
+	db := storagetest.NewSQLite(t)
+	leido := ""
+	h := NewIdempotency(relational.NewIdempotenciaRepository(db), time.Hour).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		prefijo := make([]byte, 5)
+		io.ReadFull(r.Body, prefijo) // solo lee el principio
+		leido = string(prefijo)
+		utils.JSON(w, http.StatusCreated, map[string]string{"leido": leido})
+	}))
+	enviar := func(cuerpo string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/v1/alumnos", strings.NewReader(cuerpo))
+		r.Header.Set(IdempotencyKeyHeader, "k-1")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	if w := enviar(`{"a":1}`); w.Code != http.StatusCreated || leido != `{"a":` {
+		t.Fatalf("primera = %d, el handler leyó %q", w.Code, leido)
+	}
+	if w := enviar(`{"a":1}`); w.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Fatalf("reintento = %d %s, se esperaba la respuesta guardada", w.Code, w.Body)
+	}
+	if w := enviar(`{"a":2}`); w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("cuerpo distinto después de lo leído = %d, se esperaba 422", w.Code)
+	}
+}
+
+// cuerpoVigilado registra si alguien lo leyó
+type cuerpoVigilado struct {
+	io.Reader
+	leido bool
+}
+
+func (c *cuerpoVigilado) Read(p []byte) (int, error) {
+	c.leido = true
+	return c.Reader.Read(p)
+}
+
+// Montado después de la autenticación, una petición sin credenciales se
+// rechaza sin leer su cuerpo ni reservar la clave
+func TestIdempotencyDespuesDeAutenticar(t *testing.T) {
+	db := storagetest.NewSQLite(t)
+	idempotency := NewIdempotency(relational.NewIdempotenciaRepository(db), time.Hour)
+	admin := NewAuth(auth.NewAuthenticator(nil, "admin")).Admin
+	h := admin(idempotency.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusCreated)
+	})))
+
+	for _, tc := range []struct {
+		authorization string
+		status        int
+		registros     int64
+	}{
+		{"", http.StatusUnauthorized, 0},
+		{"Bearer admin", http.StatusCreated, 1},
+	} {
+		cuerpo := &cuerpoVigilado{Reader: strings.NewReader(strings.Repeat("x", 1<<20))}
+		r := httptest.NewRequest(http.MethodPost, "/v1/admin/plantillas", cuerpo)
+		r.Header.Set(IdempotencyKeyHeader, "k-1")
+		r.Header.Set("Authorization", tc.authorization)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		var registros int64
+		if err := db.Model(&domain.RegistroIdempotencia{}).Count(&registros).Error; err != nil {
+			t.Fatal(err)
+		}
+		if w.Code != tc.status || registros != tc.registros || cuerpo.leido != (tc.status == http.StatusCreated) {
+			t.Errorf("%q: status=%d registros=%d leído=%v", tc.authorization, w.Code, registros, cuerpo.leido)
+		}
+	}
+}
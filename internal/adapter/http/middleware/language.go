@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+)
+
+// Language negocia el idioma de la respuesta con Accept-Language y lo guarda en
+// el contexto; sin coincidencia se responde en el idioma por defecto
+func Language(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if lang := i18n.Negotiate(r.Header.Get("Accept-Language")); lang != "" {
+			r = r.WithContext(i18n.WithLang(r.Context(), lang))
+		}
+		w.Header().Set("Content-Language", i18n.FromContext(r.Context()))
+		w.Header().Add("Vary", "Accept-Language")
+		next.ServeHTTP(w, r)
+	})
+}
@@ -3,7 +3,8 @@ package middleware
 import (
 	"net/http"
 
-	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
 )
 
 // RequireJSON middleware para validar que el Content-Type sea application/json
@@ -14,7 +15,7 @@ func RequireJSON(next http.Handler) http.Handler {
 			// Permitir multipart/form-data para uploads
 			if contentType != "application/json" && contentType != "" {
 				if contentType != "multipart/form-data" && !isMultipart(contentType) {
-					utils.JSONError(w, http.StatusUnsupportedMediaType, "Content-Type debe ser application/json")
+					problem.Write(w, r, problem.New(http.StatusUnsupportedMediaType, problem.CodeUnsupportedMediaType, i18n.MsgContentTypeJSON))
 					return
 				}
 			}
@@ -0,0 +1,87 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/go-chi/chi/v5"
+)
+
+// Spec - Documento OpenAPI de un router. Se genera la primera vez que se usa,
+// cuando el router ya tiene todas sus rutas, y sirve tanto para publicarlo
+// como para validar peticiones y respuestas
+type Spec struct {
+	routes chi.Routes
+	once   sync.Once
+	doc    *Document
+	body   []byte
+	err    error
+}
+
+func New(routes chi.Routes) *Spec {
+	return &Spec{routes: routes}
+}
+
+func (s *Spec) load() error {
+	s.once.Do(func() {
+		if s.doc, s.err = Build(s.routes); s.err == nil {
+			s.body, s.err = json.Marshal(s.doc)
+		}
+	})
+	return s.err
+}
+
+// operation busca la operación documentada de la ruta que atiende r. El
+// contexto devuelto trae los parámetros de path de esa ruta
+func (s *Spec) operation(r *http.Request) (*Operation, *chi.Context) {
+	path := r.URL.RawPath
+	if path == "" {
+		path = r.URL.Path
+	}
+	rctx := chi.NewRouteContext()
+	patron := s.routes.Find(rctx, r.Method, path)
+	if patron == "" {
+		return nil, nil
+	}
+	if patron != "/" {
+		patron = strings.TrimSuffix(patron, "/")
+	}
+	return s.doc.Paths[openapiPath(patron)][strings.ToLower(r.Method)], rctx
+}
+
+// Handler sirve el documento, generado en la primera petición
+func (s *Spec) Handler(w http.ResponseWriter, r *http.Request) {
+	if err := s.load(); err != nil {
+		problem.Error(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(s.body)
+}
+
+// Docs sirve Swagger UI apuntando a specURL
+func Docs(specURL string) http.HandlerFunc {
+	page := []byte(`<!DOCTYPE html>
+<html lang="es">
+<head>
+  <meta charset="utf-8">
+  <title>aws-segundaentrega API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "` + specURL + `", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`)
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(page)
+	}
+}
@@ -0,0 +1,282 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/graphql"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/handler"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+)
+
+// ruta - Documentación de una operación del router. patron usa la sintaxis de
+// chi, igual que Router.Setup
+type ruta struct {
+	metodo     string
+	patron     string
+	tag        string
+	resumen    string
+	acceso     string
+	query      []Parameter
+	cuerpo     *cuerpo
+	respuestas []respuesta
+}
+
+// Acceso de las rutas protegidas por middleware.Auth
+const (
+	accesoAlumno = "alumno" // sesión del alumno {id} o token de administración
+	accesoAdmin  = "admin"
+)
+
+// cuerpo - Cuerpo de la petición. Con json se describe con el schema de ese
+// valor; con campos, como multipart/form-data; si no, como binario en tipos
+type cuerpo struct {
+	json   any
+	campos map[string]*Schema
+	tipos  []string
+}
+
+type respuesta struct {
+	status      int
+	descripcion string
+	json        any      // cuerpo JSON, nil si no hay
+	tipos       []string // cuerpo binario en estos Content-Type
+	headers     map[string]*Header
+}
+
+func jsonBody(v any) *cuerpo {
+	return &cuerpo{json: v}
+}
+
+func multipart(campos map[string]*Schema) *cuerpo {
+	return &cuerpo{campos: campos}
+}
+
+func binario(tipos ...string) *cuerpo {
+	return &cuerpo{tipos: tipos}
+}
+
+func ok(status int, descripcion string, v any) respuesta {
+	return respuesta{status: status, descripcion: descripcion, json: v}
+}
+
+func mensaje(status int, descripcion string) respuesta {
+	return respuesta{status: status, descripcion: descripcion, json: utils.Response{}}
+}
+
+func archivo(status int, descripcion string, tipos ...string) respuesta {
+	return respuesta{status: status, descripcion: descripcion, tipos: tipos}
+}
+
+func aceptado(descripcion string) respuesta {
+	return respuesta{
+		status:      http.StatusAccepted,
+		descripcion: descripcion,
+		json:        domain.Job{},
+		headers:     map[string]*Header{"Location": {Description: "URL para consultar el job", Schema: &Schema{Type: "string"}}},
+	}
+}
+
+func queryParam(name, descripcion string, schema *Schema) Parameter {
+	return Parameter{Name: name, In: "query", Description: descripcion, Schema: schema}
+}
+
+var (
+	stringSchema = &Schema{Type: "string"}
+	binarySchema = &Schema{Type: "string", Format: "binary"}
+
+	formatosTabulares = []string{tabular.ContentTypeCSV, tabular.ContentTypeXLSX}
+	formatosExport    = []string{tabular.ContentTypeCSV, tabular.ContentTypeXLSX, tabular.ContentTypeNDJSON}
+
+	dryRunParam = queryParam("dryRun", "Solo valida, sin guardar", &Schema{Type: "boolean"})
+	formatParam = queryParam("format", "Formato; si no viene se negocia con Accept",
+		&Schema{Type: "string", Enum: []any{tabular.FormatCSV, tabular.FormatXLSX, tabular.FormatNDJSON}})
+	importBody = &cuerpo{
+		campos: map[string]*Schema{"archivo": binarySchema},
+		tipos:  formatosTabulares,
+	}
+	firmaParams = []Parameter{
+		{Name: "expires", In: "query", Required: true, Description: "Vencimiento (UNIX)", Schema: &Schema{Type: "integer", Format: "int64"}},
+		{Name: "signature", In: "query", Required: true, Description: "Firma HMAC de la URL", Schema: stringSchema},
+	}
+)
+
+// versionAlias - Versión que Router.Setup monta también sin prefijo; esas
+// rutas se documentan como obsoletas
+const versionAlias = "v1"
+
+// versiones documenta las rutas de cada versión, relativas a su prefijo
+// (/v1). Una versión nueva agrega aquí su tabla, con sus propios DTOs
+var versiones = map[string][]ruta{
+	"v1": rutasV1,
+}
+
+// rutasGenerales documenta las operaciones de Router.Setup que no dependen de
+// la versión. Check falla si el router tiene una ruta que no está en estas
+// tablas
+var rutasGenerales = []ruta{
+	{metodo: "GET", patron: "/openapi.json", tag: "docs", resumen: "Especificación OpenAPI",
+		respuestas: []respuesta{ok(http.StatusOK, "Documento OpenAPI 3.1", map[string]any{})}},
+	{metodo: "GET", patron: "/docs", tag: "docs", resumen: "Swagger UI",
+		respuestas: []respuesta{archivo(http.StatusOK, "Página HTML", "text/html")}},
+
+	// GraphQL
+	{metodo: "POST", patron: "/graphql", tag: "graphql", resumen: "Ejecuta una consulta o mutación GraphQL",
+		cuerpo: jsonBody(graphql.Request{}), respuestas: []respuesta{ok(http.StatusOK, "Resultado con data y errors", map[string]any{})}},
+
+	// Archivos locales
+	{metodo: "GET", patron: "/files/*", tag: "archivos", resumen: "Descarga un archivo con URL firmada",
+		query: firmaParams, respuestas: []respuesta{archivo(http.StatusOK, "Contenido del archivo", "application/octet-stream")}},
+	{metodo: "PUT", patron: "/files/*", tag: "archivos", resumen: "Sube un archivo con URL firmada",
+		query: firmaParams, cuerpo: binario("application/octet-stream"), respuestas: []respuesta{mensaje(http.StatusOK, "Archivo subido")}},
+}
+
+var rutasV1 = []ruta{
+	// Alumnos
+	{metodo: "GET", patron: "/alumnos", tag: "alumnos", resumen: "Lista los alumnos",
+		respuestas: []respuesta{ok(http.StatusOK, "Alumnos", []domain.Alumno{})}},
+	{metodo: "POST", patron: "/alumnos", tag: "alumnos", resumen: "Crea un alumno",
+		cuerpo: jsonBody(handler.AlumnoInput{}), respuestas: []respuesta{ok(http.StatusCreated, "Alumno creado", domain.Alumno{})}},
+	{metodo: "POST", patron: "/alumnos/batch", tag: "alumnos", resumen: "Aplica un lote de altas, cambios y bajas",
+		cuerpo: jsonBody(handler.LoteRequest[handler.AlumnoInput]{}),
+		respuestas: []respuesta{
+			ok(http.StatusOK, "Todas las operaciones se aplicaron", domain.ResultadoLote{}),
+			ok(http.StatusMultiStatus, "Modo parcial con operaciones fallidas", domain.ResultadoLote{}),
+			ok(http.StatusUnprocessableEntity, "Lote atómico revertido", domain.ResultadoLote{}),
+		}},
+	{metodo: "GET", patron: "/alumnos/export", tag: "alumnos", resumen: "Exporta los alumnos",
+		query: []Parameter{formatParam}, respuestas: []respuesta{archivo(http.StatusOK, "Archivo exportado", formatosExport...)}},
+	{metodo: "POST", patron: "/alumnos/import", tag: "alumnos", resumen: "Importa alumnos desde CSV o XLSX",
+		query: []Parameter{dryRunParam}, cuerpo: importBody,
+		respuestas: []respuesta{
+			ok(http.StatusOK, "Reporte de la importación", domain.ResultadoImportacion{}),
+			ok(http.StatusUnprocessableEntity, "Alguna fila tiene errores; no se guardó nada", domain.ResultadoImportacion{}),
+		}},
+	{metodo: "GET", patron: "/alumnos/{id}", tag: "alumnos", resumen: "Obtiene un alumno",
+		respuestas: []respuesta{ok(http.StatusOK, "Alumno", domain.Alumno{})}},
+	{metodo: "PUT", patron: "/alumnos/{id}", tag: "alumnos", resumen: "Actualiza un alumno",
+		cuerpo: jsonBody(handler.AlumnoInput{}), respuestas: []respuesta{mensaje(http.StatusOK, "Alumno actualizado")}},
+	{metodo: "DELETE", patron: "/alumnos/{id}", tag: "alumnos", resumen: "Elimina un alumno",
+		respuestas: []respuesta{mensaje(http.StatusOK, "Alumno eliminado")}},
+	{metodo: "POST", patron: "/alumnos/{id}/fotoPerfil", tag: "alumnos", resumen: "Sube la foto de perfil",
+		cuerpo:     multipart(map[string]*Schema{"foto": binarySchema}),
+		respuestas: []respuesta{ok(http.StatusOK, "Foto guardada", handler.FotoPerfilResponse{})}},
+	{metodo: "DELETE", patron: "/alumnos/{id}/fotoPerfil", tag: "alumnos", resumen: "Elimina la foto de perfil",
+		respuestas: []respuesta{mensaje(http.StatusOK, "Foto eliminada")}},
+	{metodo: "POST", patron: "/alumnos/{id}/fotoPerfil/upload-url", tag: "alumnos", resumen: "Genera una URL para subir la foto directamente",
+		cuerpo: jsonBody(handler.FotoPerfilUploadRequest{}), respuestas: []respuesta{ok(http.StatusOK, "Instrucciones de subida", domain.PresignedUpload{})}},
+	{metodo: "POST", patron: "/alumnos/{id}/fotoPerfil/confirm", tag: "alumnos", resumen: "Confirma una foto subida con upload-url",
+		cuerpo: jsonBody(handler.FotoPerfilConfirmRequest{}), respuestas: []respuesta{ok(http.StatusOK, "Foto guardada", handler.FotoPerfilResponse{})}},
+	{metodo: "POST", patron: "/alumnos/{id}/email", tag: "alumnos", resumen: "Envía al alumno sus calificaciones",
+		respuestas: []respuesta{mensaje(http.StatusAccepted, "Email programado")}},
+
+	// Documentos
+	{metodo: "GET", patron: "/alumnos/{id}/documentos", tag: "documentos", resumen: "Lista los documentos del alumno", acceso: accesoAlumno,
+		respuestas: []respuesta{ok(http.StatusOK, "Documentos", []domain.Documento{})}},
+	{metodo: "POST", patron: "/alumnos/{id}/documentos", tag: "documentos", resumen: "Sube un documento", acceso: accesoAlumno,
+		cuerpo:     multipart(map[string]*Schema{"archivo": binarySchema, "tipo": stringSchema}),
+		respuestas: []respuesta{ok(http.StatusCreated, "Documento creado", domain.Documento{})}},
+	{metodo: "POST", patron: "/alumnos/{id}/documentos/stream", tag: "documentos", resumen: "Sube un documento como cuerpo crudo", acceso: accesoAlumno,
+		query: []Parameter{
+			queryParam("tipo", "Tipo de documento", stringSchema),
+			queryParam("nombre", "Nombre del archivo", stringSchema),
+		},
+		cuerpo: binario("application/octet-stream"), respuestas: []respuesta{ok(http.StatusCreated, "Documento creado", domain.Documento{})}},
+	{metodo: "GET", patron: "/alumnos/{id}/documentos/{documentoId}", tag: "documentos", resumen: "Obtiene un documento", acceso: accesoAlumno,
+		respuestas: []respuesta{ok(http.StatusOK, "Documento", domain.Documento{})}},
+	{metodo: "GET", patron: "/alumnos/{id}/documentos/{documentoId}/download", tag: "documentos", resumen: "Descarga un documento", acceso: accesoAlumno,
+		respuestas: []respuesta{archivo(http.StatusOK, "Contenido del documento", "application/octet-stream")}},
+	{metodo: "PUT", patron: "/alumnos/{id}/documentos/{documentoId}/review", tag: "documentos", resumen: "Aprueba o rechaza un documento", acceso: accesoAdmin,
+		cuerpo: jsonBody(handler.RevisionRequest{}), respuestas: []respuesta{ok(http.StatusOK, "Documento revisado", domain.Documento{})}},
+	{metodo: "DELETE", patron: "/alumnos/{id}/documentos/{documentoId}", tag: "documentos", resumen: "Elimina un documento", acceso: accesoAlumno,
+		respuestas: []respuesta{mensaje(http.StatusOK, "Documento eliminado")}},
+
+	// Sesiones
+	{metodo: "POST", patron: "/alumnos/{id}/session/login", tag: "sesiones", resumen: "Inicia sesión",
+		cuerpo: jsonBody(handler.LoginRequest{}), respuestas: []respuesta{ok(http.StatusOK, "Sesión creada", handler.LoginResponse{})}},
+	{metodo: "POST", patron: "/alumnos/{id}/session/verify", tag: "sesiones", resumen: "Verifica una sesión",
+		cuerpo: jsonBody(handler.SessionRequest{}), respuestas: []respuesta{mensaje(http.StatusOK, "Sesión válida")}},
+	{metodo: "POST", patron: "/alumnos/{id}/session/logout", tag: "sesiones", resumen: "Cierra una sesión",
+		cuerpo: jsonBody(handler.SessionRequest{}), respuestas: []respuesta{mensaje(http.StatusOK, "Sesión cerrada")}},
+
+	// Profesores
+	{metodo: "GET", patron: "/profesores", tag: "profesores", resumen: "Lista los profesores",
+		respuestas: []respuesta{ok(http.StatusOK, "Profesores", []domain.Profesor{})}},
+	{metodo: "POST", patron: "/profesores", tag: "profesores", resumen: "Crea un profesor",
+		cuerpo: jsonBody(domain.Profesor{}), respuestas: []respuesta{ok(http.StatusCreated, "Profesor creado", domain.Profesor{})}},
+	{metodo: "POST", patron: "/profesores/batch", tag: "profesores", resumen: "Aplica un lote de altas, cambios y bajas",
+		cuerpo: jsonBody(handler.LoteRequest[domain.Profesor]{}),
+		respuestas: []respuesta{
+			ok(http.StatusOK, "Todas las operaciones se aplicaron", domain.ResultadoLote{}),
+			ok(http.StatusMultiStatus, "Modo parcial con operaciones fallidas", domain.ResultadoLote{}),
+			ok(http.StatusUnprocessableEntity, "Lote atómico revertido", domain.ResultadoLote{}),
+		}},
+	{metodo: "GET", patron: "/profesores/export", tag: "profesores", resumen: "Exporta los profesores",
+		query: []Parameter{formatParam}, respuestas: []respuesta{archivo(http.StatusOK, "Archivo exportado", formatosExport...)}},
+	{metodo: "POST", patron: "/profesores/import", tag: "profesores", resumen: "Importa profesores desde CSV o XLSX",
+		query: []Parameter{dryRunParam}, cuerpo: importBody,
+		respuestas: []respuesta{
+			ok(http.StatusOK, "Reporte de la importación", domain.ResultadoImportacion{}),
+			ok(http.StatusUnprocessableEntity, "Alguna fila tiene errores; no se guardó nada", domain.ResultadoImportacion{}),
+		}},
+	{metodo: "GET", patron: "/profesores/{id}", tag: "profesores", resumen: "Obtiene un profesor",
+		respuestas: []respuesta{ok(http.StatusOK, "Profesor", domain.Profesor{})}},
+	{metodo: "PUT", patron: "/profesores/{id}", tag: "profesores", resumen: "Actualiza un profesor",
+		cuerpo: jsonBody(domain.Profesor{}), respuestas: []respuesta{mensaje(http.StatusOK, "Profesor actualizado")}},
+	{metodo: "DELETE", patron: "/profesores/{id}", tag: "profesores", resumen: "Elimina un profesor",
+		respuestas: []respuesta{mensaje(http.StatusOK, "Profesor eliminado")}},
+
+	// Plantillas
+	{metodo: "GET", patron: "/admin/plantillas", tag: "plantillas", resumen: "Lista las plantillas de notificación", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusOK, "Plantillas", []domain.PlantillaNotificacion{})}},
+	{metodo: "POST", patron: "/admin/plantillas", tag: "plantillas", resumen: "Crea una plantilla", acceso: accesoAdmin,
+		cuerpo: jsonBody(domain.PlantillaNotificacion{}), respuestas: []respuesta{ok(http.StatusCreated, "Plantilla creada", domain.PlantillaNotificacion{})}},
+	{metodo: "POST", patron: "/admin/plantillas/preview", tag: "plantillas", resumen: "Renderiza una plantilla sin guardarla", acceso: accesoAdmin,
+		cuerpo: jsonBody(domain.PlantillaNotificacion{}), respuestas: []respuesta{ok(http.StatusOK, "Mensaje renderizado", domain.Mensaje{})}},
+	{metodo: "GET", patron: "/admin/plantillas/{id}", tag: "plantillas", resumen: "Obtiene una plantilla", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusOK, "Plantilla", domain.PlantillaNotificacion{})}},
+	{metodo: "PUT", patron: "/admin/plantillas/{id}", tag: "plantillas", resumen: "Actualiza una plantilla", acceso: accesoAdmin,
+		cuerpo: jsonBody(domain.PlantillaNotificacion{}), respuestas: []respuesta{ok(http.StatusOK, "Plantilla actualizada", domain.PlantillaNotificacion{})}},
+	{metodo: "DELETE", patron: "/admin/plantillas/{id}", tag: "plantillas", resumen: "Elimina una plantilla", acceso: accesoAdmin,
+		respuestas: []respuesta{mensaje(http.StatusOK, "Plantilla eliminada")}},
+	{metodo: "GET", patron: "/admin/plantillas/{id}/preview", tag: "plantillas", resumen: "Renderiza una plantilla guardada", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusOK, "Mensaje renderizado", domain.Mensaje{})}},
+
+	// Notificaciones
+	{metodo: "GET", patron: "/admin/notificaciones", tag: "notificaciones", resumen: "Lista las notificaciones más recientes", acceso: accesoAdmin,
+		query:      []Parameter{queryParam("estado", "Filtra por estado", stringSchema)},
+		respuestas: []respuesta{ok(http.StatusOK, "Notificaciones", []domain.Notificacion{})}},
+	{metodo: "POST", patron: "/admin/notificaciones/replay", tag: "notificaciones", resumen: "Reencola las notificaciones fallidas", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusAccepted, "Notificaciones reencoladas", handler.ReplayFallidasResponse{})}},
+	{metodo: "GET", patron: "/admin/notificaciones/{id}", tag: "notificaciones", resumen: "Obtiene una notificación", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusOK, "Notificación", domain.Notificacion{})}},
+	{metodo: "POST", patron: "/admin/notificaciones/{id}/replay", tag: "notificaciones", resumen: "Reencola una notificación", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusAccepted, "Notificación reencolada", domain.Notificacion{})}},
+
+	// Webhooks
+	{metodo: "GET", patron: "/admin/webhooks", tag: "webhooks", resumen: "Lista las suscripciones", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusOK, "Suscripciones", []domain.WebhookSuscripcion{})}},
+	{metodo: "POST", patron: "/admin/webhooks", tag: "webhooks", resumen: "Crea una suscripción", acceso: accesoAdmin,
+		cuerpo: jsonBody(domain.WebhookSuscripcion{}), respuestas: []respuesta{ok(http.StatusCreated, "Suscripción creada", domain.WebhookSuscripcion{})}},
+	{metodo: "GET", patron: "/admin/webhooks/{id}", tag: "webhooks", resumen: "Obtiene una suscripción", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusOK, "Suscripción", domain.WebhookSuscripcion{})}},
+	{metodo: "PUT", patron: "/admin/webhooks/{id}", tag: "webhooks", resumen: "Actualiza una suscripción", acceso: accesoAdmin,
+		cuerpo: jsonBody(domain.WebhookSuscripcion{}), respuestas: []respuesta{ok(http.StatusOK, "Suscripción actualizada", domain.WebhookSuscripcion{})}},
+	{metodo: "DELETE", patron: "/admin/webhooks/{id}", tag: "webhooks", resumen: "Elimina una suscripción", acceso: accesoAdmin,
+		respuestas: []respuesta{mensaje(http.StatusOK, "Suscripción eliminada")}},
+	{metodo: "GET", patron: "/admin/webhooks/{id}/entregas", tag: "webhooks", resumen: "Lista las entregas de una suscripción", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusOK, "Entregas", []domain.WebhookEntrega{})}},
+	{metodo: "POST", patron: "/admin/webhooks/{id}/entregas/{entregaId}/replay", tag: "webhooks", resumen: "Reenvía una entrega", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusAccepted, "Entrega reencolada", domain.WebhookEntrega{})}},
+
+	// Jobs
+	{metodo: "POST", patron: "/jobs/{recurso}/import", tag: "jobs", resumen: "Importa en segundo plano", acceso: accesoAdmin,
+		query: []Parameter{dryRunParam}, cuerpo: importBody, respuestas: []respuesta{aceptado("Job creado")}},
+	{metodo: "POST", patron: "/jobs/{recurso}/export", tag: "jobs", resumen: "Exporta en segundo plano", acceso: accesoAdmin,
+		query: []Parameter{formatParam}, respuestas: []respuesta{aceptado("Job creado")}},
+	{metodo: "GET", patron: "/jobs/{id}", tag: "jobs", resumen: "Consulta el estado de un job", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusOK, "Job", domain.Job{})}},
+	{metodo: "POST", patron: "/jobs/{id}/cancel", tag: "jobs", resumen: "Cancela un job", acceso: accesoAdmin,
+		respuestas: []respuesta{ok(http.StatusOK, "Cancelación solicitada", domain.Job{})}},
+}
@@ -0,0 +1,171 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaRegistry genera los schemas de los structs como componentes
+// reutilizables y devuelve referencias a ellos
+type schemaRegistry struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		components: make(map[string]*Schema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+// schemaOf devuelve el schema del valor v (normalmente el valor cero del tipo)
+func (s *schemaRegistry) schemaOf(v any) *Schema {
+	return s.schema(reflect.TypeOf(v))
+}
+
+func (s *schemaRegistry) schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return &Schema{Type: "string", Format: "byte"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Minimum: ptr(0.0)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: s.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schema(t.Elem())}
+	case reflect.Struct:
+		return s.ref(t)
+	default:
+		return &Schema{} // interface{}: cualquier valor
+	}
+}
+
+// ref registra el struct t como componente la primera vez y devuelve su $ref
+func (s *schemaRegistry) ref(t reflect.Type) *Schema {
+	name, ok := s.names[t]
+	if !ok {
+		name = schemaName(t)
+		s.names[t] = name
+		s.components[name] = &Schema{} // reservado por si el tipo es recursivo
+		*s.components[name] = *s.object(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// object describe los campos serializables de t. Es cerrado: un campo que no
+// está en el struct no es válido
+func (s *schemaRegistry) object(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema), AdditionalProperties: false}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, ok := jsonName(field)
+		if !ok {
+			continue
+		}
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			if embedded := s.schema(field.Type); embedded.Ref != "" {
+				for k, v := range s.components[strings.TrimPrefix(embedded.Ref, "#/components/schemas/")].Properties {
+					schema.Properties[k] = v
+				}
+				continue
+			}
+		}
+		property := s.schema(field.Type)
+		if tag, ok := field.Tag.Lookup("openapi"); ok {
+			if restricciones(property, tag) {
+				schema.Required = append(schema.Required, name)
+			}
+		}
+		schema.Properties[name] = property
+	}
+	return schema
+}
+
+// restricciones aplica el tag openapi a schema y dice si el campo es
+// requerido. Ej.: `openapi:"required,enum=crear|eliminar,minimum=0,minLength=1"`
+func restricciones(schema *Schema, tag string) (required bool) {
+	for _, opt := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(opt, "=")
+		switch key {
+		case "required":
+			required = true
+		case "enum":
+			for _, v := range strings.Split(value, "|") {
+				schema.Enum = append(schema.Enum, v)
+			}
+		case "minimum":
+			if f, err := strconv.ParseFloat(value, 64); err == nil {
+				schema.Minimum = &f
+			}
+		case "maximum":
+			if f, err := strconv.ParseFloat(value, 64); err == nil {
+				schema.Maximum = &f
+			}
+		case "minLength":
+			if n, err := strconv.Atoi(value); err == nil {
+				schema.MinLength = &n
+			}
+		}
+	}
+	return required
+}
+
+// jsonName lee el tag json del campo; ok es false si el campo no se serializa
+func jsonName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+// schemaName usa el nombre del tipo; los genéricos concatenan el nombre corto
+// de sus argumentos (LoteRequest[handler.AlumnoInput] -> LoteRequestAlumnoInput)
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	base, args, ok := strings.Cut(name, "[")
+	if !ok {
+		return name
+	}
+	var b strings.Builder
+	b.WriteString(base)
+	for _, arg := range strings.Split(strings.TrimSuffix(args, "]"), ",") {
+		b.WriteString(arg[strings.LastIndex(arg, ".")+1:])
+	}
+	return b.String()
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
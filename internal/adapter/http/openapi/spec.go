@@ -0,0 +1,254 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	"github.com/go-chi/chi/v5"
+)
+
+var pathParamRegexp = regexp.MustCompile(`\{(\w+)\}`)
+
+// Operacion - Ruta del router tal como aparece en la especificación
+type Operacion struct {
+	Metodo   string
+	Patron   string // sintaxis de chi, sin "/" final
+	Path     string // sintaxis de OpenAPI
+	Obsoleta bool   // alias sin prefijo de versión
+}
+
+// Routes devuelve las operaciones registradas en routes ordenadas por patrón
+func Routes(routes chi.Routes) ([]Operacion, error) {
+	documentadas := rutasPorClave()
+	var operaciones []Operacion
+	err := chi.Walk(routes, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		patron := route
+		if patron != "/" {
+			patron = strings.TrimSuffix(patron, "/")
+		}
+		operaciones = append(operaciones, Operacion{
+			Metodo:   method,
+			Patron:   patron,
+			Path:     openapiPath(patron),
+			Obsoleta: documentadas[method+" "+patron].alias,
+		})
+		return nil
+	})
+	sort.Slice(operaciones, func(i, j int) bool {
+		if operaciones[i].Patron != operaciones[j].Patron {
+			return operaciones[i].Patron < operaciones[j].Patron
+		}
+		return operaciones[i].Metodo < operaciones[j].Metodo
+	})
+	return operaciones, err
+}
+
+// Check devuelve un error con las rutas del router que no están documentadas
+func Check(routes chi.Routes) error {
+	operaciones, err := Routes(routes)
+	if err != nil {
+		return err
+	}
+	documentadas := rutasPorClave()
+
+	var faltantes []string
+	for _, op := range operaciones {
+		if _, ok := documentadas[op.Metodo+" "+op.Patron]; !ok {
+			faltantes = append(faltantes, op.Metodo+" "+op.Patron)
+		}
+	}
+	if len(faltantes) > 0 {
+		return fmt.Errorf("rutas sin documentar en OpenAPI: %s", strings.Join(faltantes, ", "))
+	}
+	return nil
+}
+
+// Build genera el documento con las operaciones documentadas que existen en
+// routes; así /files solo aparece cuando el almacenamiento es local
+func Build(routes chi.Routes) (*Document, error) {
+	operaciones, err := Routes(routes)
+	if err != nil {
+		return nil, err
+	}
+	documentadas := rutasPorClave()
+	registry := newSchemaRegistry()
+	problemSchema := registry.schemaOf(problem.Problem{})
+
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:   "aws-segundaentrega API",
+			Version: "1.0.0",
+			Description: "Las rutas van bajo /v1; sin prefijo son alias obsoletos que responden con " +
+				"Deprecation, Sunset y un Link a su sucesora. " +
+				"Los errores se devuelven como application/problem+json (RFC 7807). " +
+				"Los mensajes se traducen según Accept-Language (es, en). " +
+				"Los POST aceptan Idempotency-Key, que vale por cliente y ruta. " +
+				"Las rutas protegidas piden la sesión del alumno (Authorization: Bearer <sessionString> " +
+				"con X-Alumno-ID) o el token de administración.",
+		},
+		Paths: make(map[string]PathItem),
+	}
+	for _, op := range operaciones {
+		ruta, ok := documentadas[op.Metodo+" "+op.Patron]
+		if !ok {
+			continue
+		}
+		item := doc.Paths[op.Path]
+		if item == nil {
+			item = make(PathItem)
+			doc.Paths[op.Path] = item
+		}
+		operation := ruta.operation(op, registry, problemSchema)
+		if op.Obsoleta {
+			operation.Deprecated = true
+			operation.Description = "Alias obsoleto de /" + versionAlias + op.Path
+		}
+		item[strings.ToLower(op.Metodo)] = operation
+	}
+	doc.Components.Schemas = registry.components
+	doc.Components.SecuritySchemes = map[string]*SecurityScheme{
+		"sesion": {Type: "http", Scheme: "bearer", Description: "sessionString del login, junto con X-Alumno-ID"},
+		"admin":  {Type: "http", Scheme: "bearer", Description: "ADMIN_API_TOKEN"},
+	}
+	return doc, nil
+}
+
+// rutaDocumentada - Ruta de una tabla; alias indica que es la versión sin
+// prefijo de una ruta de versionAlias
+type rutaDocumentada struct {
+	ruta
+	alias bool
+}
+
+// rutasPorClave indexa las rutas por método y patrón completo: las generales
+// tal cual, las de cada versión bajo /{version} y las de versionAlias también
+// sin prefijo
+func rutasPorClave() map[string]rutaDocumentada {
+	porClave := make(map[string]rutaDocumentada)
+	for _, r := range rutasGenerales {
+		porClave[r.metodo+" "+r.patron] = rutaDocumentada{ruta: r}
+	}
+	for version, rutas := range versiones {
+		for _, r := range rutas {
+			porClave[r.metodo+" /"+version+r.patron] = rutaDocumentada{ruta: r}
+		}
+	}
+	for _, r := range versiones[versionAlias] {
+		porClave[r.metodo+" "+r.patron] = rutaDocumentada{ruta: r, alias: true}
+	}
+	return porClave
+}
+
+func (r ruta) operation(op Operacion, registry *schemaRegistry, problemSchema *Schema) *Operation {
+	operation := &Operation{
+		OperationID: operationID(op),
+		Tags:        []string{r.tag},
+		Summary:     r.resumen,
+		Parameters:  append(pathParams(op.Patron), r.query...),
+		Responses:   make(map[string]*Response),
+	}
+	if op.Metodo == http.MethodPost {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:        "Idempotency-Key",
+			In:          "header",
+			Description: "Repite la respuesta guardada si la petición se reintenta",
+			Schema:      &Schema{Type: "string"},
+		})
+	}
+
+	switch r.acceso {
+	case accesoAlumno:
+		operation.Security = []map[string][]string{{"sesion": {}}, {"admin": {}}}
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:        auth.HeaderAlumnoID,
+			In:          "header",
+			Description: "Alumno dueño de la sesión; no hace falta con el token de administración",
+			Schema:      &Schema{Type: "string"},
+		})
+	case accesoAdmin:
+		operation.Security = []map[string][]string{{"admin": {}}}
+	}
+
+	if r.cuerpo != nil {
+		body := &RequestBody{Required: true, Content: make(map[string]*MediaType)}
+		if r.cuerpo.json != nil {
+			body.Content["application/json"] = &MediaType{Schema: registry.schemaOf(r.cuerpo.json)}
+		}
+		if r.cuerpo.campos != nil {
+			body.Content["multipart/form-data"] = &MediaType{Schema: &Schema{Type: "object", Properties: r.cuerpo.campos}}
+		}
+		for _, tipo := range r.cuerpo.tipos {
+			body.Content[tipo] = &MediaType{Schema: &Schema{Type: "string", Format: "binary"}}
+		}
+		operation.RequestBody = body
+	}
+
+	for _, res := range r.respuestas {
+		response := &Response{Description: res.descripcion, Headers: res.headers}
+		if res.json != nil {
+			response.Content = map[string]*MediaType{"application/json": {Schema: registry.schemaOf(res.json)}}
+		}
+		for _, tipo := range res.tipos {
+			if response.Content == nil {
+				response.Content = make(map[string]*MediaType)
+			}
+			response.Content[tipo] = &MediaType{Schema: &Schema{Type: "string", Format: "binary"}}
+		}
+		operation.Responses[strconv.Itoa(res.status)] = response
+	}
+	operation.Responses["default"] = &Response{
+		Description: "Error",
+		Content:     map[string]*MediaType{problem.ContentType: {Schema: problemSchema}},
+	}
+	return operation
+}
+
+// openapiPath convierte el comodín final de chi en el parámetro {key}
+func openapiPath(patron string) string {
+	if p, ok := strings.CutSuffix(patron, "/*"); ok {
+		return p + "/{key}"
+	}
+	return patron
+}
+
+func pathParams(patron string) []Parameter {
+	var params []Parameter
+	for _, m := range pathParamRegexp.FindAllStringSubmatch(patron, -1) {
+		schema := &Schema{Type: "integer", Minimum: ptr(1.0)}
+		if m[1] == "recurso" {
+			schema = &Schema{Type: "string", Enum: []any{"alumnos", "profesores"}}
+		}
+		params = append(params, Parameter{Name: m[1], In: "path", Required: true, Schema: schema})
+	}
+	if strings.HasSuffix(patron, "/*") {
+		params = append(params, Parameter{
+			Name:        "key",
+			In:          "path",
+			Required:    true,
+			Description: "Llave del archivo; puede contener /",
+			Schema:      &Schema{Type: "string"},
+		})
+	}
+	return params
+}
+
+// operationID arma un identificador estable con el método y los segmentos
+// del path: GET /alumnos/{id}/documentos -> getAlumnosIdDocumentos
+func operationID(op Operacion) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(op.Metodo))
+	for _, segmento := range strings.Split(op.Path, "/") {
+		segmento = strings.Trim(segmento, "{}")
+		for _, parte := range strings.FieldsFunc(segmento, func(r rune) bool { return r == '-' || r == '.' }) {
+			b.WriteString(strings.ToUpper(parte[:1]) + parte[1:])
+		}
+	}
+	return b.String()
+}
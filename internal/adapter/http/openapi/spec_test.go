@@ -0,0 +1,68 @@
+package openapi_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/graphql"
+	apphttp "github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/handler"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/middleware"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/openapi"
+	"github.com/go-chi/chi/v5"
+)
+
+// router arma Router.Setup con handlers sin servicios: Check y Build solo
+// recorren las rutas, nunca las ejecutan
+func router(t *testing.T) *chi.Mux {
+	t.Helper()
+	return apphttp.NewRouter(
+		handler.NewAlumnoHandler(nil),
+		handler.NewProfesorHandler(nil),
+		handler.NewSesionHandler(nil),
+		handler.NewDocumentoHandler(nil),
+		handler.NewPlantillaHandler(nil),
+		handler.NewNotificacionHandler(nil),
+		handler.NewWebhookHandler(nil),
+		handler.NewJobHandler(nil),
+		handler.NewFileHandler(nil), // incluye /files, que solo existe con almacenamiento local
+		graphql.NewHandler(nil, nil, nil, nil, nil),
+		middleware.NewIdempotency(nil, time.Hour),
+		middleware.NewDeprecation(time.Time{}, time.Time{}),
+		middleware.NewAuth(nil),
+		true,
+	).Setup()
+}
+
+func TestCheckDocumentaTodasLasRutas(t *testing.T) {
+	if err := openapi.Check(router(t)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckDetectaRutasSinDocumentar(t *testing.T) {
+	r := router(t)
+	r.Get("/v1/sin-documentar", func(w http.ResponseWriter, r *http.Request) {})
+
+	err := openapi.Check(r)
+	if err == nil || !strings.Contains(err.Error(), "GET /v1/sin-documentar") {
+		t.Fatalf("Check = %v, se esperaba la ruta sin documentar", err)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	doc, err := openapi.Build(router(t))
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	for _, path := range []string{"/v1/alumnos", "/v1/alumnos/{id}/documentos", "/alumnos", "/files/{key}", "/graphql"} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Errorf("falta %s en el documento", path)
+		}
+	}
+	if op := doc.Paths["/alumnos"]["get"]; op == nil || !op.Deprecated {
+		t.Errorf("el alias /alumnos no está marcado como obsoleto: %+v", op)
+	}
+}
@@ -0,0 +1,388 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/go-chi/chi/v5"
+)
+
+// maxCuerpoJSON - Tamaño máximo de un cuerpo JSON (el mayor es un lote)
+const maxCuerpoJSON = 5 << 20
+
+// Validate rechaza con 400 validation_failed las peticiones cuyos parámetros
+// de path y query o cuyo cuerpo JSON no cumplen el schema de su operación:
+// tipos, enums, mínimos, requeridos y campos desconocidos. Las rutas sin
+// documentar pasan sin validar para que el router responda 404 o 405
+func (s *Spec) Validate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := s.load(); err != nil {
+			problem.Error(w, r, err)
+			return
+		}
+		operation, rctx := s.operation(r)
+		if operation == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		v := &validador{components: s.doc.Components.Schemas, errs: &apperrors.ValidationErrors{}}
+		v.params(operation.Parameters, rctx, r)
+
+		if media := requestJSON(operation, r); media != nil {
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCuerpoJSON))
+			if err != nil {
+				problem.Error(w, r, err)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			value, err := decode(body)
+			if err != nil {
+				problem.Invalid(w, r, i18n.MsgJSONInvalido)
+				return
+			}
+			v.value(media.Schema, value, "")
+		}
+
+		if v.errs.HasErrors() {
+			problem.Error(w, r, v.errs)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ValidateResponses comprueba que las respuestas JSON cumplan el schema
+// documentado para su status. Una respuesta que no lo cumple se registra en
+// el log y se cambia por un 500; pensado para pruebas, no para producción
+func (s *Spec) ValidateResponses(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := s.load(); err != nil {
+			problem.Error(w, r, err)
+			return
+		}
+		operation, _ := s.operation(r)
+		if operation == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &responseBuffer{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		if !rec.buffered {
+			return
+		}
+
+		if err := s.validateResponse(operation, rec); err != nil {
+			log.Printf("Respuesta de %s %s no cumple OpenAPI: %v", r.Method, r.URL.Path, err)
+			w.Header().Del("Content-Length")
+			problem.Write(w, r, problem.New(http.StatusInternalServerError, problem.CodeInternal, i18n.MsgRespuestaInvalida))
+			return
+		}
+		w.WriteHeader(rec.status)
+		w.Write(rec.body.Bytes())
+	})
+}
+
+func (s *Spec) validateResponse(operation *Operation, rec *responseBuffer) error {
+	response := operation.Responses[strconv.Itoa(rec.status)]
+	if response == nil {
+		response = operation.Responses["default"]
+	}
+	if response == nil {
+		return fmt.Errorf("status %d sin documentar", rec.status)
+	}
+	if rec.body.Len() == 0 {
+		return nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(rec.Header().Get("Content-Type"))
+	media := response.Content[mediaType]
+	if media == nil {
+		return fmt.Errorf("%s sin documentar para el status %d", mediaType, rec.status)
+	}
+
+	value, err := decode(rec.body.Bytes())
+	if err != nil {
+		return err
+	}
+	v := &validador{components: s.doc.Components.Schemas, errs: &apperrors.ValidationErrors{}}
+	v.value(media.Schema, value, "")
+	if v.errs.HasErrors() {
+		return v.errs
+	}
+	return nil
+}
+
+// requestJSON devuelve el schema JSON del cuerpo si la operación lo acepta.
+// Los cuerpos con otro tipo documentado (multipart, archivos) no se validan
+// aquí; cualquier otro se trata como JSON, igual que en los handlers
+func requestJSON(operation *Operation, r *http.Request) *MediaType {
+	if operation.RequestBody == nil {
+		return nil
+	}
+	media := operation.RequestBody.Content["application/json"]
+	if media == nil {
+		return nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "application/json" && operation.RequestBody.Content[mediaType] != nil {
+		return nil
+	}
+	return media
+}
+
+func decode(body []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	err := decoder.Decode(&value)
+	return value, err
+}
+
+// validador acumula en errs los errores de un valor contra un schema; field es
+// la ruta del campo (operaciones[0].datos.nombres)
+type validador struct {
+	components map[string]*Schema
+	errs       *apperrors.ValidationErrors
+}
+
+// params valida los parámetros de path y de query. Llegan como texto, así
+// que se convierten al tipo del schema antes de validarlos
+func (v *validador) params(params []Parameter, rctx *chi.Context, r *http.Request) {
+	query := r.URL.Query()
+	for _, param := range params {
+		var raw string
+		var ok bool
+		switch param.In {
+		case "path":
+			name := param.Name
+			if name == "key" {
+				name = "*"
+			}
+			raw = rctx.URLParam(name)
+			ok = raw != ""
+		case "query":
+			ok = query.Has(param.Name)
+			raw = query.Get(param.Name)
+		default:
+			continue
+		}
+
+		if !ok {
+			if param.Required {
+				v.errs.Add(param.Name, i18n.MsgCampoRequerido, param.Name)
+			}
+			continue
+		}
+		v.value(param.Schema, v.convert(param.Schema, raw), param.Name)
+	}
+}
+
+func (v *validador) convert(schema *Schema, raw string) any {
+	switch v.resolve(schema).Type {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(raw, 64); err == nil {
+			return json.Number(raw)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(raw); err == nil {
+			return b
+		}
+	}
+	return raw
+}
+
+func (v *validador) resolve(schema *Schema) *Schema {
+	if schema == nil {
+		return &Schema{}
+	}
+	if name, ok := strings.CutPrefix(schema.Ref, "#/components/schemas/"); ok {
+		if component := v.components[name]; component != nil {
+			return component
+		}
+	}
+	return schema
+}
+
+// value valida value contra schema. null se acepta en cualquier campo, igual
+// que al decodificarlo en un struct
+func (v *validador) value(schema *Schema, value any, field string) {
+	schema = v.resolve(schema)
+	if value == nil {
+		return
+	}
+	if !tipoValido(schema, value) {
+		tipo := schema.Type
+		if schema.Format == "date-time" {
+			tipo = "string (date-time)"
+		}
+		v.errs.Add(campo(field), i18n.MsgTipoInvalido, campo(field), tipo)
+		return
+	}
+	if len(schema.Enum) > 0 && !slices.ContainsFunc(schema.Enum, func(e any) bool { return fmt.Sprint(e) == fmt.Sprint(value) }) {
+		permitidos := make([]string, len(schema.Enum))
+		for i, e := range schema.Enum {
+			permitidos[i] = fmt.Sprint(e)
+		}
+		v.errs.Add(campo(field), i18n.MsgValorNoPermitido, campo(field), strings.Join(permitidos, ", "))
+		return
+	}
+
+	switch value := value.(type) {
+	case json.Number:
+		n, _ := value.Float64()
+		if schema.Minimum != nil && n < *schema.Minimum {
+			v.errs.Add(campo(field), i18n.MsgValorMinimo, campo(field), *schema.Minimum)
+		}
+		if schema.Maximum != nil && n > *schema.Maximum {
+			v.errs.Add(campo(field), i18n.MsgValorMaximo, campo(field), *schema.Maximum)
+		}
+	case string:
+		if schema.MinLength != nil && utf8.RuneCountInString(value) < *schema.MinLength {
+			if *schema.MinLength == 1 {
+				v.errs.Add(campo(field), i18n.MsgCampoRequerido, campo(field))
+			} else {
+				v.errs.Add(campo(field), i18n.MsgLongitudMinima, campo(field), *schema.MinLength)
+			}
+		}
+	case []any:
+		for i, item := range value {
+			v.value(schema.Items, item, fmt.Sprintf("%s[%d]", field, i))
+		}
+	case map[string]any:
+		v.object(schema, value, field)
+	}
+}
+
+func (v *validador) object(schema *Schema, value map[string]any, field string) {
+	for _, name := range schema.Required {
+		if value[name] == nil {
+			v.errs.Add(hijo(field, name), i18n.MsgCampoRequerido, hijo(field, name))
+		}
+	}
+
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if property, ok := schema.Properties[name]; ok {
+			v.value(property, value[name], hijo(field, name))
+			continue
+		}
+		switch additional := schema.AdditionalProperties.(type) {
+		case bool:
+			if !additional {
+				v.errs.Add(hijo(field, name), i18n.MsgCampoDesconocido, hijo(field, name))
+			}
+		case *Schema:
+			v.value(additional, value[name], hijo(field, name))
+		}
+	}
+}
+
+func tipoValido(schema *Schema, value any) bool {
+	switch schema.Type {
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := strconv.ParseInt(string(n), 10, 64)
+		return err == nil
+	case "number":
+		_, ok := value.(json.Number)
+		return ok
+	case "string":
+		s, ok := value.(string)
+		if ok && schema.Format == "date-time" {
+			_, err := time.Parse(time.RFC3339, s)
+			return err == nil
+		}
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	}
+	return true
+}
+
+// campo nombra el cuerpo completo cuando el error no es de un campo
+func campo(field string) string {
+	if field == "" {
+		return "body"
+	}
+	return field
+}
+
+func hijo(field, name string) string {
+	if field == "" {
+		return name
+	}
+	return field + "." + name
+}
+
+// responseBuffer guarda las respuestas JSON para validarlas antes de
+// enviarlas; las demás (archivos, exportaciones) pasan directo
+type responseBuffer struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	wrote    bool
+	buffered bool
+}
+
+func (rec *responseBuffer) WriteHeader(status int) {
+	if rec.wrote {
+		return
+	}
+	rec.wrote = true
+	rec.status = status
+	mediaType, _, _ := mime.ParseMediaType(rec.Header().Get("Content-Type"))
+	rec.buffered = mediaType == "application/json" || mediaType == problem.ContentType
+	if !rec.buffered {
+		rec.ResponseWriter.WriteHeader(status)
+	}
+}
+
+func (rec *responseBuffer) Write(p []byte) (int, error) {
+	if !rec.wrote {
+		rec.WriteHeader(http.StatusOK)
+	}
+	if rec.buffered {
+		return rec.body.Write(p)
+	}
+	return rec.ResponseWriter.Write(p)
+}
+
+func (rec *responseBuffer) Flush() {
+	if flusher, ok := rec.ResponseWriter.(http.Flusher); ok && !rec.buffered {
+		flusher.Flush()
+	}
+}
+
+func (rec *responseBuffer) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -0,0 +1,130 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/openapi"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/go-chi/chi/v5"
+)
+
+// validado monta algunas rutas documentadas detrás de Validate con handlers
+// que responden 204; lo que llega a ellos pasó la validación
+func validado() http.Handler {
+	r := chi.NewRouter()
+	r.Use(openapi.New(r).Validate)
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) }
+	r.Route("/v1", func(r chi.Router) {
+		r.Get("/alumnos", ok)
+		r.Post("/alumnos", ok)
+		r.Post("/alumnos/batch", ok)
+		r.Post("/alumnos/import", ok)
+		r.Get("/alumnos/{id}", ok)
+		r.Post("/jobs/{recurso}/export", ok)
+	})
+	r.Get("/sin-documentar", ok)
+	return r
+}
+
+type errorCampo struct {
+	Field string `json:"field"`
+	Code  string `json:"code"`
+}
+
+func TestValidate(t *testing.T) {
+	h := validado()
+	for _, tc := range []struct {
+		nombre string
+		metodo string
+		path   string
+		cuerpo string
+		status int
+		errors []errorCampo
+	}{
+		{"cuerpo válido", "POST", "/v1/alumnos", `{"nombres":"Ana","promedio":9.5}`, http.StatusNoContent, nil},
+		{"null en cualquier campo", "POST", "/v1/alumnos", `{"nombres":null}`, http.StatusNoContent, nil},
+		{"tipo inválido", "POST", "/v1/alumnos", `{"promedio":"diez"}`, http.StatusBadRequest,
+			[]errorCampo{{"promedio", i18n.MsgTipoInvalido}}},
+		{"máximo", "POST", "/v1/alumnos", `{"promedio":11}`, http.StatusBadRequest,
+			[]errorCampo{{"promedio", i18n.MsgValorMaximo}}},
+		{"campo desconocido", "POST", "/v1/alumnos", `{"nombre":"Ana"}`, http.StatusBadRequest,
+			[]errorCampo{{"nombre", i18n.MsgCampoDesconocido}}},
+		{"cuerpo que no es objeto", "POST", "/v1/alumnos", `[1]`, http.StatusBadRequest,
+			[]errorCampo{{"body", i18n.MsgTipoInvalido}}},
+		{"JSON inválido", "POST", "/v1/alumnos", `{"nombres":`, http.StatusBadRequest, nil},
+		{"requerido y enum anidados", "POST", "/v1/alumnos/batch", `{"modo":"todo","operaciones":[{"datos":{"promedio":"x"}},{"op":"borrar"}]}`, http.StatusBadRequest,
+			[]errorCampo{
+				{"modo", i18n.MsgValorNoPermitido},
+				{"operaciones[0].op", i18n.MsgCampoRequerido},
+				{"operaciones[0].datos.promedio", i18n.MsgTipoInvalido},
+				{"operaciones[1].op", i18n.MsgValorNoPermitido},
+			}},
+		{"lote sin operaciones", "POST", "/v1/alumnos/batch", `{}`, http.StatusBadRequest,
+			[]errorCampo{{"operaciones", i18n.MsgCampoRequerido}}},
+		{"id no numérico", "GET", "/v1/alumnos/abc", "", http.StatusBadRequest,
+			[]errorCampo{{"id", i18n.MsgTipoInvalido}}},
+		{"id menor al mínimo", "GET", "/v1/alumnos/0", "", http.StatusBadRequest,
+			[]errorCampo{{"id", i18n.MsgValorMinimo}}},
+		{"query válida", "POST", "/v1/jobs/alumnos/export?format=xlsx", "", http.StatusNoContent, nil},
+		{"query con tipo inválido", "POST", "/v1/alumnos/import?dryRun=quizas", "", http.StatusBadRequest,
+			[]errorCampo{{"dryRun", i18n.MsgTipoInvalido}}},
+		{"enum en path y query", "POST", "/v1/jobs/cursos/export?format=pdf", "", http.StatusBadRequest,
+			[]errorCampo{{"recurso", i18n.MsgValorNoPermitido}, {"format", i18n.MsgValorNoPermitido}}},
+		{"ruta sin documentar", "GET", "/sin-documentar?id=x", "", http.StatusNoContent, nil},
+	} {
+		t.Run(tc.nombre, func(t *testing.T) {
+			r := httptest.NewRequest(tc.metodo, tc.path, strings.NewReader(tc.cuerpo))
+			if tc.cuerpo != "" {
+				r.Header.Set("Content-Type", "application/json")
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if w.Code != tc.status {
+				t.Fatalf("status = %d, se esperaba %d: %s", w.Code, tc.status, w.Body)
+			}
+			if tc.errors == nil {
+				return
+			}
+			var respuesta struct {
+				Errors []errorCampo `json:"errors"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &respuesta); err != nil {
+				t.Fatal(err)
+			}
+			if len(respuesta.Errors) != len(tc.errors) {
+				t.Fatalf("errors = %+v, se esperaba %+v", respuesta.Errors, tc.errors)
+			}
+			for i := range tc.errors {
+				if respuesta.Errors[i] != tc.errors[i] {
+					t.Errorf("errors[%d] = %+v, se esperaba %+v", i, respuesta.Errors[i], tc.errors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateResponses(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(openapi.New(r).ValidateResponses)
+	r.Get("/v1/alumnos/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if chi.URLParam(r, "id") == "1" {
+			w.Write([]byte(`{"id":1,"nombres":"Ana","apellidos":"García","matricula":"A001","promedio":9.5}`))
+			return
+		}
+		w.Write([]byte(`{"id":"uno"}`))
+	})
+
+	for id, status := range map[string]int{"1": http.StatusOK, "2": http.StatusInternalServerError} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/alumnos/"+id, nil))
+		if w.Code != status {
+			t.Errorf("GET /v1/alumnos/%s = %d, se esperaba %d: %s", id, w.Code, status, w.Body)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Package problem escribe los errores de la API como application/problem+json
+// (RFC 7807) a partir de los errores de pkg/errors
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+const ContentType = "application/problem+json"
+
+// Códigos estables; type es "/problems/{code}"
+const (
+	CodeInvalidInput         = "invalid_input"
+	CodeValidationFailed     = "validation_failed"
+	CodeNotFound             = "not_found"
+	CodeUnauthorized         = "unauthorized"
+	CodeForbidden            = "forbidden"
+	CodeAlreadyExists        = "already_exists"
+	CodeConflict             = "conflict"
+	CodeInvalidSession       = "invalid_session"
+	CodeInvalidCredentials   = "invalid_credentials"
+	CodePayloadTooLarge      = "payload_too_large"
+	CodeLengthRequired       = "length_required"
+	CodeUnsupportedMediaType = "unsupported_media_type"
+	CodeNotAcceptable        = "not_acceptable"
+	CodeUnprocessable        = "unprocessable"
+	CodeMethodNotAllowed     = "method_not_allowed"
+	CodeInternal             = "internal_error"
+)
+
+// Problem - Cuerpo de una respuesta de error
+type Problem struct {
+	Type     string                      `json:"type"`
+	Title    string                      `json:"title"`
+	Status   int                         `json:"status"`
+	Code     string                      `json:"code"`
+	Detail   string                      `json:"detail,omitempty"`
+	Instance string                      `json:"instance,omitempty"` // urn:request:{id} de la petición
+	Errors   []apperrors.ValidationError `json:"errors,omitempty"`
+
+	mensaje string // ID de pkg/i18n con el que se traduce Detail
+	params  []any
+}
+
+// Errores de pkg/errors y su status; el primero que coincide con errors.Is gana
+var mappings = []struct {
+	err    error
+	status int
+	code   string
+}{
+	{apperrors.ErrNotFound, http.StatusNotFound, CodeNotFound},
+	{apperrors.ErrInvalidInput, http.StatusBadRequest, CodeInvalidInput},
+	{apperrors.ErrAlreadyExists, http.StatusConflict, CodeAlreadyExists},
+	{apperrors.ErrConflict, http.StatusConflict, CodeConflict},
+	{apperrors.ErrUnauthorized, http.StatusUnauthorized, CodeUnauthorized},
+	{apperrors.ErrForbidden, http.StatusForbidden, CodeForbidden},
+	// Sesión y credenciales responden 400 como antes de problem+json
+	{apperrors.ErrInvalidSession, http.StatusBadRequest, CodeInvalidSession},
+	{apperrors.ErrInvalidCredentials, http.StatusBadRequest, CodeInvalidCredentials},
+}
+
+// From traduce err a un Problem sin instance. El detalle es siempre el mensaje
+// del catálogo para el código, nunca el texto del error: los errores que no
+// vienen de pkg/errors son 500 y su causa no se expone
+func From(err error) Problem {
+	var validationErrors *apperrors.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		p := New(http.StatusBadRequest, CodeValidationFailed, i18n.ErrorMsg(CodeValidationFailed))
+		p.Errors = validationErrors.Errors
+		return p
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return New(http.StatusRequestEntityTooLarge, CodePayloadTooLarge, i18n.MsgPeticionMuyGrande)
+	}
+
+	for _, m := range mappings {
+		if errors.Is(err, m.err) {
+			return New(m.status, m.code, i18n.ErrorMsg(m.code))
+		}
+	}
+	return New(http.StatusInternalServerError, CodeInternal, i18n.ErrorMsg(CodeInternal))
+}
+
+// New arma un Problem cuyo detalle es el mensaje de pkg/i18n con params
+func New(status int, code, mensaje string, params ...any) Problem {
+	return Problem{
+		Type:    "/problems/" + code,
+		Title:   http.StatusText(status),
+		Status:  status,
+		Code:    code,
+		Detail:  i18n.T(i18n.Default, mensaje, params...),
+		mensaje: mensaje,
+		params:  params,
+	}
+}
+
+// Localize traduce el detalle y los errores de campo de p a lang
+func Localize(p Problem, lang string) Problem {
+	if lang == i18n.Default {
+		return p
+	}
+	if p.mensaje != "" {
+		p.Detail = i18n.T(lang, p.mensaje, p.params...)
+	}
+	p.Errors = apperrors.Localize(p.Errors, lang)
+	return p
+}
+
+// Error escribe el problema que corresponde a err; los 500 se registran en el log
+func Error(w http.ResponseWriter, r *http.Request, err error) {
+	p := From(err)
+	if p.Status == http.StatusInternalServerError {
+		log.Printf("Error en %s %s: %v", r.Method, r.URL.Path, err)
+	}
+	Write(w, r, p)
+}
+
+// Invalid escribe un 400 invalid_input con el mensaje indicado, para las
+// validaciones que hace el propio handler (ID, JSON, parámetros)
+func Invalid(w http.ResponseWriter, r *http.Request, mensaje string, params ...any) {
+	Write(w, r, New(http.StatusBadRequest, CodeInvalidInput, mensaje, params...))
+}
+
+// Write escribe p en el idioma de la petición. instance identifica la petición
+// con el mismo ID que el log (X-Request-Id)
+func Write(w http.ResponseWriter, r *http.Request, p Problem) {
+	p = Localize(p, i18n.FromContext(r.Context()))
+	if id := chimiddleware.GetReqID(r.Context()); id != "" {
+		p.Instance = "urn:request:" + id
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
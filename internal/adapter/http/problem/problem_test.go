@@ -0,0 +1,110 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+func camposInvalidos() error {
+	errs := &apperrors.ValidationErrors{}
+	errs.Add("nombres", i18n.MsgCampoRequerido, "nombres")
+	return errs
+}
+
+func TestFrom(t *testing.T) {
+	for _, tc := range []struct {
+		nombre string
+		err    error
+		status int
+		code   string
+		detail string
+	}{
+		{"no encontrado", fmt.Errorf("%w: alumno 7", apperrors.ErrNotFound), http.StatusNotFound, CodeNotFound, "Recurso no encontrado"},
+		{"entrada inválida", fmt.Errorf("%w: modo debe ser atomico o parcial", apperrors.ErrInvalidInput), http.StatusBadRequest, CodeInvalidInput, "Datos de entrada inválidos"},
+		{"ya existe", apperrors.ErrAlreadyExists, http.StatusConflict, CodeAlreadyExists, "El recurso ya existe"},
+		{"no autorizado", apperrors.ErrUnauthorized, http.StatusUnauthorized, CodeUnauthorized, "No autorizado"},
+		{"prohibido", apperrors.ErrForbidden, http.StatusForbidden, CodeForbidden, "Acceso denegado"},
+		{"sesión", apperrors.ErrInvalidSession, http.StatusBadRequest, CodeInvalidSession, "Sesión inválida o expirada"},
+		{"validación", camposInvalidos(), http.StatusBadRequest, CodeValidationFailed, "Datos de entrada inválidos"},
+		{"cuerpo grande", fmt.Errorf("leer: %w", &http.MaxBytesError{Limit: 10}), http.StatusRequestEntityTooLarge, CodePayloadTooLarge, "La petición excede el tamaño máximo"},
+		{"interno", errors.New("dial tcp 10.0.0.5:5432: connection refused"), http.StatusInternalServerError, CodeInternal, "Error interno del servidor"},
+	} {
+		p := From(tc.err)
+		if p.Status != tc.status || p.Code != tc.code || p.Type != "/problems/"+tc.code || p.Title != http.StatusText(tc.status) {
+			t.Errorf("%s: %d %s %s %q, se esperaba %d %s", tc.nombre, p.Status, p.Code, p.Type, p.Title, tc.status, tc.code)
+		}
+		// El detalle sale del catálogo, nunca del texto del error
+		if p.Detail != tc.detail {
+			t.Errorf("%s: detail = %q, se esperaba %q", tc.nombre, p.Detail, tc.detail)
+		}
+	}
+
+	p := From(camposInvalidos())
+	if len(p.Errors) != 1 || p.Errors[0].Field != "nombres" || p.Errors[0].Code != i18n.MsgCampoRequerido {
+		t.Errorf("errores de campo = %+v", p.Errors)
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	p := From(camposInvalidos())
+
+	en := Localize(p, "en")
+	if en.Detail != "Invalid input data" || en.Errors[0].Message != "The nombres field is required" {
+		t.Errorf("en: detail=%q errores=%+v", en.Detail, en.Errors)
+	}
+	// Localize no modifica el original
+	if p.Detail != "Datos de entrada inválidos" || p.Errors[0].Message != "El campo nombres es requerido" {
+		t.Errorf("original modificado: detail=%q errores=%+v", p.Detail, p.Errors)
+	}
+
+	conParams := Localize(New(http.StatusBadRequest, CodeInvalidInput, i18n.MsgCampoRequerido, "id"), "en")
+	if conParams.Detail != "The id field is required" {
+		t.Errorf("detail con parámetros = %q", conParams.Detail)
+	}
+	if sinTraduccion := Localize(From(apperrors.ErrNotFound), "fr"); sinTraduccion.Detail != "Recurso no encontrado" {
+		t.Errorf("idioma sin catálogo: detail = %q", sinTraduccion.Detail)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	h := chimiddleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Error(w, r.WithContext(i18n.WithLang(r.Context(), "en")), camposInvalidos())
+	}))
+	r := httptest.NewRequest(http.MethodPost, "/v1/alumnos", nil)
+	r.Header.Set(chimiddleware.RequestIDHeader, "abc-123")
+	escrito := httptest.NewRecorder()
+	h.ServeHTTP(escrito, r)
+
+	if escrito.Code != http.StatusBadRequest || escrito.Header().Get("Content-Type") != ContentType {
+		t.Fatalf("%d %s, se esperaba 400 %s", escrito.Code, escrito.Header().Get("Content-Type"), ContentType)
+	}
+	var cuerpo map[string]any
+	if err := json.Unmarshal(escrito.Body.Bytes(), &cuerpo); err != nil {
+		t.Fatal(err)
+	}
+	if cuerpo["instance"] != "urn:request:abc-123" || cuerpo["code"] != CodeValidationFailed || cuerpo["detail"] != "Invalid input data" {
+		t.Errorf("cuerpo = %v", cuerpo)
+	}
+	if _, ok := cuerpo["requestId"]; ok {
+		t.Errorf("cuerpo con requestId: %v", cuerpo)
+	}
+	if errores, _ := cuerpo["errors"].([]any); len(errores) != 1 || !strings.Contains(escrito.Body.String(), `"message":"The nombres field is required"`) {
+		t.Errorf("errores = %v", cuerpo["errors"])
+	}
+
+	// Sin ID de petición no hay instance
+	w := httptest.NewRecorder()
+	Write(w, httptest.NewRequest(http.MethodGet, "/v1/alumnos/7", nil), From(apperrors.ErrNotFound))
+	if w.Code != http.StatusNotFound || strings.Contains(w.Body.String(), "instance") {
+		t.Errorf("sin ID de petición: %d %s", w.Code, w.Body)
+	}
+}
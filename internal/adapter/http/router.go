@@ -1,64 +1,208 @@
 package http
 
 import (
+	"net/http"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/graphql"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/handler"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/middleware"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/openapi"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/problem"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
 	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 )
 
 type Router struct {
-	alumnoHandler   *handler.AlumnoHandler
-	profesorHandler *handler.ProfesorHandler
-	sesionHandler   *handler.SesionHandler
+	alumnoHandler       *handler.AlumnoHandler
+	profesorHandler     *handler.ProfesorHandler
+	sesionHandler       *handler.SesionHandler
+	documentoHandler    *handler.DocumentoHandler
+	plantillaHandler    *handler.PlantillaHandler
+	notificacionHandler *handler.NotificacionHandler
+	webhookHandler      *handler.WebhookHandler
+	jobHandler          *handler.JobHandler
+	fileHandler         *handler.FileHandler
+	graphqlHandler      *graphql.Handler
+	idempotency         *middleware.Idempotency
+	deprecation         *middleware.Deprecation
+	auth                *middleware.Auth
+	validateResponses   bool
 }
 
 func NewRouter(
 	alumnoHandler *handler.AlumnoHandler,
 	profesorHandler *handler.ProfesorHandler,
 	sesionHandler *handler.SesionHandler,
+	documentoHandler *handler.DocumentoHandler,
+	plantillaHandler *handler.PlantillaHandler,
+	notificacionHandler *handler.NotificacionHandler,
+	webhookHandler *handler.WebhookHandler,
+	jobHandler *handler.JobHandler,
+	fileHandler *handler.FileHandler,
+	graphqlHandler *graphql.Handler,
+	idempotency *middleware.Idempotency,
+	deprecation *middleware.Deprecation,
+	auth *middleware.Auth,
+	validateResponses bool,
 ) *Router {
 	return &Router{
-		alumnoHandler:   alumnoHandler,
-		profesorHandler: profesorHandler,
-		sesionHandler:   sesionHandler,
+		alumnoHandler:       alumnoHandler,
+		profesorHandler:     profesorHandler,
+		sesionHandler:       sesionHandler,
+		documentoHandler:    documentoHandler,
+		plantillaHandler:    plantillaHandler,
+		notificacionHandler: notificacionHandler,
+		webhookHandler:      webhookHandler,
+		jobHandler:          jobHandler,
+		fileHandler:         fileHandler,
+		graphqlHandler:      graphqlHandler,
+		idempotency:         idempotency,
+		deprecation:         deprecation,
+		auth:                auth,
+		validateResponses:   validateResponses,
 	}
 }
 
 func (rt *Router) Setup() *chi.Mux {
 	r := chi.NewRouter()
+	spec := openapi.New(r)
 
 	// Middlewares globales
 	r.Use(chimiddleware.Logger)
 	r.Use(chimiddleware.Recoverer)
 	r.Use(chimiddleware.RequestID)
+	r.Use(middleware.Language)
 	r.Use(middleware.CORS)
 	r.Use(middleware.ContentType)
+	if rt.validateResponses {
+		r.Use(spec.ValidateResponses)
+	}
+	r.Use(spec.Validate)
+
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		problem.Write(w, r, problem.New(http.StatusNotFound, problem.CodeNotFound, i18n.MsgRutaNoEncontrada))
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		problem.Write(w, r, problem.New(http.StatusMethodNotAllowed, problem.CodeMethodNotAllowed, i18n.MsgMetodoNoPermitido))
+	})
+
+	// Documentación
+	r.Get("/openapi.json", spec.Handler)
+	r.Get("/docs", openapi.Docs("/openapi.json"))
+
+	// Cada versión registra sus rutas con sus propios handlers y DTOs; una
+	// /v2 se monta junto a /v1 con r.Route("/v2", rt.v2)
+	r.Route("/v1", rt.v1)
 
+	// Rutas sin prefijo: alias obsoletos de /v1
+	r.Group(func(r chi.Router) {
+		r.Use(rt.deprecation.Successor("/v1"))
+		rt.v1(r)
+	})
+
+	// GraphQL sobre los mismos servicios; no lleva versión en la ruta
+	r.With(rt.idempotency.Handler).Post("/graphql", rt.graphqlHandler.ServeHTTP)
+
+	// Descarga de archivos locales (solo con STORAGE_DRIVER=local)
+	if rt.fileHandler != nil {
+		r.Get("/files/*", rt.fileHandler.Download)
+		r.Put("/files/*", rt.fileHandler.Upload)
+	}
+
+	return r
+}
+
+// v1 registra las rutas de la versión 1 de la API en r. El middleware de
+// idempotencia (solo actúa en POST con Idempotency-Key) va después de la
+// autenticación en las rutas que la piden
+func (rt *Router) v1(r chi.Router) {
 	// Rutas de alumnos
 	r.Route("/alumnos", func(r chi.Router) {
-		r.Get("/", rt.alumnoHandler.GetAll)
-		r.Post("/", rt.alumnoHandler.Create)
-		r.Get("/{id}", rt.alumnoHandler.GetByID)
-		r.Put("/{id}", rt.alumnoHandler.Update)
-		r.Delete("/{id}", rt.alumnoHandler.Delete)
-		r.Post("/{id}/fotoPerfil", rt.alumnoHandler.UploadFotoPerfil)
-		r.Post("/{id}/email", rt.alumnoHandler.SendEmail)
-
-		// Rutas de sesión
-		r.Post("/{id}/session/login", rt.sesionHandler.Login)
-		r.Post("/{id}/session/verify", rt.sesionHandler.Verify)
-		r.Post("/{id}/session/logout", rt.sesionHandler.Logout)
+		r.Group(func(r chi.Router) {
+			r.Use(rt.idempotency.Handler)
+			r.Get("/", rt.alumnoHandler.GetAll)
+			r.Post("/", rt.alumnoHandler.Create)
+			r.Post("/batch", rt.alumnoHandler.Batch)
+			r.Get("/export", rt.alumnoHandler.Export)
+			r.Post("/import", rt.alumnoHandler.Import)
+			r.Get("/{id}", rt.alumnoHandler.GetByID)
+			r.Put("/{id}", rt.alumnoHandler.Update)
+			r.Delete("/{id}", rt.alumnoHandler.Delete)
+			r.Post("/{id}/fotoPerfil", rt.alumnoHandler.UploadFotoPerfil)
+			r.Delete("/{id}/fotoPerfil", rt.alumnoHandler.DeleteFotoPerfil)
+			r.Post("/{id}/fotoPerfil/upload-url", rt.alumnoHandler.CreateFotoPerfilUploadURL)
+			r.Post("/{id}/fotoPerfil/confirm", rt.alumnoHandler.ConfirmFotoPerfil)
+			r.Post("/{id}/email", rt.alumnoHandler.SendEmail)
+
+			// Rutas de sesión
+			r.Post("/{id}/session/login", rt.sesionHandler.Login)
+			r.Post("/{id}/session/verify", rt.sesionHandler.Verify)
+			r.Post("/{id}/session/logout", rt.sesionHandler.Logout)
+		})
+
+		// Rutas de documentos: el alumno dueño o el administrador; solo este revisa
+		r.With(rt.auth.Alumno).Get("/{id}/documentos", rt.documentoHandler.GetAll)
+		r.With(rt.auth.Alumno, rt.idempotency.Handler).Post("/{id}/documentos", rt.documentoHandler.Upload)
+		r.With(rt.auth.Alumno, rt.idempotency.Handler).Post("/{id}/documentos/stream", rt.documentoHandler.UploadStream)
+		r.With(rt.auth.Alumno).Get("/{id}/documentos/{documentoId}", rt.documentoHandler.GetByID)
+		r.With(rt.auth.Alumno).Get("/{id}/documentos/{documentoId}/download", rt.documentoHandler.Download)
+		r.With(rt.auth.Admin).Put("/{id}/documentos/{documentoId}/review", rt.documentoHandler.Review)
+		r.With(rt.auth.Alumno).Delete("/{id}/documentos/{documentoId}", rt.documentoHandler.Delete)
 	})
 
 	// Rutas de profesores
 	r.Route("/profesores", func(r chi.Router) {
+		r.Use(rt.idempotency.Handler)
 		r.Get("/", rt.profesorHandler.GetAll)
 		r.Post("/", rt.profesorHandler.Create)
+		r.Post("/batch", rt.profesorHandler.Batch)
+		r.Get("/export", rt.profesorHandler.Export)
+		r.Post("/import", rt.profesorHandler.Import)
 		r.Get("/{id}", rt.profesorHandler.GetByID)
 		r.Put("/{id}", rt.profesorHandler.Update)
 		r.Delete("/{id}", rt.profesorHandler.Delete)
 	})
 
-	return r
+	// Administración de plantillas de notificación
+	r.Route("/admin/plantillas", func(r chi.Router) {
+		r.Use(rt.auth.Admin, rt.idempotency.Handler)
+		r.Get("/", rt.plantillaHandler.GetAll)
+		r.Post("/", rt.plantillaHandler.Create)
+		r.Post("/preview", rt.plantillaHandler.Preview)
+		r.Get("/{id}", rt.plantillaHandler.GetByID)
+		r.Put("/{id}", rt.plantillaHandler.Update)
+		r.Delete("/{id}", rt.plantillaHandler.Delete)
+		r.Get("/{id}/preview", rt.plantillaHandler.PreviewByID)
+	})
+
+	// Bandeja de salida de notificaciones
+	r.Route("/admin/notificaciones", func(r chi.Router) {
+		r.Use(rt.auth.Admin, rt.idempotency.Handler)
+		r.Get("/", rt.notificacionHandler.GetAll)
+		r.Post("/replay", rt.notificacionHandler.ReplayFallidas)
+		r.Get("/{id}", rt.notificacionHandler.GetByID)
+		r.Post("/{id}/replay", rt.notificacionHandler.Replay)
+	})
+
+	// Suscripciones de webhooks
+	r.Route("/admin/webhooks", func(r chi.Router) {
+		r.Use(rt.auth.Admin, rt.idempotency.Handler)
+		r.Get("/", rt.webhookHandler.GetAll)
+		r.Post("/", rt.webhookHandler.Create)
+		r.Get("/{id}", rt.webhookHandler.GetByID)
+		r.Put("/{id}", rt.webhookHandler.Update)
+		r.Delete("/{id}", rt.webhookHandler.Delete)
+		r.Get("/{id}/entregas", rt.webhookHandler.GetEntregas)
+		r.Post("/{id}/entregas/{entregaId}/replay", rt.webhookHandler.ReplayEntrega)
+	})
+
+	// Importaciones y exportaciones en segundo plano: solo el administrador
+	r.Route("/jobs", func(r chi.Router) {
+		r.Use(rt.auth.Admin, rt.idempotency.Handler)
+		r.Post("/{recurso}/import", rt.jobHandler.CreateImport)
+		r.Post("/{recurso}/export", rt.jobHandler.CreateExport)
+		r.Get("/{id}", rt.jobHandler.GetByID)
+		r.Post("/{id}/cancel", rt.jobHandler.Cancel)
+	})
 }
@@ -0,0 +1,238 @@
+package plantillas
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+// Las plantillas viven en files/{idioma}/{llave}.{asunto,txt,html}.tmpl.
+// El asunto y el texto usan text/template; el HTML usa html/template para escapar los datos.
+//
+//go:embed files
+var files embed.FS
+
+type plantilla struct {
+	asunto *texttemplate.Template
+	texto  *texttemplate.Template
+	html   *htmltemplate.Template // opcional
+}
+
+// ttlGuardadas - Cuánto se reutiliza una plantilla guardada ya compilada. Los
+// cambios hechos en esta instancia se ven al momento (Invalidar); los de otras
+// instancias, a lo más tras este tiempo
+const ttlGuardadas = time.Minute
+
+// guardada - Plantilla guardada en caché; p es nil si no hay una para la
+// clave e idioma (también se recuerda, para no consultar la base cada vez)
+type guardada struct {
+	p      *plantilla
+	expira time.Time
+}
+
+// Renderer - Genera mensajes con las plantillas guardadas en la base de datos
+// y, si no hay una para la clave e idioma, con las incluidas en el binario
+type Renderer struct {
+	repo       port.PlantillaRepository // opcional
+	plantillas map[string]plantilla     // "{idioma}/{llave}"
+
+	mu        sync.Mutex
+	guardadas map[string]guardada // "{idioma}/{llave}"
+}
+
+func NewRenderer(repo port.PlantillaRepository) (*Renderer, error) {
+	r := &Renderer{repo: repo, plantillas: make(map[string]plantilla), guardadas: make(map[string]guardada)}
+
+	idiomas, err := fs.ReadDir(files, "files")
+	if err != nil {
+		return nil, err
+	}
+	for _, idioma := range idiomas {
+		entries, err := fs.ReadDir(files, "files/"+idioma.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			key, ok := strings.CutSuffix(entry.Name(), ".asunto.tmpl")
+			if !ok {
+				continue
+			}
+			p, err := parse(idioma.Name(), key)
+			if err != nil {
+				return nil, err
+			}
+			r.plantillas[idioma.Name()+"/"+key] = p
+		}
+	}
+
+	return r, nil
+}
+
+// Render busca la plantilla en el idioma pedido, luego en su idioma base
+// (en-US -> en) y por último en el idioma por defecto. En cada idioma la
+// plantilla guardada tiene prioridad sobre la incluida en el binario
+func (r *Renderer) Render(ctx context.Context, key string, idioma string, data any) (*domain.Mensaje, error) {
+	for _, candidato := range Candidatos(idioma) {
+		p, err := r.guardada(ctx, key, candidato)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			return p.render(data)
+		}
+		if p, ok := r.plantillas[candidato+"/"+key]; ok {
+			return p.render(data)
+		}
+	}
+	return nil, fmt.Errorf("%w: plantilla %s", apperrors.ErrNotFound, key)
+}
+
+// Invalidar descarta la versión en caché de la plantilla guardada
+func (r *Renderer) Invalidar(key string, idioma string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.guardadas, idioma+"/"+key)
+}
+
+// guardada devuelve la plantilla guardada ya compilada, o nil si no hay una
+func (r *Renderer) guardada(ctx context.Context, key string, idioma string) (*plantilla, error) {
+	if r.repo == nil {
+		return nil, nil
+	}
+
+	cacheKey := idioma + "/" + key
+	r.mu.Lock()
+	cached, ok := r.guardadas[cacheKey]
+	r.mu.Unlock()
+	if ok && time.Now().Before(cached.expira) {
+		return cached.p, nil
+	}
+
+	stored, err := r.repo.GetByClave(ctx, key, idioma)
+	if err != nil {
+		return nil, err
+	}
+	var compilada *plantilla
+	if stored != nil {
+		p, err := compile(stored)
+		if err != nil {
+			return nil, fmt.Errorf("plantilla %s/%s: %w", idioma, key, err)
+		}
+		compilada = &p
+	}
+
+	r.mu.Lock()
+	r.guardadas[cacheKey] = guardada{p: compilada, expira: time.Now().Add(ttlGuardadas)}
+	r.mu.Unlock()
+	return compilada, nil
+}
+
+func (r *Renderer) RenderPlantilla(ctx context.Context, plantilla *domain.PlantillaNotificacion, data any) (*domain.Mensaje, error) {
+	p, err := compile(plantilla)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, err)
+	}
+	mensaje, err := p.render(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, err)
+	}
+	return mensaje, nil
+}
+
+// Candidatos devuelve los idiomas a probar, en orden, para idioma
+func Candidatos(idioma string) []string {
+	idioma = strings.ToLower(strings.TrimSpace(idioma))
+	candidatos := make([]string, 0, 3)
+	if idioma != "" {
+		candidatos = append(candidatos, idioma)
+		if base, _, ok := strings.Cut(idioma, "-"); ok {
+			candidatos = append(candidatos, base)
+		}
+	}
+	return append(candidatos, domain.IdiomaPorDefecto)
+}
+
+func parse(idioma, key string) (plantilla, error) {
+	dir := "files/" + idioma + "/" + key
+
+	asunto, err := fs.ReadFile(files, dir+".asunto.tmpl")
+	if err != nil {
+		return plantilla{}, err
+	}
+	texto, err := fs.ReadFile(files, dir+".txt.tmpl")
+	if err != nil {
+		return plantilla{}, err
+	}
+	html, err := fs.ReadFile(files, dir+".html.tmpl")
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return plantilla{}, err
+	}
+
+	p, err := compile(&domain.PlantillaNotificacion{
+		Clave:      key,
+		Idioma:     idioma,
+		Asunto:     string(asunto),
+		Cuerpo:     string(texto),
+		CuerpoHTML: string(html),
+	})
+	if err != nil {
+		return plantilla{}, fmt.Errorf("plantilla %s/%s: %w", idioma, key, err)
+	}
+	return p, nil
+}
+
+// compile usa missingkey=error para que un campo mal escrito falle al validar
+// con los datos de ejemplo y no al enviar la notificación
+func compile(p *domain.PlantillaNotificacion) (plantilla, error) {
+	asunto, err := texttemplate.New("asunto").Option("missingkey=error").Parse(p.Asunto)
+	if err != nil {
+		return plantilla{}, err
+	}
+	texto, err := texttemplate.New("cuerpo").Option("missingkey=error").Parse(p.Cuerpo)
+	if err != nil {
+		return plantilla{}, err
+	}
+
+	compilada := plantilla{asunto: asunto, texto: texto}
+	if strings.TrimSpace(p.CuerpoHTML) != "" {
+		compilada.html, err = htmltemplate.New("cuerpoHtml").Option("missingkey=error").Parse(p.CuerpoHTML)
+		if err != nil {
+			return plantilla{}, err
+		}
+	}
+	return compilada, nil
+}
+
+func (p plantilla) render(data any) (*domain.Mensaje, error) {
+	var asunto, texto, html bytes.Buffer
+
+	if err := p.asunto.Execute(&asunto, data); err != nil {
+		return nil, fmt.Errorf("error al renderizar asunto: %w", err)
+	}
+	if err := p.texto.Execute(&texto, data); err != nil {
+		return nil, fmt.Errorf("error al renderizar texto: %w", err)
+	}
+	if p.html != nil {
+		if err := p.html.Execute(&html, data); err != nil {
+			return nil, fmt.Errorf("error al renderizar HTML: %w", err)
+		}
+	}
+
+	return &domain.Mensaje{
+		Asunto: strings.TrimSpace(asunto.String()),
+		Texto:  texto.String(),
+		HTML:   html.String(),
+	}, nil
+}
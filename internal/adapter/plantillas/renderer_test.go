@@ -0,0 +1,154 @@
+package plantillas
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+// contador cuenta las consultas a la base para ver cuándo se usa la caché
+type contador struct {
+	port.PlantillaRepository
+	consultas int
+}
+
+func (c *contador) GetByClave(ctx context.Context, clave string, idioma string) (*domain.PlantillaNotificacion, error) {
+	c.consultas++
+	return c.PlantillaRepository.GetByClave(ctx, clave, idioma)
+}
+
+func nuevoRenderer(t *testing.T) (*Renderer, *contador) {
+	t.Helper()
+	repo := &contador{PlantillaRepository: relational.NewPlantillaRepository(storagetest.NewSQLite(t))}
+	r, err := NewRenderer(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, repo
+}
+
+var datos = domain.DatosCalificaciones{Nombres: "Ana", Apellidos: "<García>", Matricula: "A001", Promedio: 9.456}
+
+func TestRenderIncluidas(t *testing.T) {
+	r, err := NewRenderer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		idioma string
+		asunto string
+	}{
+		{"es", "Calificaciones de Ana <García>"},
+		{"en", "Grades for Ana <García>"},
+		{"en-US", "Grades for Ana <García>"},
+		{"fr", "Calificaciones de Ana <García>"},
+		{"", "Calificaciones de Ana <García>"},
+	} {
+		mensaje, err := r.Render(context.Background(), domain.PlantillaCalificaciones, tc.idioma, datos)
+		if err != nil {
+			t.Fatalf("%q: Render: %v", tc.idioma, err)
+		}
+		if mensaje.Asunto != tc.asunto {
+			t.Errorf("%q: asunto = %q, se esperaba %q", tc.idioma, mensaje.Asunto, tc.asunto)
+		}
+		// El texto va tal cual; el HTML escapa los datos
+		if !strings.Contains(mensaje.Texto, "<García>") || !strings.Contains(mensaje.Texto, "9.46") {
+			t.Errorf("%q: texto = %q", tc.idioma, mensaje.Texto)
+		}
+		if strings.Contains(mensaje.HTML, "<García>") || !strings.Contains(mensaje.HTML, "&lt;García&gt;") {
+			t.Errorf("%q: HTML sin escapar: %q", tc.idioma, mensaje.HTML)
+		}
+	}
+
+	if _, err := r.Render(context.Background(), "no_existe", "es", datos); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Errorf("plantilla inexistente: %v, se esperaba ErrNotFound", err)
+	}
+}
+
+func TestRenderPlantilla(t *testing.T) {
+	r, err := NewRenderer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	mensaje, err := r.RenderPlantilla(ctx, &domain.PlantillaNotificacion{Asunto: " Hola {{.Nombres}}\n", Cuerpo: "{{.Matricula}}"}, datos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mensaje.Asunto != "Hola Ana" || mensaje.Texto != "A001" || mensaje.HTML != "" {
+		t.Errorf("mensaje = %+v", mensaje)
+	}
+
+	for nombre, p := range map[string]*domain.PlantillaNotificacion{
+		"sintaxis inválida":   {Asunto: "{{.Nombres", Cuerpo: "x"},
+		"campo mal escrito":   {Asunto: "x", Cuerpo: "{{.Nombre}}"},
+		"HTML mal escrito":    {Asunto: "x", Cuerpo: "x", CuerpoHTML: "{{.Password}}"},
+		"función inexistente": {Asunto: "{{mayus .Nombres}}", Cuerpo: "x"},
+	} {
+		if _, err := r.RenderPlantilla(ctx, p, datos); !errors.Is(err, apperrors.ErrInvalidInput) {
+			t.Errorf("%s: %v, se esperaba ErrInvalidInput", nombre, err)
+		}
+	}
+}
+
+// La plantilla guardada tiene prioridad y se reutiliza hasta que expira o se
+// invalida; la ausencia de una también se recuerda
+func TestRenderGuardadasEnCache(t *testing.T) {
+	ctx := context.Background()
+	r, repo := nuevoRenderer(t)
+	cacheKey := "es/" + domain.PlantillaCalificaciones
+
+	asunto := func() string {
+		t.Helper()
+		mensaje, err := r.Render(ctx, domain.PlantillaCalificaciones, "es", datos)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return mensaje.Asunto
+	}
+	expirar := func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		g := r.guardadas[cacheKey]
+		g.expira = time.Now().Add(-time.Second)
+		r.guardadas[cacheKey] = g
+	}
+
+	if got := asunto(); got != "Calificaciones de Ana <García>" || repo.consultas != 1 {
+		t.Fatalf("sin guardada: %q tras %d consultas", got, repo.consultas)
+	}
+
+	guardada := &domain.PlantillaNotificacion{Clave: domain.PlantillaCalificaciones, Idioma: "es", Asunto: "Notas de {{.Nombres}}", Cuerpo: "x"}
+	if err := repo.Create(ctx, guardada); err != nil {
+		t.Fatal(err)
+	}
+	if got := asunto(); got != "Calificaciones de Ana <García>" || repo.consultas != 1 {
+		t.Fatalf("antes de expirar: %q tras %d consultas", got, repo.consultas)
+	}
+	expirar()
+	if got := asunto(); got != "Notas de Ana" || repo.consultas != 2 {
+		t.Fatalf("tras expirar: %q tras %d consultas", got, repo.consultas)
+	}
+
+	guardada.Asunto = "Boleta de {{.Nombres}}"
+	if err := repo.Update(ctx, guardada); err != nil {
+		t.Fatal(err)
+	}
+	if got := asunto(); got != "Notas de Ana" || repo.consultas != 2 {
+		t.Fatalf("compilada en caché: %q tras %d consultas", got, repo.consultas)
+	}
+	r.Invalidar(domain.PlantillaCalificaciones, "es")
+	if got := asunto(); got != "Boleta de Ana" || repo.consultas != 3 {
+		t.Fatalf("tras invalidar: %q tras %d consultas", got, repo.consultas)
+	}
+}
@@ -0,0 +1,236 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+)
+
+// Modos de TLS soportados (SMTP_TLS)
+const (
+	TLSNone     = "none"     // texto plano, p. ej. MailHog o Mailpit en localhost:1025
+	TLSStartTLS = "starttls" // puerto 587
+	TLSImplicit = "tls"      // puerto 465
+)
+
+type Config struct {
+	Host        string
+	Port        string
+	Username    string
+	Password    string
+	TLS         string
+	From        string
+	BroadcastTo []string
+	Timeout     time.Duration
+}
+
+// Notifier envía las notificaciones como correos multipart (texto + HTML)
+type Notifier struct {
+	cfg Config
+}
+
+func NewNotifier(cfg Config) *Notifier {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &Notifier{cfg: cfg}
+}
+
+// Publish envía el anuncio a las direcciones de SMTP_BROADCAST_TO
+func (n *Notifier) Publish(ctx context.Context, subject, message string) error {
+	if len(n.cfg.BroadcastTo) == 0 {
+		return fmt.Errorf("SMTP_BROADCAST_TO vacío: no hay destinatarios para el anuncio")
+	}
+	return n.deliver(ctx, n.cfg.BroadcastTo, domain.Mensaje{Asunto: subject, Texto: message})
+}
+
+// Send envía el correo al alumno; SMTP no tiene canal de SMS, así que un
+// destinatario con solo teléfono devuelve domain.ErrCanalNoSoportado
+func (n *Notifier) Send(ctx context.Context, to domain.Destinatario, mensaje domain.Mensaje) error {
+	if to.Email == "" && to.Telefono == "" {
+		return fmt.Errorf("destinatario sin email ni teléfono")
+	}
+	if to.Email == "" {
+		return fmt.Errorf("%w: SMTP no envía SMS", domain.ErrCanalNoSoportado)
+	}
+	return n.deliver(ctx, []string{to.Email}, mensaje)
+}
+
+// Subscribe no hace nada: con SMTP cada correo se envía directo al destinatario
+func (n *Notifier) Subscribe(ctx context.Context, to domain.Destinatario) error {
+	return nil
+}
+
+func (n *Notifier) deliver(ctx context.Context, to []string, mensaje domain.Mensaje) error {
+	raw, err := n.build(to, mensaje)
+	if err != nil {
+		return err
+	}
+
+	client, err := n.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("error al conectar a SMTP: %w", err)
+	}
+	defer client.Close()
+
+	if n.cfg.Username != "" {
+		auth := smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("error de autenticación SMTP: %w", err)
+		}
+	}
+
+	from, err := mail.ParseAddress(n.cfg.From)
+	if err != nil {
+		return fmt.Errorf("SMTP_FROM inválido: %w", err)
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return fmt.Errorf("error en MAIL FROM: %w", err)
+	}
+	for _, rcpt := range to {
+		if err := client.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("error en RCPT TO %s: %w", rcpt, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("error en DATA: %w", err)
+	}
+	if _, err := w.Write(raw); err != nil {
+		return fmt.Errorf("error al enviar correo: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("error al enviar correo: %w", err)
+	}
+
+	log.Printf("SMTP: Correo para %d destinatarios - Asunto: %s", len(to), mensaje.Asunto)
+	return client.Quit()
+}
+
+func (n *Notifier) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(n.cfg.Host, n.cfg.Port)
+	dialer := &net.Dialer{Timeout: n.cfg.Timeout}
+	tlsConfig := &tls.Config{ServerName: n.cfg.Host}
+
+	var conn net.Conn
+	var err error
+	if n.cfg.TLS == TLSImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(n.cfg.Timeout))
+
+	client, err := smtp.NewClient(conn, n.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if n.cfg.TLS == TLSStartTLS {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// build arma el mensaje MIME; si hay HTML se envía como multipart/alternative
+func (n *Notifier) build(to []string, mensaje domain.Mensaje) ([]byte, error) {
+	var buf bytes.Buffer
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", n.cfg.From)
+	header.Set("To", strings.Join(to, ", "))
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", mensaje.Asunto))
+	header.Set("Date", time.Now().Format(time.RFC1123Z))
+	header.Set("Message-ID", n.messageID())
+	header.Set("MIME-Version", "1.0")
+
+	if mensaje.HTML == "" {
+		header.Set("Content-Type", "text/plain; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeHeader(&buf, header)
+		if err := writeQuotedPrintable(&buf, mensaje.Texto); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", mensaje.Texto},
+		{"text/html; charset=utf-8", mensaje.HTML},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(w, part.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	header.Set("Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": mw.Boundary()}))
+	writeHeader(&buf, header)
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+func (n *Notifier) messageID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	domainPart := n.cfg.Host
+	if addr, err := mail.ParseAddress(n.cfg.From); err == nil {
+		if _, d, ok := strings.Cut(addr.Address, "@"); ok {
+			domainPart = d
+		}
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domainPart)
+}
+
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	for _, key := range []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type", "Content-Transfer-Encoding"} {
+		if value := header.Get(key); value != "" {
+			fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+		}
+	}
+	buf.WriteString("\r\n")
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
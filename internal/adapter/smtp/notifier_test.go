@@ -0,0 +1,210 @@
+package smtp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+)
+
+// fakeServer - Servidor SMTP mínimo que acepta todo y guarda cada correo
+type fakeServer struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	from     string
+	rcpts    []string
+	mensajes []string
+	conexion int
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeServer{listener: listener}
+	t.Cleanup(func() { listener.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *fakeServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conexion++
+		s.mu.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeServer) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verbo, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verbo) {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			s.mu.Lock()
+			s.rcpts = append(s.rcpts, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 fin con <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.mensajes = append(s.mensajes, string(data))
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 adiós")
+			return
+		default:
+			tp.PrintfLine("502 no implementado")
+		}
+	}
+}
+
+func (s *fakeServer) notifier() *Notifier {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return NewNotifier(Config{
+		Host:        host,
+		Port:        port,
+		TLS:         TLSNone,
+		From:        "Control Escolar <escolar@example.com>",
+		BroadcastTo: []string{"anuncios@example.com"},
+		Timeout:     5 * time.Second,
+	})
+}
+
+func TestSendMultipart(t *testing.T) {
+	server := newFakeServer(t)
+	mensaje := domain.Mensaje{
+		Asunto: "Calificación actualizada",
+		Texto:  "Tu promedio es 9.5",
+		HTML:   "<p>Tu promedio es <b>9.5</b></p>",
+	}
+
+	err := server.notifier().Send(context.Background(), domain.Destinatario{Email: "ana@example.com"}, mensaje)
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if server.from != "escolar@example.com" {
+		t.Errorf("MAIL FROM = %q", server.from)
+	}
+	if len(server.rcpts) != 1 || server.rcpts[0] != "ana@example.com" {
+		t.Errorf("RCPT TO = %v", server.rcpts)
+	}
+	if len(server.mensajes) != 1 {
+		t.Fatalf("se recibieron %d correos", len(server.mensajes))
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(server.mensajes[0]))
+	if err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+	asunto, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || asunto != mensaje.Asunto {
+		t.Errorf("Subject = %q (%v)", asunto, err)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q (%v)", msg.Header.Get("Content-Type"), err)
+	}
+
+	partes := map[string]string{}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("NextPart: %v", err)
+		}
+		contenido, err := io.ReadAll(quotedprintable.NewReader(part))
+		if err != nil {
+			t.Fatalf("ReadAll: %v", err)
+		}
+		partes[part.Header.Get("Content-Type")] = string(contenido)
+	}
+	if partes["text/plain; charset=utf-8"] != mensaje.Texto {
+		t.Errorf("parte de texto = %q", partes["text/plain; charset=utf-8"])
+	}
+	if partes["text/html; charset=utf-8"] != mensaje.HTML {
+		t.Errorf("parte HTML = %q", partes["text/html; charset=utf-8"])
+	}
+}
+
+func TestSendSinEmailSeOmite(t *testing.T) {
+	server := newFakeServer(t)
+
+	err := server.notifier().Send(context.Background(), domain.Destinatario{Telefono: "+525512345678"}, domain.Mensaje{Asunto: "Aviso", Texto: "hola"})
+	if !errors.Is(err, domain.ErrCanalNoSoportado) {
+		t.Fatalf("Send con solo teléfono = %v, se esperaba ErrCanalNoSoportado", err)
+	}
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if server.conexion != 0 {
+		t.Errorf("se abrieron %d conexiones SMTP para un alumno sin email", server.conexion)
+	}
+}
+
+func TestSendSinDestinatario(t *testing.T) {
+	server := newFakeServer(t)
+
+	if err := server.notifier().Send(context.Background(), domain.Destinatario{}, domain.Mensaje{Texto: "hola"}); err == nil {
+		t.Fatal("Send sin email ni teléfono debería fallar")
+	}
+}
+
+func TestPublishBroadcast(t *testing.T) {
+	server := newFakeServer(t)
+
+	if err := server.notifier().Publish(context.Background(), "Anuncio", "Inscripciones abiertas"); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if len(server.rcpts) != 1 || server.rcpts[0] != "anuncios@example.com" {
+		t.Errorf("RCPT TO = %v", server.rcpts)
+	}
+	if len(server.mensajes) != 1 || !strings.Contains(server.mensajes[0], "Content-Type: text/plain; charset=utf-8") {
+		t.Errorf("se esperaba un correo de texto plano, llegó %q", server.mensajes)
+	}
+}
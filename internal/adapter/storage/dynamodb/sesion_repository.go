@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
@@ -71,7 +72,7 @@ func (r *SesionRepository) Deactivate(ctx context.Context, sessionString string)
 		return err
 	}
 	if sesion == nil {
-		return fmt.Errorf("sesión no encontrada")
+		return apperrors.ErrNotFound
 	}
 
 	_, err = r.client.UpdateItem(ctx, &dynamodb.UpdateItemInput{
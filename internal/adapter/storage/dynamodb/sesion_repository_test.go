@@ -0,0 +1,48 @@
+package dynamodb
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port/porttest"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/google/uuid"
+)
+
+// TestSesionRepository corre la suite de conformidad contra DynamoDB Local
+// (o cualquier endpoint compatible) cuando DYNAMODB_ENDPOINT está definido,
+// p. ej. docker run -p 8000:8000 amazon/dynamodb-local
+func TestSesionRepository(t *testing.T) {
+	endpoint := os.Getenv("DYNAMODB_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("DYNAMODB_ENDPOINT no está definido")
+	}
+	ctx := context.Background()
+
+	client := dynamodb.New(dynamodb.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(endpoint),
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "local", SecretAccessKey: "local"}, nil
+		}),
+	})
+	tableName := "sesiones-test-" + uuid.NewString()
+	repo := NewSesionRepository(client, tableName)
+	if err := repo.CreateTable(ctx); err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+	t.Cleanup(func() {
+		client.DeleteTable(context.Background(), &dynamodb.DeleteTableInput{TableName: aws.String(tableName)})
+	})
+	waiter := dynamodb.NewTableExistsWaiter(client)
+	if err := waiter.Wait(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(tableName)}, time.Minute); err != nil {
+		t.Fatalf("la tabla %s no quedó activa: %v", tableName, err)
+	}
+
+	if err := porttest.TestSesionRepository(ctx, repo); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,254 @@
+package local
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+// FileStorage guarda los archivos en disco bajo rootDir y genera URLs
+// /files/{key}?expires=...&signature=... firmadas con HMAC-SHA256 sobre
+// método, llave, expiración y (para subidas) Content-Type y tamaño
+type FileStorage struct {
+	rootDir    string
+	baseURL    string
+	signingKey []byte
+	urlTTL     time.Duration
+}
+
+func NewFileStorage(rootDir, baseURL string, signingKey []byte, urlTTL time.Duration) *FileStorage {
+	return &FileStorage{
+		rootDir:    rootDir,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		signingKey: signingKey,
+		urlTTL:     urlTTL,
+	}
+}
+
+func (f *FileStorage) Upload(ctx context.Context, key string, file io.Reader, contentType string) (string, error) {
+	dest := f.path(key)
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return "", fmt.Errorf("error al crear directorio: %w", err)
+	}
+
+	// Se escribe en un temporal y se renombra para no dejar archivos a medias
+	tmp, err := os.CreateTemp(filepath.Dir(dest), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("error al crear archivo: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, file); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("error al escribir archivo: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("error al escribir archivo: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		return "", fmt.Errorf("error al guardar archivo: %w", err)
+	}
+
+	return f.GetURL(ctx, key)
+}
+
+func (f *FileStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(f.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("error al abrir archivo: %w", err)
+	}
+	return file, nil
+}
+
+func (f *FileStorage) GetURL(ctx context.Context, key string) (string, error) {
+	key = cleanKey(key)
+	expires := time.Now().Add(f.urlTTL).Unix()
+	return f.signedURL(key, expires, f.sign(http.MethodGet, key, expires, "", 0)), nil
+}
+
+// PresignUpload genera una URL PUT /files/{key} firmada con el Content-Type y
+// Content-Length que el cliente debe enviar, igual que una URL prefirmada de S3
+func (f *FileStorage) PresignUpload(ctx context.Context, key string, contentType string, size int64) (*domain.PresignedUpload, error) {
+	key = cleanKey(key)
+	expiresAt := time.Now().Add(f.urlTTL)
+	signature := f.sign(http.MethodPut, key, expiresAt.Unix(), contentType, size)
+
+	return &domain.PresignedUpload{
+		Key:    key,
+		URL:    f.signedURL(key, expiresAt.Unix(), signature),
+		Method: http.MethodPut,
+		Headers: map[string]string{
+			"Content-Type":   contentType,
+			"Content-Length": strconv.FormatInt(size, 10),
+		},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (f *FileStorage) Exists(ctx context.Context, key string) (bool, error) {
+	info, err := os.Stat(f.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error al consultar archivo: %w", err)
+	}
+	return !info.IsDir(), nil
+}
+
+func (f *FileStorage) List(ctx context.Context, prefix string) ([]domain.StoredObject, error) {
+	var objects []domain.StoredObject
+
+	err := filepath.WalkDir(f.rootDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".upload-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(f.rootDir, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, domain.StoredObject{
+			Key:          key,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error al listar archivos: %w", err)
+	}
+
+	return objects, nil
+}
+
+func (f *FileStorage) Delete(ctx context.Context, key string) error {
+	if err := os.Remove(f.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error al eliminar archivo: %w", err)
+	}
+	return nil
+}
+
+// OpenSigned abre el archivo de key si la firma es válida y no ha expirado
+func (f *FileStorage) OpenSigned(ctx context.Context, key string, expires int64, signature string) (io.ReadSeekCloser, time.Time, error) {
+	key = cleanKey(key)
+	if time.Now().Unix() > expires {
+		return nil, time.Time{}, apperrors.ErrForbidden
+	}
+
+	if !f.validSignature(http.MethodGet, key, expires, "", 0, signature) {
+		return nil, time.Time{}, apperrors.ErrForbidden
+	}
+
+	file, err := os.Open(f.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, time.Time{}, apperrors.ErrNotFound
+		}
+		return nil, time.Time{}, fmt.Errorf("error al abrir archivo: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, time.Time{}, fmt.Errorf("error al leer archivo: %w", err)
+	}
+	if info.IsDir() {
+		file.Close()
+		return nil, time.Time{}, apperrors.ErrNotFound
+	}
+
+	return file, info.ModTime(), nil
+}
+
+// UploadSigned guarda el archivo de una subida directa si la firma coincide
+// con el Content-Type y tamaño declarados
+func (f *FileStorage) UploadSigned(ctx context.Context, key string, expires int64, signature string, file io.Reader, contentType string, size int64) error {
+	key = cleanKey(key)
+	if time.Now().Unix() > expires {
+		return apperrors.ErrForbidden
+	}
+	if !f.validSignature(http.MethodPut, key, expires, contentType, size, signature) {
+		return apperrors.ErrForbidden
+	}
+
+	_, err := f.Upload(ctx, key, io.LimitReader(file, size), contentType)
+	return err
+}
+
+// CreateRoot crea el directorio raíz si no existe
+func (f *FileStorage) CreateRoot(ctx context.Context) error {
+	return os.MkdirAll(f.rootDir, 0o755)
+}
+
+func (f *FileStorage) sign(method, key string, expires int64, contentType string, size int64) string {
+	mac := hmac.New(sha256.New, f.signingKey)
+	for _, part := range []string{method, key, strconv.FormatInt(expires, 10), contentType, strconv.FormatInt(size, 10)} {
+		mac.Write([]byte(part))
+		mac.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (f *FileStorage) validSignature(method, key string, expires int64, contentType string, size int64, signature string) bool {
+	expected := f.sign(method, key, expires, contentType, size)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func (f *FileStorage) signedURL(key string, expires int64, signature string) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", signature)
+
+	return fmt.Sprintf("%s/files/%s?%s", f.baseURL, escapeKey(key), query.Encode())
+}
+
+// escapeKey escapa cada segmento de la llave para que ?, #, % o espacios no
+// cambien la URL; las / se conservan como separadores
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func (f *FileStorage) path(key string) string {
+	return filepath.Join(f.rootDir, filepath.FromSlash(cleanKey(key)))
+}
+
+// cleanKey normaliza la llave y evita que salga del directorio raíz (../)
+func cleanKey(key string) string {
+	return strings.TrimPrefix(path.Clean("/"+key), "/")
+}
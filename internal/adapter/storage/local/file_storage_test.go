@@ -0,0 +1,190 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/http/handler"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/go-chi/chi/v5"
+)
+
+func nuevoFileStorage(t *testing.T, ttl time.Duration) *FileStorage {
+	t.Helper()
+	return NewFileStorage(t.TempDir(), "http://localhost:8080", []byte("clave"), ttl)
+}
+
+// firmada separa la llave (ya sin escapar), la expiración y la firma de una URL
+func firmada(t *testing.T, rawURL string) (string, int64, string) {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("URL inválida %q: %v", rawURL, err)
+	}
+	expires, err := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	if err != nil {
+		t.Fatalf("expires inválido en %q", rawURL)
+	}
+	return strings.TrimPrefix(u.Path, "/files/"), expires, u.Query().Get("signature")
+}
+
+func leer(t *testing.T, file io.ReadCloser) string {
+	t.Helper()
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestOpenSigned(t *testing.T) {
+	ctx := context.Background()
+	storage := nuevoFileStorage(t, time.Minute)
+
+	for _, key := range []string{"alumnos/1/foto.png", "docs/¿qué? #1 100%.pdf", "a,b;c=d/e&f+g.txt"} {
+		rawURL, err := storage.Upload(ctx, key, strings.NewReader("contenido"), "text/plain")
+		if err != nil {
+			t.Fatalf("Upload(%q): %v", key, err)
+		}
+		if u, err := url.Parse(rawURL); err != nil || u.RawQuery == "" || u.Fragment != "" {
+			t.Fatalf("la llave %q rompe la URL %q", key, rawURL)
+		}
+
+		got, expires, signature := firmada(t, rawURL)
+		if got != key {
+			t.Fatalf("la URL de %q apunta a %q", key, got)
+		}
+		file, _, err := storage.OpenSigned(ctx, got, expires, signature)
+		if err != nil {
+			t.Fatalf("OpenSigned(%q): %v", key, err)
+		}
+		if contenido := leer(t, file); contenido != "contenido" {
+			t.Fatalf("OpenSigned(%q) = %q", key, contenido)
+		}
+	}
+}
+
+func TestOpenSignedRechaza(t *testing.T) {
+	ctx := context.Background()
+	storage := nuevoFileStorage(t, time.Minute)
+	rawURL, err := storage.Upload(ctx, "a/b.txt", strings.NewReader("x"), "text/plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, expires, signature := firmada(t, rawURL)
+
+	vencida := nuevoFileStorage(t, -time.Minute)
+	vencida.rootDir = storage.rootDir
+	vencidaURL, err := vencida.GetURL(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, vencidaExpires, vencidaSignature := firmada(t, vencidaURL)
+
+	otra := []byte(signature)
+	otra[0] ^= 1
+
+	for nombre, tc := range map[string]struct {
+		key       string
+		expires   int64
+		signature string
+	}{
+		"vencida":             {key, vencidaExpires, vencidaSignature},
+		"firma alterada":      {key, expires, string(otra)},
+		"expiración alterada": {key, expires + 60, signature},
+		"otra llave":          {"a/c.txt", expires, signature},
+		"sin firma":           {key, expires, ""},
+	} {
+		if _, _, err := storage.OpenSigned(ctx, tc.key, tc.expires, tc.signature); !errors.Is(err, apperrors.ErrForbidden) {
+			t.Errorf("%s: OpenSigned = %v, se esperaba ErrForbidden", nombre, err)
+		}
+	}
+
+	// Una URL de descarga no sirve para subir
+	if err := storage.UploadSigned(ctx, key, expires, signature, strings.NewReader("y"), "text/plain", 1); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Errorf("UploadSigned con firma GET = %v, se esperaba ErrForbidden", err)
+	}
+}
+
+func TestUploadSigned(t *testing.T) {
+	ctx := context.Background()
+	storage := nuevoFileStorage(t, time.Minute)
+	upload, err := storage.PresignUpload(ctx, "fotos/1 #a.png", "image/png", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, expires, signature := firmada(t, upload.URL)
+
+	if err := storage.UploadSigned(ctx, key, expires, signature, strings.NewReader("png!"), "image/jpeg", 4); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("otro Content-Type = %v, se esperaba ErrForbidden", err)
+	}
+	if err := storage.UploadSigned(ctx, key, expires, signature, strings.NewReader("png!!"), "image/png", 5); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("otro tamaño = %v, se esperaba ErrForbidden", err)
+	}
+	if err := storage.UploadSigned(ctx, key, expires, signature, strings.NewReader("png!"), "image/png", 4); err != nil {
+		t.Fatalf("UploadSigned: %v", err)
+	}
+	if ok, err := storage.Exists(ctx, upload.Key); err != nil || !ok {
+		t.Fatalf("Exists(%q) = %t, %v", upload.Key, ok, err)
+	}
+}
+
+func TestCleanKeyNoSaleDeLaRaiz(t *testing.T) {
+	ctx := context.Background()
+	storage := nuevoFileStorage(t, time.Minute)
+
+	for key, want := range map[string]string{
+		"../../etc/passwd":    "etc/passwd",
+		"/a/../../b.txt":      "b.txt",
+		"a/./b/../c.txt":      "a/c.txt",
+		"..":                  "",
+		"alumnos/1/foto.webp": "alumnos/1/foto.webp",
+	} {
+		if got := cleanKey(key); got != want {
+			t.Errorf("cleanKey(%q) = %q, se esperaba %q", key, got, want)
+		}
+	}
+
+	if _, err := storage.Upload(ctx, "../../fuera.txt", strings.NewReader("x"), "text/plain"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(storage.rootDir, "fuera.txt")); err != nil {
+		t.Fatalf("la llave con ../ no quedó dentro de la raíz: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(storage.rootDir), "fuera.txt")); err == nil {
+		t.Fatal("la llave con ../ escribió fuera de la raíz")
+	}
+}
+
+// La URL firmada funciona tal cual a través del router, también con llaves
+// cuyos escapes hacen que chi enrute sobre RawPath
+func TestURLFirmadaPorHTTP(t *testing.T) {
+	ctx := context.Background()
+	storage := nuevoFileStorage(t, time.Minute)
+	files := handler.NewFileHandler(storage)
+	r := chi.NewRouter()
+	r.Get("/files/*", files.Download)
+
+	for _, key := range []string{"docs/¿qué? #1 100%.pdf", "a,b/c.txt"} {
+		rawURL, err := storage.Upload(ctx, key, strings.NewReader(key), "text/plain")
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, rawURL, nil))
+		if w.Code != http.StatusOK || w.Body.String() != key {
+			t.Errorf("GET %s = %d %q", rawURL, w.Code, w.Body)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+)
+
+// RunMigrations crea/actualiza el esquema; funciona igual en PostgreSQL y SQLite
+func RunMigrations(db *gorm.DB) error {
+	if err := db.AutoMigrate(
+		&domain.Alumno{},
+		&domain.Profesor{},
+		&domain.Documento{},
+		&domain.PlantillaNotificacion{},
+		&domain.Notificacion{},
+		&domain.WebhookSuscripcion{},
+		&domain.WebhookEntrega{},
+		&domain.EventoSalida{},
+		&domain.Job{},
+		&domain.RegistroIdempotencia{},
+	); err != nil {
+		return err
+	}
+
+	return migrateFotoPerfilURLToKey(db)
+}
+
+// migrateFotoPerfilURLToKey convierte la antigua columna foto_perfil_url
+// (endpoint/bucket/alumnos/...) en la llave del objeto y la elimina
+func migrateFotoPerfilURLToKey(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&domain.Alumno{}, "foto_perfil_url") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var rows []struct {
+			ID            uint
+			FotoPerfilUrl string
+		}
+		err := tx.Table("alumnos").
+			Select("id, foto_perfil_url").
+			Where("foto_perfil_url <> '' AND (foto_perfil_key IS NULL OR foto_perfil_key = '')").
+			Scan(&rows).Error
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			idx := strings.Index(row.FotoPerfilUrl, "alumnos/")
+			if idx < 0 {
+				continue
+			}
+			key, _, _ := strings.Cut(row.FotoPerfilUrl[idx:], "?")
+			err := tx.Table("alumnos").
+				Where("id = ?", row.ID).
+				Update("foto_perfil_key", key).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Migrator().DropColumn(&domain.Alumno{}, "foto_perfil_url")
+	})
+}
@@ -0,0 +1,110 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	sesionKeyPrefix = "sesion:"
+
+	// maxIntentosDeactivate - Reintentos cuando otra escritura toca la llave
+	// entre el WATCH y el EXEC
+	maxIntentosDeactivate = 3
+)
+
+// SesionRepository guarda cada sesión como JSON bajo sesion:{sessionString};
+// la expiración la maneja Redis con el TTL de la llave
+type SesionRepository struct {
+	client *goredis.Client
+	ttl    time.Duration
+}
+
+func NewSesionRepository(client *goredis.Client, ttl time.Duration) *SesionRepository {
+	return &SesionRepository{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+func (r *SesionRepository) Create(ctx context.Context, sesion *domain.Sesion) error {
+	data, err := json.Marshal(sesion)
+	if err != nil {
+		return fmt.Errorf("error al serializar sesión: %w", err)
+	}
+
+	if err := r.client.Set(ctx, sesionKey(sesion.SessionString), data, r.ttl).Err(); err != nil {
+		return fmt.Errorf("error al crear sesión en Redis: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SesionRepository) GetBySessionString(ctx context.Context, sessionString string) (*domain.Sesion, error) {
+	data, err := r.client.Get(ctx, sesionKey(sessionString)).Bytes()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error al buscar sesión: %w", err)
+	}
+
+	var sesion domain.Sesion
+	if err := json.Unmarshal(data, &sesion); err != nil {
+		return nil, fmt.Errorf("error al deserializar sesión: %w", err)
+	}
+
+	return &sesion, nil
+}
+
+// Deactivate lee y reescribe la sesión en una transacción con WATCH, de modo
+// que una expiración o un cambio concurrente de la llave no se pisen
+func (r *SesionRepository) Deactivate(ctx context.Context, sessionString string) error {
+	key := sesionKey(sessionString)
+	for range maxIntentosDeactivate {
+		err := r.client.Watch(ctx, func(tx *goredis.Tx) error {
+			data, err := tx.Get(ctx, key).Bytes()
+			if errors.Is(err, goredis.Nil) {
+				return apperrors.ErrNotFound
+			}
+			if err != nil {
+				return fmt.Errorf("error al buscar sesión: %w", err)
+			}
+
+			var sesion domain.Sesion
+			if err := json.Unmarshal(data, &sesion); err != nil {
+				return fmt.Errorf("error al deserializar sesión: %w", err)
+			}
+			sesion.Active = false
+			data, err = json.Marshal(&sesion)
+			if err != nil {
+				return fmt.Errorf("error al serializar sesión: %w", err)
+			}
+
+			// KeepTTL conserva la expiración original de la sesión
+			_, err = tx.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+				pipe.SetArgs(ctx, key, data, goredis.SetArgs{Mode: "XX", KeepTTL: true})
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("error al desactivar sesión: %w", err)
+			}
+			return nil
+		}, key)
+		if !errors.Is(err, goredis.TxFailedErr) {
+			return err
+		}
+	}
+	return fmt.Errorf("error al desactivar sesión: %w", goredis.TxFailedErr)
+}
+
+func sesionKey(sessionString string) string {
+	return sesionKeyPrefix + sessionString
+}
@@ -0,0 +1,65 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port/porttest"
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+func TestSesionRepository(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	repo := NewSesionRepository(client, time.Hour)
+	if err := porttest.TestSesionRepository(context.Background(), repo); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSesionRepositoryExpira(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	repo := NewSesionRepository(client, time.Minute)
+	sesion := &domain.Sesion{ID: "s-1", Fecha: time.Now().Unix(), AlumnoID: 1, Active: true, SessionString: "expira"}
+	if err := repo.Create(ctx, sesion); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	server.FastForward(2 * time.Minute)
+	got, err := repo.GetBySessionString(ctx, sesion.SessionString)
+	if err != nil {
+		t.Fatalf("GetBySessionString: %v", err)
+	}
+	if got != nil {
+		t.Fatalf("la sesión debía expirar con el TTL, sigue: %+v", *got)
+	}
+}
+
+// Desactivar conserva la expiración de la llave
+func TestSesionRepositoryDeactivateConservaTTL(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	repo := NewSesionRepository(client, time.Minute)
+	sesion := &domain.Sesion{ID: "s-1", Fecha: time.Now().Unix(), AlumnoID: 1, Active: true, SessionString: "activa"}
+	if err := repo.Create(ctx, sesion); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := repo.Deactivate(ctx, sesion.SessionString); err != nil {
+		t.Fatalf("Deactivate: %v", err)
+	}
+	if ttl := server.TTL(sesionKey(sesion.SessionString)); ttl <= 0 || ttl > time.Minute {
+		t.Fatalf("TTL tras Deactivate = %s", ttl)
+	}
+}
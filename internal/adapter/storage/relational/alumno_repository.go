@@ -0,0 +1,86 @@
+// Package relational implementa los repositorios con GORM. Sirve igual para
+// PostgreSQL y SQLite; lo único que cambia por dialecto es el bloqueo al
+// reservar filas (storage.SkipLocked)
+package relational
+
+import (
+	"context"
+	"errors"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+)
+
+type AlumnoRepository struct {
+	db *gorm.DB
+}
+
+func NewAlumnoRepository(db *gorm.DB) *AlumnoRepository {
+	return &AlumnoRepository{db: db}
+}
+
+func (r *AlumnoRepository) GetAll(ctx context.Context) ([]domain.Alumno, error) {
+	var alumnos []domain.Alumno
+	if err := storage.DB(ctx, r.db).Find(&alumnos).Error; err != nil {
+		return nil, err
+	}
+	return alumnos, nil
+}
+
+func (r *AlumnoRepository) GetByID(ctx context.Context, id uint) (*domain.Alumno, error) {
+	var alumno domain.Alumno
+	if err := storage.DB(ctx, r.db).First(&alumno, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &alumno, nil
+}
+
+func (r *AlumnoRepository) GetByIDs(ctx context.Context, ids []uint) ([]domain.Alumno, error) {
+	var alumnos []domain.Alumno
+	if len(ids) == 0 {
+		return alumnos, nil
+	}
+	if err := storage.DB(ctx, r.db).Where("id IN ?", ids).Find(&alumnos).Error; err != nil {
+		return nil, err
+	}
+	return alumnos, nil
+}
+
+func (r *AlumnoRepository) GetByMatriculas(ctx context.Context, matriculas []string) ([]domain.Alumno, error) {
+	var alumnos []domain.Alumno
+	if len(matriculas) == 0 {
+		return alumnos, nil
+	}
+	if err := storage.DB(ctx, r.db).Where("matricula IN ?", matriculas).Find(&alumnos).Error; err != nil {
+		return nil, err
+	}
+	return alumnos, nil
+}
+
+func (r *AlumnoRepository) Each(ctx context.Context, fn func(*domain.Alumno) error) error {
+	var batch []domain.Alumno
+	return storage.DB(ctx, r.db).FindInBatches(&batch, storage.BatchSize, func(tx *gorm.DB, _ int) error {
+		for i := range batch {
+			if err := fn(&batch[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+func (r *AlumnoRepository) Create(ctx context.Context, alumno *domain.Alumno) error {
+	return storage.DB(ctx, r.db).Create(alumno).Error
+}
+
+func (r *AlumnoRepository) Update(ctx context.Context, alumno *domain.Alumno) error {
+	return storage.DB(ctx, r.db).Save(alumno).Error
+}
+
+func (r *AlumnoRepository) Delete(ctx context.Context, id uint) error {
+	return storage.DB(ctx, r.db).Delete(&domain.Alumno{}, id).Error
+}
@@ -0,0 +1,72 @@
+package relational
+
+import (
+	"context"
+	"errors"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+)
+
+type DocumentoRepository struct {
+	db *gorm.DB
+}
+
+func NewDocumentoRepository(db *gorm.DB) *DocumentoRepository {
+	return &DocumentoRepository{db: db}
+}
+
+func (r *DocumentoRepository) GetByAlumno(ctx context.Context, alumnoID uint) ([]domain.Documento, error) {
+	var documentos []domain.Documento
+	err := storage.DB(ctx, r.db).
+		Where("alumno_id = ?", alumnoID).
+		Order("created_at DESC").
+		Find(&documentos).Error
+	if err != nil {
+		return nil, err
+	}
+	return documentos, nil
+}
+
+func (r *DocumentoRepository) GetByAlumnos(ctx context.Context, alumnoIDs []uint) ([]domain.Documento, error) {
+	var documentos []domain.Documento
+	if len(alumnoIDs) == 0 {
+		return documentos, nil
+	}
+	err := storage.DB(ctx, r.db).
+		Where("alumno_id IN ?", alumnoIDs).
+		Order("created_at DESC").
+		Find(&documentos).Error
+	if err != nil {
+		return nil, err
+	}
+	return documentos, nil
+}
+
+func (r *DocumentoRepository) GetByID(ctx context.Context, id uint) (*domain.Documento, error) {
+	var documento domain.Documento
+	if err := storage.DB(ctx, r.db).First(&documento, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &documento, nil
+}
+
+func (r *DocumentoRepository) Create(ctx context.Context, documento *domain.Documento) error {
+	return storage.DB(ctx, r.db).Create(documento).Error
+}
+
+func (r *DocumentoRepository) Update(ctx context.Context, documento *domain.Documento) error {
+	return storage.DB(ctx, r.db).Save(documento).Error
+}
+
+func (r *DocumentoRepository) Delete(ctx context.Context, id uint) error {
+	return storage.DB(ctx, r.db).Delete(&domain.Documento{}, id).Error
+}
+
+func (r *DocumentoRepository) DeleteByAlumno(ctx context.Context, alumnoID uint) error {
+	return storage.DB(ctx, r.db).Where("alumno_id = ?", alumnoID).Delete(&domain.Documento{}).Error
+}
@@ -0,0 +1,54 @@
+package relational
+
+import (
+	"context"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+)
+
+type EventoSalidaRepository struct {
+	db *gorm.DB
+}
+
+func NewEventoSalidaRepository(db *gorm.DB) *EventoSalidaRepository {
+	return &EventoSalidaRepository{db: db}
+}
+
+func (r *EventoSalidaRepository) Create(ctx context.Context, evento *domain.EventoSalida) error {
+	return storage.DB(ctx, r.db).Create(evento).Error
+}
+
+func (r *EventoSalidaRepository) Update(ctx context.Context, evento *domain.EventoSalida) error {
+	return storage.DB(ctx, r.db).Save(evento).Error
+}
+
+func (r *EventoSalidaRepository) ClaimPendientes(ctx context.Context, limit int, lease time.Duration) ([]domain.EventoSalida, error) {
+	var eventos []domain.EventoSalida
+	err := storage.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := storage.SkipLocked(tx).
+			Where("estado = ? AND siguiente_intento <= ?", domain.EntregaPendiente, now).
+			Order("siguiente_intento").
+			Limit(limit).
+			Find(&eventos).Error
+		if err != nil || len(eventos) == 0 {
+			return err
+		}
+
+		ids := make([]uint, len(eventos))
+		for i := range eventos {
+			ids[i] = eventos[i].ID
+			eventos[i].SiguienteIntento = now.Add(lease)
+		}
+		return tx.Model(&domain.EventoSalida{}).
+			Where("id IN ?", ids).
+			Update("siguiente_intento", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return eventos, nil
+}
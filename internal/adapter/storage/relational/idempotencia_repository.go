@@ -0,0 +1,65 @@
+package relational
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IdempotenciaRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotenciaRepository(db *gorm.DB) *IdempotenciaRepository {
+	return &IdempotenciaRepository{db: db}
+}
+
+func (r *IdempotenciaRepository) Reservar(ctx context.Context, registro *domain.RegistroIdempotencia) (*domain.RegistroIdempotencia, bool, error) {
+	db := storage.DB(ctx, r.db)
+	// Un registro expirado se reemplaza; el DELETE condicional evita borrar uno
+	// que otra petición acaba de reservar
+	err := db.Where("clave = ? AND expira_en <= ?", registro.Clave, time.Now()).
+		Delete(&domain.RegistroIdempotencia{}).Error
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Si la clave se libera entre el INSERT y la lectura se vuelve a intentar
+	for {
+		result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(registro)
+		if result.Error != nil {
+			return nil, false, result.Error
+		}
+		if result.RowsAffected == 1 {
+			return registro, true, nil
+		}
+
+		var existente domain.RegistroIdempotencia
+		err := db.First(&existente, "clave = ?", registro.Clave).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		return &existente, false, nil
+	}
+}
+
+func (r *IdempotenciaRepository) Update(ctx context.Context, registro *domain.RegistroIdempotencia) error {
+	return storage.DB(ctx, r.db).Save(registro).Error
+}
+
+func (r *IdempotenciaRepository) Delete(ctx context.Context, clave string) error {
+	return storage.DB(ctx, r.db).Delete(&domain.RegistroIdempotencia{}, "clave = ?", clave).Error
+}
+
+func (r *IdempotenciaRepository) DeleteExpirados(ctx context.Context) (int64, error) {
+	result := storage.DB(ctx, r.db).Where("expira_en <= ?", time.Now()).Delete(&domain.RegistroIdempotencia{})
+	return result.RowsAffected, result.Error
+}
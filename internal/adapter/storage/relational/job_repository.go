@@ -0,0 +1,125 @@
+package relational
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+)
+
+type JobRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) *JobRepository {
+	return &JobRepository{db: db}
+}
+
+func (r *JobRepository) GetByID(ctx context.Context, id uint) (*domain.Job, error) {
+	var job domain.Job
+	if err := storage.DB(ctx, r.db).First(&job, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *JobRepository) Create(ctx context.Context, job *domain.Job) error {
+	return storage.DB(ctx, r.db).Create(job).Error
+}
+
+func (r *JobRepository) ClaimPendiente(ctx context.Context, lease time.Duration) (*domain.Job, error) {
+	var jobs []domain.Job
+	err := storage.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := storage.SkipLocked(tx).
+			Where("estado IN ? AND reservado_hasta <= ?", []string{domain.JobPendiente, domain.JobEnProceso}, now).
+			Order("reservado_hasta").
+			Limit(1).
+			Find(&jobs).Error
+		if err != nil || len(jobs) == 0 {
+			return err
+		}
+
+		job := &jobs[0]
+		job.Estado = domain.JobEnProceso
+		job.ReservadoHasta = now.Add(lease)
+		job.Intentos++
+		if job.IniciadoEn == nil {
+			job.IniciadoEn = &now
+		}
+		return tx.Model(job).Updates(map[string]any{
+			"estado":          job.Estado,
+			"reservado_hasta": job.ReservadoHasta,
+			"intentos":        job.Intentos,
+			"iniciado_en":     job.IniciadoEn,
+		}).Error
+	})
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return &jobs[0], nil
+}
+
+func (r *JobRepository) Heartbeat(ctx context.Context, id uint, procesados, total int, lease time.Duration) (bool, error) {
+	var job domain.Job
+	err := storage.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.Job{}).
+			Where("id = ? AND estado = ?", id, domain.JobEnProceso).
+			Updates(map[string]any{
+				"procesados":      procesados,
+				"total":           total,
+				"reservado_hasta": time.Now().Add(lease),
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Select("cancelacion_solicitada").First(&job, id).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return job.CancelacionSolicitada, nil
+}
+
+func (r *JobRepository) Cancel(ctx context.Context, id uint) error {
+	return storage.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.Job{}).
+			Where("id = ? AND estado = ?", id, domain.JobPendiente).
+			Updates(map[string]any{
+				"estado":                 domain.JobCancelado,
+				"cancelacion_solicitada": true,
+				"terminado_en":           time.Now(),
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&domain.Job{}).
+			Where("id = ? AND estado = ?", id, domain.JobEnProceso).
+			Update("cancelacion_solicitada", true).Error
+	})
+}
+
+// Terminar actualiza solo las columnas del worker; la condición sobre intentos
+// descarta el resultado de un worker cuya reserva venció y otro retomó el job
+func (r *JobRepository) Terminar(ctx context.Context, job *domain.Job) (bool, error) {
+	result := storage.DB(ctx, r.db).Model(&domain.Job{}).
+		Where("id = ? AND estado = ? AND intentos = ?", job.ID, domain.JobEnProceso, job.Intentos).
+		Updates(map[string]any{
+			"estado":        job.Estado,
+			"procesados":    job.Procesados,
+			"total":         job.Total,
+			"error":         job.Error,
+			"resultado_key": job.ResultadoKey,
+			"terminado_en":  job.TerminadoEn,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,78 @@
+package relational
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+)
+
+type NotificacionRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificacionRepository(db *gorm.DB) *NotificacionRepository {
+	return &NotificacionRepository{db: db}
+}
+
+func (r *NotificacionRepository) GetAll(ctx context.Context, estado string, limit int) ([]domain.Notificacion, error) {
+	var notificaciones []domain.Notificacion
+	query := storage.DB(ctx, r.db).Order("id DESC").Limit(limit)
+	if estado != "" {
+		query = query.Where("estado = ?", estado)
+	}
+	if err := query.Find(&notificaciones).Error; err != nil {
+		return nil, err
+	}
+	return notificaciones, nil
+}
+
+func (r *NotificacionRepository) GetByID(ctx context.Context, id uint) (*domain.Notificacion, error) {
+	var notificacion domain.Notificacion
+	if err := storage.DB(ctx, r.db).First(&notificacion, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &notificacion, nil
+}
+
+func (r *NotificacionRepository) Create(ctx context.Context, notificacion *domain.Notificacion) error {
+	return storage.DB(ctx, r.db).Create(notificacion).Error
+}
+
+func (r *NotificacionRepository) Update(ctx context.Context, notificacion *domain.Notificacion) error {
+	return storage.DB(ctx, r.db).Save(notificacion).Error
+}
+
+func (r *NotificacionRepository) ClaimPendientes(ctx context.Context, limit int, lease time.Duration) ([]domain.Notificacion, error) {
+	var notificaciones []domain.Notificacion
+	err := storage.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := storage.SkipLocked(tx).
+			Where("estado = ? AND siguiente_intento <= ?", domain.NotificacionPendiente, now).
+			Order("siguiente_intento").
+			Limit(limit).
+			Find(&notificaciones).Error
+		if err != nil || len(notificaciones) == 0 {
+			return err
+		}
+
+		ids := make([]uint, len(notificaciones))
+		for i := range notificaciones {
+			ids[i] = notificaciones[i].ID
+			notificaciones[i].SiguienteIntento = now.Add(lease)
+		}
+		return tx.Model(&domain.Notificacion{}).
+			Where("id IN ?", ids).
+			Update("siguiente_intento", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return notificaciones, nil
+}
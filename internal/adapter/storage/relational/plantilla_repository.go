@@ -0,0 +1,63 @@
+package relational
+
+import (
+	"context"
+	"errors"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+)
+
+type PlantillaRepository struct {
+	db *gorm.DB
+}
+
+func NewPlantillaRepository(db *gorm.DB) *PlantillaRepository {
+	return &PlantillaRepository{db: db}
+}
+
+func (r *PlantillaRepository) GetAll(ctx context.Context) ([]domain.PlantillaNotificacion, error) {
+	var plantillas []domain.PlantillaNotificacion
+	if err := storage.DB(ctx, r.db).Order("clave, idioma").Find(&plantillas).Error; err != nil {
+		return nil, err
+	}
+	return plantillas, nil
+}
+
+func (r *PlantillaRepository) GetByID(ctx context.Context, id uint) (*domain.PlantillaNotificacion, error) {
+	var plantilla domain.PlantillaNotificacion
+	if err := storage.DB(ctx, r.db).First(&plantilla, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &plantilla, nil
+}
+
+func (r *PlantillaRepository) GetByClave(ctx context.Context, clave string, idioma string) (*domain.PlantillaNotificacion, error) {
+	var plantilla domain.PlantillaNotificacion
+	err := storage.DB(ctx, r.db).
+		Where("clave = ? AND idioma = ?", clave, idioma).
+		First(&plantilla).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &plantilla, nil
+}
+
+func (r *PlantillaRepository) Create(ctx context.Context, plantilla *domain.PlantillaNotificacion) error {
+	return storage.DB(ctx, r.db).Create(plantilla).Error
+}
+
+func (r *PlantillaRepository) Update(ctx context.Context, plantilla *domain.PlantillaNotificacion) error {
+	return storage.DB(ctx, r.db).Save(plantilla).Error
+}
+
+func (r *PlantillaRepository) Delete(ctx context.Context, id uint) error {
+	return storage.DB(ctx, r.db).Delete(&domain.PlantillaNotificacion{}, id).Error
+}
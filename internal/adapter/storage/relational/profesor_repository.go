@@ -0,0 +1,72 @@
+package relational
+
+import (
+	"context"
+	"errors"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+)
+
+type ProfesorRepository struct {
+	db *gorm.DB
+}
+
+func NewProfesorRepository(db *gorm.DB) *ProfesorRepository {
+	return &ProfesorRepository{db: db}
+}
+
+func (r *ProfesorRepository) GetAll(ctx context.Context) ([]domain.Profesor, error) {
+	var profesores []domain.Profesor
+	if err := storage.DB(ctx, r.db).Find(&profesores).Error; err != nil {
+		return nil, err
+	}
+	return profesores, nil
+}
+
+func (r *ProfesorRepository) GetByID(ctx context.Context, id uint) (*domain.Profesor, error) {
+	var profesor domain.Profesor
+	if err := storage.DB(ctx, r.db).First(&profesor, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &profesor, nil
+}
+
+func (r *ProfesorRepository) GetByNumerosEmpleado(ctx context.Context, numeros []int) ([]domain.Profesor, error) {
+	var profesores []domain.Profesor
+	if len(numeros) == 0 {
+		return profesores, nil
+	}
+	if err := storage.DB(ctx, r.db).Where("numero_empleado IN ?", numeros).Find(&profesores).Error; err != nil {
+		return nil, err
+	}
+	return profesores, nil
+}
+
+func (r *ProfesorRepository) Each(ctx context.Context, fn func(*domain.Profesor) error) error {
+	var batch []domain.Profesor
+	return storage.DB(ctx, r.db).FindInBatches(&batch, storage.BatchSize, func(tx *gorm.DB, _ int) error {
+		for i := range batch {
+			if err := fn(&batch[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+}
+
+func (r *ProfesorRepository) Create(ctx context.Context, profesor *domain.Profesor) error {
+	return storage.DB(ctx, r.db).Create(profesor).Error
+}
+
+func (r *ProfesorRepository) Update(ctx context.Context, profesor *domain.Profesor) error {
+	return storage.DB(ctx, r.db).Save(profesor).Error
+}
+
+func (r *ProfesorRepository) Delete(ctx context.Context, id uint) error {
+	return storage.DB(ctx, r.db).Delete(&domain.Profesor{}, id).Error
+}
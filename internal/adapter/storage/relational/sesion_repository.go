@@ -0,0 +1,70 @@
+package relational
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// SesionRepository guarda las sesiones en la tabla sesiones. Una sesión con
+// más de ttl desde su fecha se trata como inexistente, igual que la llave
+// vencida en Redis; ttl <= 0 no las expira
+type SesionRepository struct {
+	db  *gorm.DB
+	ttl time.Duration
+}
+
+func NewSesionRepository(db *gorm.DB, ttl time.Duration) *SesionRepository {
+	return &SesionRepository{db: db, ttl: ttl}
+}
+
+func (r *SesionRepository) Create(ctx context.Context, sesion *domain.Sesion) error {
+	return storage.DB(ctx, r.db).Create(sesion).Error
+}
+
+func (r *SesionRepository) GetBySessionString(ctx context.Context, sessionString string) (*domain.Sesion, error) {
+	var sesion domain.Sesion
+	err := r.vigentes(ctx).
+		Where("session_string = ?", sessionString).
+		First(&sesion).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &sesion, nil
+}
+
+func (r *SesionRepository) Deactivate(ctx context.Context, sessionString string) error {
+	result := r.vigentes(ctx).
+		Model(&domain.Sesion{}).
+		Where("session_string = ?", sessionString).
+		Update("active", false)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// vigentes limita la consulta a las sesiones que no han expirado
+func (r *SesionRepository) vigentes(ctx context.Context) *gorm.DB {
+	db := storage.DB(ctx, r.db)
+	if r.ttl > 0 {
+		db = db.Where("fecha > ?", time.Now().Add(-r.ttl).Unix())
+	}
+	return db
+}
+
+// CreateTable crea la tabla de sesiones; solo se usa cuando SESSION_STORE=postgres
+func (r *SesionRepository) CreateTable(ctx context.Context) error {
+	return storage.DB(ctx, r.db).AutoMigrate(&domain.Sesion{})
+}
@@ -0,0 +1,55 @@
+package relational
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port/porttest"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+func nuevoSesionRepository(t *testing.T, ttl time.Duration) *SesionRepository {
+	t.Helper()
+	repo := NewSesionRepository(storagetest.NewSQLite(t), ttl)
+	if err := repo.CreateTable(context.Background()); err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+	return repo
+}
+
+func TestSesionRepository(t *testing.T) {
+	repo := nuevoSesionRepository(t, time.Hour)
+	if err := porttest.TestSesionRepository(context.Background(), repo); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Una sesión más vieja que el TTL no se encuentra ni se puede desactivar,
+// igual que la llave vencida en Redis
+func TestSesionRepositoryExpira(t *testing.T) {
+	ctx := context.Background()
+	repo := nuevoSesionRepository(t, time.Minute)
+
+	vieja := &domain.Sesion{ID: "s-1", Fecha: time.Now().Add(-2 * time.Minute).Unix(), AlumnoID: 1, Active: true, SessionString: "vieja"}
+	nueva := &domain.Sesion{ID: "s-2", Fecha: time.Now().Unix(), AlumnoID: 1, Active: true, SessionString: "nueva"}
+	for _, sesion := range []*domain.Sesion{vieja, nueva} {
+		if err := repo.Create(ctx, sesion); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+
+	got, err := repo.GetBySessionString(ctx, vieja.SessionString)
+	if err != nil || got != nil {
+		t.Fatalf("GetBySessionString(vieja) = %+v, %v; se esperaba (nil, nil)", got, err)
+	}
+	if err := repo.Deactivate(ctx, vieja.SessionString); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Fatalf("Deactivate(vieja) = %v, se esperaba ErrNotFound", err)
+	}
+	if got, err := repo.GetBySessionString(ctx, nueva.SessionString); err != nil || got == nil {
+		t.Fatalf("GetBySessionString(nueva) = %+v, %v", got, err)
+	}
+}
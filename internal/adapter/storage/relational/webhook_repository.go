@@ -0,0 +1,130 @@
+package relational
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"gorm.io/gorm"
+)
+
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+func (r *WebhookRepository) GetAll(ctx context.Context) ([]domain.WebhookSuscripcion, error) {
+	var suscripciones []domain.WebhookSuscripcion
+	if err := storage.DB(ctx, r.db).Order("id").Find(&suscripciones).Error; err != nil {
+		return nil, err
+	}
+	return suscripciones, nil
+}
+
+func (r *WebhookRepository) GetActivas(ctx context.Context) ([]domain.WebhookSuscripcion, error) {
+	var suscripciones []domain.WebhookSuscripcion
+	if err := storage.DB(ctx, r.db).Where("activa = ?", true).Find(&suscripciones).Error; err != nil {
+		return nil, err
+	}
+	return suscripciones, nil
+}
+
+func (r *WebhookRepository) GetByID(ctx context.Context, id uint) (*domain.WebhookSuscripcion, error) {
+	var suscripcion domain.WebhookSuscripcion
+	if err := storage.DB(ctx, r.db).First(&suscripcion, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &suscripcion, nil
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, suscripcion *domain.WebhookSuscripcion) error {
+	return storage.DB(ctx, r.db).Create(suscripcion).Error
+}
+
+func (r *WebhookRepository) Update(ctx context.Context, suscripcion *domain.WebhookSuscripcion) error {
+	return storage.DB(ctx, r.db).Save(suscripcion).Error
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id uint) error {
+	return storage.DB(ctx, r.db).Delete(&domain.WebhookSuscripcion{}, id).Error
+}
+
+type WebhookEntregaRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookEntregaRepository(db *gorm.DB) *WebhookEntregaRepository {
+	return &WebhookEntregaRepository{db: db}
+}
+
+func (r *WebhookEntregaRepository) GetBySuscripcion(ctx context.Context, suscripcionID uint, limit int) ([]domain.WebhookEntrega, error) {
+	var entregas []domain.WebhookEntrega
+	err := storage.DB(ctx, r.db).
+		Where("suscripcion_id = ?", suscripcionID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&entregas).Error
+	if err != nil {
+		return nil, err
+	}
+	return entregas, nil
+}
+
+func (r *WebhookEntregaRepository) GetByID(ctx context.Context, id uint) (*domain.WebhookEntrega, error) {
+	var entrega domain.WebhookEntrega
+	if err := storage.DB(ctx, r.db).First(&entrega, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entrega, nil
+}
+
+func (r *WebhookEntregaRepository) Create(ctx context.Context, entrega *domain.WebhookEntrega) error {
+	return storage.DB(ctx, r.db).Create(entrega).Error
+}
+
+func (r *WebhookEntregaRepository) Update(ctx context.Context, entrega *domain.WebhookEntrega) error {
+	return storage.DB(ctx, r.db).Save(entrega).Error
+}
+
+func (r *WebhookEntregaRepository) DeleteBySuscripcion(ctx context.Context, suscripcionID uint) error {
+	return storage.DB(ctx, r.db).Where("suscripcion_id = ?", suscripcionID).Delete(&domain.WebhookEntrega{}).Error
+}
+
+func (r *WebhookEntregaRepository) ClaimPendientes(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookEntrega, error) {
+	var entregas []domain.WebhookEntrega
+	err := storage.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := storage.SkipLocked(tx).
+			Where("estado = ? AND siguiente_intento <= ?", domain.EntregaPendiente, now).
+			Order("siguiente_intento").
+			Limit(limit).
+			Find(&entregas).Error
+		if err != nil || len(entregas) == 0 {
+			return err
+		}
+
+		ids := make([]uint, len(entregas))
+		for i := range entregas {
+			ids[i] = entregas[i].ID
+			entregas[i].SiguienteIntento = now.Add(lease)
+		}
+		return tx.Model(&domain.WebhookEntrega{}).
+			Where("id IN ?", ids).
+			Update("siguiente_intento", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entregas, nil
+}
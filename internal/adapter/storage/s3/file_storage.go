@@ -2,49 +2,150 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
+// FileStorage trabaja sobre un bucket privado: las descargas y subidas
+// directas se hacen con URLs prefirmadas de duración limitada
 type FileStorage struct {
-	client *s3.Client
-	bucketName string
-	endpoint string
+	client        *s3.Client
+	uploader      *manager.Uploader
+	presignClient *s3.PresignClient
+	bucketName    string
+	presignTTL    time.Duration
 }
 
-func NewFileStorage(client *s3.Client, bucketName, endpoint string) *FileStorage {
+func NewFileStorage(client *s3.Client, bucketName string, presignTTL time.Duration) *FileStorage {
 	return &FileStorage{
-		client: client,
-		bucketName: bucketName,
-		endpoint: endpoint,
+		client:        client,
+		uploader:      manager.NewUploader(client),
+		presignClient: s3.NewPresignClient(client),
+		bucketName:    bucketName,
+		presignTTL:    presignTTL,
 	}
 }
 
+// Upload usa el uploader multiparte para aceptar streams sin tamaño conocido
 func (f *FileStorage) Upload(ctx context.Context, key string, file io.Reader, contentType string) (string, error) {
-	_, err := f.client.PutObject(ctx, &s3.PutObjectInput{
-		Bucket: aws.String(f.bucketName),
-		Key: aws.String(key),
-		Body: file,
+	_, err := f.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(f.bucketName),
+		Key:         aws.String(key),
+		Body:        file,
 		ContentType: aws.String(contentType),
 	})
 	if err != nil {
 		return "", fmt.Errorf("error al subir archivo a s3: %w", err)
 	}
 
-	return f.GetURL(ctx, key), nil
+	return f.GetURL(ctx, key)
+}
+
+func (f *FileStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := f.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, fmt.Errorf("error al descargar archivo de S3: %w", err)
+	}
+	return output.Body, nil
+}
+
+func (f *FileStorage) GetURL(ctx context.Context, key string) (string, error) {
+	req, err := f.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(f.presignTTL))
+	if err != nil {
+		return "", fmt.Errorf("error al prefirmar URL de descarga: %w", err)
+	}
+	return req.URL, nil
+}
+
+// PresignUpload firma un PUT con Content-Type y Content-Length fijos;
+// S3 rechaza la subida si el cliente envía otros valores
+func (f *FileStorage) PresignUpload(ctx context.Context, key string, contentType string, size int64) (*domain.PresignedUpload, error) {
+	req, err := f.presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(f.bucketName),
+		Key:           aws.String(key),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(size),
+	}, s3.WithPresignExpires(f.presignTTL))
+	if err != nil {
+		return nil, fmt.Errorf("error al prefirmar URL de subida: %w", err)
+	}
+
+	return &domain.PresignedUpload{
+		Key:    key,
+		URL:    req.URL,
+		Method: http.MethodPut,
+		Headers: map[string]string{
+			"Content-Type":   contentType,
+			"Content-Length": strconv.FormatInt(size, 10),
+		},
+		ExpiresAt: time.Now().Add(f.presignTTL),
+	}, nil
+}
+
+func (f *FileStorage) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := f.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(f.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error al consultar archivo en S3: %w", err)
+	}
+	return true, nil
 }
 
-func (f *FileStorage) GetURL(ctx context.Context, key string) string {
-	return fmt.Sprintf("%s/%s/%s", f.endpoint, f.bucketName, key)
+func (f *FileStorage) List(ctx context.Context, prefix string) ([]domain.StoredObject, error) {
+	var objects []domain.StoredObject
+
+	paginator := s3.NewListObjectsV2Paginator(f.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(f.bucketName),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error al listar archivos de S3: %w", err)
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, domain.StoredObject{
+				Key:          aws.ToString(object.Key),
+				Size:         aws.ToInt64(object.Size),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+
+	return objects, nil
 }
 
 func (f *FileStorage) Delete(ctx context.Context, key string) error {
 	_, err := f.client.DeleteObject(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(f.bucketName),
-		Key: aws.String(key),
+		Key:    aws.String(key),
 	})
 	if err != nil {
 		return fmt.Errorf("error al eliminar archivo de S3: %w", err)
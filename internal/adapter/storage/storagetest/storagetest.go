@@ -0,0 +1,39 @@
+// Package storagetest arma bases de datos desechables para las pruebas de los
+// repositorios y casos de uso
+package storagetest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// NewSQLite abre una base SQLite en un directorio temporal de t con las
+// migraciones aplicadas; se cierra al terminar la prueba
+func NewSQLite(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := gorm.Open(sqlite.Open(path+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("abrir SQLite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("obtener conexión: %v", err)
+	}
+	// Igual que en producción: SQLite solo admite un escritor a la vez
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err := storage.RunMigrations(db); err != nil {
+		t.Fatalf("migraciones: %v", err)
+	}
+	return db
+}
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type txKey struct{}
+
+type txState struct {
+	tx          *gorm.DB
+	afterCommit []func(ctx context.Context)
+}
+
+// Transactor - Ejecuta varias operaciones de repositorio en una sola transacción
+type Transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+// WithTransaction ejecuta fn dentro de una transacción; los repositorios que reciben
+// el ctx de fn la usan. Si ctx ya trae una transacción se reutiliza
+func (t *Transactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	state := &txState{}
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		state.tx = tx
+		return fn(context.WithValue(ctx, txKey{}, state))
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range state.afterCommit {
+		hook(context.WithoutCancel(ctx))
+	}
+	return nil
+}
+
+// WithSavepoint ejecuta fn en un savepoint de la transacción de ctx: si fn falla
+// solo se revierte lo que hizo fn y la transacción sigue utilizable. Los
+// AfterCommit registrados en fn se descartan con el savepoint. Sin transacción
+// en ctx equivale a WithTransaction
+func (t *Transactor) WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	parent, ok := ctx.Value(txKey{}).(*txState)
+	if !ok {
+		return t.WithTransaction(ctx, fn)
+	}
+
+	// GORM anida Transaction sobre una transacción con SAVEPOINT / ROLLBACK TO
+	state := &txState{}
+	err := parent.tx.Transaction(func(tx *gorm.DB) error {
+		state.tx = tx
+		return fn(context.WithValue(ctx, txKey{}, state))
+	})
+	if err != nil {
+		return err
+	}
+
+	parent.afterCommit = append(parent.afterCommit, state.afterCommit...)
+	return nil
+}
+
+// AfterCommit ejecuta fn cuando la transacción de ctx se confirma; si ctx no trae
+// transacción la ejecuta de inmediato. Si la transacción se revierte fn no se ejecuta
+func (t *Transactor) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		state.afterCommit = append(state.afterCommit, fn)
+		return
+	}
+	fn(ctx)
+}
+
+// BatchSize - Filas leídas por consulta al recorrer una tabla completa. Cada lote
+// es una consulta independiente (paginada por llave primaria), así no se retiene
+// una conexión mientras el cliente consume la respuesta.
+const BatchSize = 500
+
+// SkipLocked bloquea las filas leídas con FOR UPDATE SKIP LOCKED para que varias
+// instancias reserven filas distintas sin esperarse. SQLite no lo soporta pero
+// serializa las escrituras, así que ahí basta la transacción
+func SkipLocked(tx *gorm.DB) *gorm.DB {
+	if tx.Dialector.Name() != "postgres" {
+		return tx
+	}
+	return tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+}
+
+// DB devuelve la transacción en curso en ctx o, si no hay, db
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+)
+
+// Cabeceras que acompañan a cada entrega
+const (
+	HeaderEvento    = "X-Webhook-Event"
+	HeaderID        = "X-Webhook-Id"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderFirma     = "X-Webhook-Signature"
+)
+
+// ErrDestinoNoPermitido - La URL resolvió a una dirección que no es pública
+var ErrDestinoNoPermitido = errors.New("destino no permitido")
+
+// Sender - Envía las entregas por HTTP POST firmadas con HMAC-SHA256
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender solo conecta con direcciones públicas: la IP se revisa al abrir la
+// conexión, ya resuelta, así un nombre que apunte a la red interna o a
+// 169.254.169.254 (o que cambie de IP tras validarse) no sirve para SSRF. No
+// usa el proxy del entorno, que haría la conexión en su nombre
+func NewSender(timeout time.Duration) *Sender {
+	dialer := &net.Dialer{Timeout: timeout, Control: soloPublicas}
+	return &Sender{client: &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			ForceAttemptHTTP2:   true,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+func soloPublicas(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrDestinoNoPermitido, address)
+	}
+	if !utils.IsPublicIP(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrDestinoNoPermitido, addrPort.Addr())
+	}
+	return nil
+}
+
+// Send considera exitosa cualquier respuesta 2xx; las redirecciones no se siguen
+func (s *Sender) Send(ctx context.Context, url string, secret string, entrega *domain.WebhookEntrega) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBufferString(entrega.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "aws-segundaentrega-webhooks/1.0")
+	req.Header.Set(HeaderEvento, entrega.Evento)
+	req.Header.Set(HeaderID, entrega.EventoID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderFirma, Sign(secret, timestamp, []byte(entrega.Payload)))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("respuesta %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign calcula la firma "sha256={hex}" de "{timestamp}.{payload}". El receptor
+// debe recalcularla con el mismo secreto y rechazar timestamps viejos
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
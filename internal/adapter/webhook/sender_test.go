@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+)
+
+func TestSendRechazaDestinosNoPublicos(t *testing.T) {
+	recibidas := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recibidas++
+	}))
+	t.Cleanup(server.Close)
+
+	sender := NewSender(time.Second)
+	entrega := &domain.WebhookEntrega{Evento: "alumno.creado", EventoID: "e-1", Payload: "{}"}
+	for _, url := range []string{
+		server.URL, // 127.0.0.1
+		strings.Replace(server.URL, "127.0.0.1", "localhost", 1),
+		"http://169.254.169.254/latest/meta-data/",
+		"http://10.0.0.1/",
+		"http://[::1]/",
+	} {
+		_, err := sender.Send(context.Background(), url, "secreto", entrega)
+		if !errors.Is(err, ErrDestinoNoPermitido) {
+			t.Errorf("Send(%s) = %v, se esperaba ErrDestinoNoPermitido", url, err)
+		}
+	}
+	if recibidas != 0 {
+		t.Errorf("el servidor local recibió %d entregas", recibidas)
+	}
+}
+
+func TestSign(t *testing.T) {
+	got := Sign("secreto", 1700000000, []byte(`{"a":1}`))
+	if !strings.HasPrefix(got, "sha256=") || len(got) != len("sha256=")+64 {
+		t.Fatalf("Sign = %q", got)
+	}
+	if got != Sign("secreto", 1700000000, []byte(`{"a":1}`)) {
+		t.Error("la firma no es determinista")
+	}
+	if got == Sign("otro", 1700000000, []byte(`{"a":1}`)) || got == Sign("secreto", 1700000001, []byte(`{"a":1}`)) {
+		t.Error("la firma no depende del secreto y del timestamp")
+	}
+}
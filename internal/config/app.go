@@ -1,30 +1,63 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	S3       S3Config
-	DynamoDB DynamoDBConfig
-	SNS      SNSConfig
+	Server      ServerConfig
+	GRPC        GRPCConfig
+	Auth        AuthConfig
+	Database    DatabaseConfig
+	S3          S3Config
+	DynamoDB    DynamoDBConfig
+	SNS         SNSConfig
+	Notifier    NotifierConfig
+	Outbox      OutboxConfig
+	Webhook     WebhookConfig
+	Jobs        JobsConfig
+	Idempotency IdempotencyConfig
+	OpenAPI     OpenAPIConfig
+	API         APIConfig
+	Events      EventsConfig
+	SMTP        SMTPConfig
+	Sesion      SesionConfig
+	Redis       RedisConfig
+	Storage     StorageConfig
 }
 
 type ServerConfig struct {
 	Port string
 }
 
+// GRPCConfig - Servidor gRPC en un puerto aparte del HTTP; Reflection (apagada
+// por omisión) permite que clientes como grpcurl descubran los servicios
+type GRPCConfig struct {
+	Port       string
+	Reflection bool
+}
+
+// AuthConfig - AdminToken habilita las rutas de administración con
+// "Authorization: Bearer <token>"; vacío las deja cerradas
+type AuthConfig struct {
+	AdminToken string
+}
+
 type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
-	DBName   string
-	SSLMode  string
+	Driver     string
+	Host       string
+	Port       string
+	User       string
+	Password   string
+	DBName     string
+	SSLMode    string
+	SQLitePath string
 }
 
 type S3Config struct {
@@ -34,6 +67,7 @@ type S3Config struct {
 	BucketName   string
 	Region       string
 	UsePathStyle bool
+	PresignTTL   time.Duration
 }
 
 type DynamoDBConfig struct {
@@ -48,20 +82,231 @@ type SNSConfig struct {
 	Region   string
 }
 
+// Canales de notificación disponibles (NOTIFIER_DRIVER)
+const (
+	NotifierDriverSNS  = "sns"
+	NotifierDriverSMTP = "smtp"
+)
+
+type NotifierConfig struct {
+	Driver string
+}
+
+// OutboxConfig - Despachador de la bandeja de salida de notificaciones
+type OutboxConfig struct {
+	Interval    time.Duration
+	BatchSize   int
+	MaxIntentos int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	Lease       time.Duration
+}
+
+type WebhookConfig struct {
+	Timeout time.Duration
+}
+
+// IdempotencyConfig - Tiempo que se guarda la respuesta de un Idempotency-Key
+type IdempotencyConfig struct {
+	TTL time.Duration
+}
+
+// APIConfig - Fechas de las rutas sin prefijo de versión, alias obsoletos de /v1
+type APIConfig struct {
+	AliasDeprecation time.Time
+	AliasSunset      time.Time
+}
+
+// OpenAPIConfig - ValidateResponses compara cada respuesta JSON con la
+// especificación; útil en pruebas
+type OpenAPIConfig struct {
+	ValidateResponses bool
+}
+
+// JobsConfig - Workers de tareas largas (importaciones y exportaciones)
+type JobsConfig struct {
+	Workers     int
+	Interval    time.Duration
+	Lease       time.Duration
+	MaxIntentos int
+}
+
+// Sinks externos del bus de eventos (EVENT_SINKS, separados por coma)
+const (
+	EventSinkLog = "log"
+	EventSinkSNS = "sns"
+)
+
+type EventsConfig struct {
+	Sinks       []string
+	SNSTopicARN string
+}
+
+type SMTPConfig struct {
+	Host        string
+	Port        string
+	Username    string
+	Password    string
+	TLS         string // none | starttls | tls
+	From        string
+	BroadcastTo []string
+}
+
+// Almacenes disponibles para las sesiones (SESSION_STORE)
+const (
+	SesionStoreDynamoDB = "dynamodb"
+	SesionStorePostgres = "postgres"
+	SesionStoreRedis    = "redis"
+)
+
+// SesionConfig - TTL es la vigencia de una sesión en Redis y en la base de
+// datos relacional
+type SesionConfig struct {
+	Store string
+	TTL   time.Duration
+}
+
+type RedisConfig struct {
+	Addr     string
+	Password string
+	DB       int
+}
+
+// Backends disponibles para archivos (STORAGE_DRIVER)
+const (
+	StorageDriverS3    = "s3"
+	StorageDriverLocal = "local"
+)
+
+type StorageConfig struct {
+	Driver string
+	Local  LocalStorageConfig
+}
+
+// LocalStorageConfig - Archivos en disco servidos por /files/* con URLs firmadas
+type LocalStorageConfig struct {
+	RootDir    string
+	BaseURL    string
+	SigningKey string
+	URLTTL     time.Duration
+}
+
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 
+	redisDB, err := strconv.Atoi(getEnv("REDIS_DB", "0"))
+	if err != nil {
+		return nil, fmt.Errorf("REDIS_DB inválido: %w", err)
+	}
+
+	// REDIS_SESSION_TTL se acepta todavía por compatibilidad
+	sessionTTL, err := time.ParseDuration(getEnv("SESSION_TTL", getEnv("REDIS_SESSION_TTL", "24h")))
+	if err != nil {
+		return nil, fmt.Errorf("SESSION_TTL inválido: %w", err)
+	}
+
+	localURLTTL, err := time.ParseDuration(getEnv("LOCAL_STORAGE_URL_TTL", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("LOCAL_STORAGE_URL_TTL inválido: %w", err)
+	}
+
+	presignTTL, err := time.ParseDuration(getEnv("S3_PRESIGN_TTL", "15m"))
+	if err != nil {
+		return nil, fmt.Errorf("S3_PRESIGN_TTL inválido: %w", err)
+	}
+
+	serverPort := getEnv("SERVER_PORT", "8080")
+
+	outboxInterval, err := time.ParseDuration(getEnv("OUTBOX_INTERVAL", "5s"))
+	if err != nil || outboxInterval <= 0 {
+		return nil, fmt.Errorf("OUTBOX_INTERVAL inválido: %q", getEnv("OUTBOX_INTERVAL", "5s"))
+	}
+
+	outboxBatchSize, err := strconv.Atoi(getEnv("OUTBOX_BATCH_SIZE", "20"))
+	if err != nil || outboxBatchSize <= 0 {
+		return nil, fmt.Errorf("OUTBOX_BATCH_SIZE inválido: %q", getEnv("OUTBOX_BATCH_SIZE", "20"))
+	}
+
+	outboxMaxIntentos, err := strconv.Atoi(getEnv("OUTBOX_MAX_INTENTOS", "8"))
+	if err != nil || outboxMaxIntentos <= 0 {
+		return nil, fmt.Errorf("OUTBOX_MAX_INTENTOS inválido: %q", getEnv("OUTBOX_MAX_INTENTOS", "8"))
+	}
+
+	outboxBackoffBase, err := time.ParseDuration(getEnv("OUTBOX_BACKOFF_BASE", "30s"))
+	if err != nil {
+		return nil, fmt.Errorf("OUTBOX_BACKOFF_BASE inválido: %w", err)
+	}
+
+	outboxBackoffMax, err := time.ParseDuration(getEnv("OUTBOX_BACKOFF_MAX", "1h"))
+	if err != nil {
+		return nil, fmt.Errorf("OUTBOX_BACKOFF_MAX inválido: %w", err)
+	}
+
+	outboxLease, err := time.ParseDuration(getEnv("OUTBOX_LEASE", "2m"))
+	if err != nil {
+		return nil, fmt.Errorf("OUTBOX_LEASE inválido: %w", err)
+	}
+
+	webhookTimeout, err := time.ParseDuration(getEnv("WEBHOOK_TIMEOUT", "10s"))
+	if err != nil {
+		return nil, fmt.Errorf("WEBHOOK_TIMEOUT inválido: %w", err)
+	}
+
+	jobsWorkers, err := strconv.Atoi(getEnv("JOBS_WORKERS", "2"))
+	if err != nil || jobsWorkers < 1 {
+		return nil, fmt.Errorf("JOBS_WORKERS inválido: %q", getEnv("JOBS_WORKERS", "2"))
+	}
+
+	jobsInterval, err := time.ParseDuration(getEnv("JOBS_INTERVAL", "2s"))
+	if err != nil {
+		return nil, fmt.Errorf("JOBS_INTERVAL inválido: %w", err)
+	}
+
+	jobsLease, err := time.ParseDuration(getEnv("JOBS_LEASE", "1m"))
+	if err != nil {
+		return nil, fmt.Errorf("JOBS_LEASE inválido: %w", err)
+	}
+
+	jobsMaxIntentos, err := strconv.Atoi(getEnv("JOBS_MAX_INTENTOS", "3"))
+	if err != nil || jobsMaxIntentos < 1 {
+		return nil, fmt.Errorf("JOBS_MAX_INTENTOS inválido: %q", getEnv("JOBS_MAX_INTENTOS", "3"))
+	}
+
+	idempotencyTTL, err := time.ParseDuration(getEnv("IDEMPOTENCY_TTL", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("IDEMPOTENCY_TTL inválido: %w", err)
+	}
+
+	aliasDeprecation, err := time.Parse(time.DateOnly, getEnv("API_ALIAS_DEPRECATION", "2026-10-19"))
+	if err != nil {
+		return nil, fmt.Errorf("API_ALIAS_DEPRECATION inválido: %w", err)
+	}
+
+	aliasSunset, err := time.Parse(time.DateOnly, getEnv("API_ALIAS_SUNSET", "2027-04-19"))
+	if err != nil {
+		return nil, fmt.Errorf("API_ALIAS_SUNSET inválido: %w", err)
+	}
+
 	return &Config{
 		Server: ServerConfig{
-			Port: getEnv("SERVER_PORT", "8080"),
+			Port: serverPort,
+		},
+		GRPC: GRPCConfig{
+			Port:       getEnv("GRPC_PORT", "9090"),
+			Reflection: getEnv("GRPC_REFLECTION", "false") == "true",
+		},
+		Auth: AuthConfig{
+			AdminToken: getEnv("ADMIN_API_TOKEN", ""),
 		},
 		Database: DatabaseConfig{
-			Host:     getEnv("DB_HOST", "localhost"),
-			Port:     getEnv("DB_PORT", "5432"),
-			User:     getEnv("DB_USER", "postgres"),
-			Password: getEnv("DB_PASSWORD", "postgres"),
-			DBName:   getEnv("DB_NAME", "aws_segundaentrega"),
-			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+			Driver:     getEnv("DB_DRIVER", DriverPostgres),
+			Host:       getEnv("DB_HOST", "localhost"),
+			Port:       getEnv("DB_PORT", "5432"),
+			User:       getEnv("DB_USER", "postgres"),
+			Password:   getEnv("DB_PASSWORD", "postgres"),
+			DBName:     getEnv("DB_NAME", "aws_segundaentrega"),
+			SSLMode:    getEnv("DB_SSLMODE", "disable"),
+			SQLitePath: getEnv("DB_SQLITE_PATH", "aws_segundaentrega.db"),
 		},
 		S3: S3Config{
 			Endpoint:     getEnv("S3_ENDPOINT", "http://localhost:9000"),
@@ -70,6 +315,7 @@ func Load() (*Config, error) {
 			BucketName:   getEnv("S3_BUCKET_NAME", "aws-segundaentrega"),
 			Region:       getEnv("S3_REGION", "us_east-1"),
 			UsePathStyle: getEnv("S3_USE_PATH_STYLE", "true") == "true",
+			PresignTTL:   presignTTL,
 		},
 		DynamoDB: DynamoDBConfig{
 			Endpoint:  getEnv("DYNAMODB_ENDPOINT", "http://localhost:8000"),
@@ -81,9 +327,80 @@ func Load() (*Config, error) {
 			TopicARN: getEnv("SNS_TOPIC_ARN", ""),
 			Region:   getEnv("SNS_REGION", "us-east-1"),
 		},
+		Notifier: NotifierConfig{
+			Driver: getEnv("NOTIFIER_DRIVER", NotifierDriverSNS),
+		},
+		Outbox: OutboxConfig{
+			Interval:    outboxInterval,
+			BatchSize:   outboxBatchSize,
+			MaxIntentos: outboxMaxIntentos,
+			BackoffBase: outboxBackoffBase,
+			BackoffMax:  outboxBackoffMax,
+			Lease:       outboxLease,
+		},
+		Webhook: WebhookConfig{
+			Timeout: webhookTimeout,
+		},
+		Idempotency: IdempotencyConfig{
+			TTL: idempotencyTTL,
+		},
+		API: APIConfig{
+			AliasDeprecation: aliasDeprecation,
+			AliasSunset:      aliasSunset,
+		},
+		OpenAPI: OpenAPIConfig{
+			ValidateResponses: getEnv("OPENAPI_VALIDATE_RESPONSES", "false") == "true",
+		},
+		Jobs: JobsConfig{
+			Workers:     jobsWorkers,
+			Interval:    jobsInterval,
+			Lease:       jobsLease,
+			MaxIntentos: jobsMaxIntentos,
+		},
+		Events: EventsConfig{
+			Sinks:       splitList(getEnv("EVENT_SINKS", EventSinkLog)),
+			SNSTopicARN: getEnv("EVENTS_SNS_TOPIC_ARN", ""),
+		},
+		SMTP: SMTPConfig{
+			Host:        getEnv("SMTP_HOST", "localhost"),
+			Port:        getEnv("SMTP_PORT", "1025"),
+			Username:    getEnv("SMTP_USERNAME", ""),
+			Password:    getEnv("SMTP_PASSWORD", ""),
+			TLS:         getEnv("SMTP_TLS", "none"),
+			From:        getEnv("SMTP_FROM", "Control Escolar <no-reply@localhost>"),
+			BroadcastTo: splitList(getEnv("SMTP_BROADCAST_TO", "")),
+		},
+		Sesion: SesionConfig{
+			Store: getEnv("SESSION_STORE", SesionStoreDynamoDB),
+			TTL:   sessionTTL,
+		},
+		Redis: RedisConfig{
+			Addr:     getEnv("REDIS_ADDR", "localhost:6379"),
+			Password: getEnv("REDIS_PASSWORD", ""),
+			DB:       redisDB,
+		},
+		Storage: StorageConfig{
+			Driver: getEnv("STORAGE_DRIVER", StorageDriverS3),
+			Local: LocalStorageConfig{
+				RootDir:    getEnv("LOCAL_STORAGE_ROOT", "./uploads"),
+				BaseURL:    getEnv("LOCAL_STORAGE_BASE_URL", "http://localhost:"+serverPort),
+				SigningKey: getEnv("LOCAL_STORAGE_SIGNING_KEY", ""),
+				URLTTL:     localURLTTL,
+			},
+		},
 	}, nil
 }
 
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
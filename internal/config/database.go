@@ -3,13 +3,50 @@ package config
 import (
 	"fmt"
 
+	"github.com/glebarez/sqlite"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
-func NewPostgresConnection(cfg DatabaseConfig) (*gorm.DB, error) {
-	dsn := fmt.Sprintf(
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// NewDatabaseConnection abre la conexión GORM según el driver configurado (DB_DRIVER)
+func NewDatabaseConnection(cfg DatabaseConfig) (*gorm.DB, error) {
+	var dialector gorm.Dialector
+	switch cfg.Driver {
+	case DriverPostgres, "":
+		dialector = postgres.Open(postgresDSN(cfg))
+	case DriverSQLite:
+		dialector = sqlite.Open(sqliteDSN(cfg))
+	default:
+		return nil, fmt.Errorf("driver de base de datos no soportado: %s", cfg.Driver)
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error al conectar a la base de datos (%s): %w", cfg.Driver, err)
+	}
+
+	if cfg.Driver == DriverSQLite {
+		// SQLite solo admite un escritor a la vez
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		sqlDB.SetMaxOpenConns(1)
+	}
+
+	return db, nil
+}
+
+func postgresDSN(cfg DatabaseConfig) string {
+	return fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		cfg.Host,
 		cfg.Port,
@@ -18,13 +55,8 @@ func NewPostgresConnection(cfg DatabaseConfig) (*gorm.DB, error) {
 		cfg.DBName,
 		cfg.SSLMode,
 	)
+}
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("error al conectar a PostgreSQL: %w", err)
-	}
-
-	return db, nil
+func sqliteDSN(cfg DatabaseConfig) string {
+	return cfg.SQLitePath + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
 }
@@ -0,0 +1,22 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func NewRedisClient(cfg RedisConfig) (*redis.Client, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Addr,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+	})
+
+	if err := client.Ping(context.TODO()).Err(); err != nil {
+		return nil, fmt.Errorf("error al conectar a Redis: %w", err)
+	}
+
+	return client, nil
+}
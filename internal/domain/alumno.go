@@ -3,13 +3,24 @@ package domain
 import "time"
 
 type Alumno struct {
-	ID            uint      `json:"id" gorm:"primaryKey"`
-	Nombres       string    `json:"nombres" gorm:"not null"`
-	Apellidos     string    `json:"apellidos" gorm:"not null"`
-	Matricula     string    `json:"matricula" gorm:"not null;unique"`
-	Promedio      float64   `json:"promedio" gorm:"not null"`
-	FotoPerfilUrl string    `json:"fotoPerfilUrl,omitempty"`
-	Password      string    `json:"-" gorm:"not null"`
-	CreatedAt     time.Time `json:"-"`
-	UpdatedAt     time.Time `json:"-"`
+	ID                  uint              `json:"id" gorm:"primaryKey"`
+	Nombres             string            `json:"nombres" gorm:"not null"`
+	Apellidos           string            `json:"apellidos" gorm:"not null"`
+	Matricula           string            `json:"matricula" gorm:"not null;unique"`
+	Promedio            float64           `json:"promedio" gorm:"not null"`
+	Email               string            `json:"email,omitempty"`
+	Telefono            string            `json:"telefono,omitempty"`
+	Idioma              string            `json:"idioma,omitempty" gorm:"default:es"`     // Idioma de las notificaciones
+	FotoPerfilKey       string            `json:"-"`                                      // Llave de la variante "original"
+	FotoPerfilUrl       string            `json:"fotoPerfilUrl,omitempty" gorm:"-"`       // URL temporal generada al leer
+	FotoPerfilKeys      map[string]string `json:"-" gorm:"serializer:json"`               // Llaves por variante (original, 64, 256, 1024)
+	FotoPerfilVariantes map[string]string `json:"fotoPerfilVariantes,omitempty" gorm:"-"` // URLs temporales por variante
+	Password            string            `json:"-" gorm:"not null"`
+	CreatedAt           time.Time         `json:"-"`
+	UpdatedAt           time.Time         `json:"-"`
+}
+
+// Destinatario devuelve los datos de contacto del alumno para notificaciones
+func (a *Alumno) Destinatario() Destinatario {
+	return Destinatario{Email: a.Email, Telefono: a.Telefono, Idioma: a.Idioma}
 }
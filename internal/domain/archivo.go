@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// PresignedUpload - Instrucciones para que el cliente suba un archivo directamente al almacenamiento
+type PresignedUpload struct {
+	Key       string            `json:"key"`
+	URL       string            `json:"uploadUrl"`
+	Method    string            `json:"method"`
+	Headers   map[string]string `json:"headers"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+}
+
+// StoredObject - Objeto existente en el almacenamiento de archivos
+type StoredObject struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
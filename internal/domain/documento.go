@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Estados de revisión de un documento
+const (
+	DocumentoPendiente = "pendiente"
+	DocumentoAprobado  = "aprobado"
+	DocumentoRechazado = "rechazado"
+)
+
+type Documento struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	AlumnoID      uint       `json:"alumnoId" gorm:"not null;index"`
+	Tipo          string     `json:"tipo" gorm:"not null"`
+	Estado        string     `json:"estado" gorm:"not null;default:pendiente"`
+	NombreArchivo string     `json:"nombreArchivo" gorm:"not null"`
+	ContentType   string     `json:"contentType" gorm:"not null"`
+	Size          int64      `json:"size" gorm:"not null"`
+	Checksum      string     `json:"checksum" gorm:"not null"` // SHA-256 en hex
+	Key           string     `json:"-" gorm:"not null"`
+	SubidoPor     string     `json:"subidoPor" gorm:"not null"`
+	RevisadoPor   string     `json:"revisadoPor,omitempty"`
+	Comentario    string     `json:"comentario,omitempty"`
+	RevisadoEn    *time.Time `json:"revisadoEn,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"-"`
+}
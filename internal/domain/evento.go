@@ -0,0 +1,122 @@
+package domain
+
+import "time"
+
+// Nombres de los eventos de dominio; los usan los webhooks y los sinks del bus
+const (
+	EventoAlumnoCreado          = "alumno.creado"
+	EventoAlumnoActualizado     = "alumno.actualizado"
+	EventoAlumnoEliminado       = "alumno.eliminado"
+	EventoFotoPerfilActualizada = "alumno.foto_perfil_actualizada"
+	EventoProfesorCreado        = "profesor.creado"
+	EventoProfesorActualizado   = "profesor.actualizado"
+	EventoProfesorEliminado     = "profesor.eliminado"
+	EventoSesionIniciada        = "sesion.iniciada"
+	EventoSesionFinalizada      = "sesion.finalizada"
+)
+
+var Eventos = []string{
+	EventoAlumnoCreado,
+	EventoAlumnoActualizado,
+	EventoAlumnoEliminado,
+	EventoFotoPerfilActualizada,
+	EventoProfesorCreado,
+	EventoProfesorActualizado,
+	EventoProfesorEliminado,
+	EventoSesionIniciada,
+	EventoSesionFinalizada,
+}
+
+// Evento - Hecho de dominio que los casos de uso publican en el bus
+type Evento interface {
+	Nombre() string
+}
+
+// EventoPublicado - Evento con los metadatos que agrega el bus al publicarlo
+type EventoPublicado struct {
+	ID     string    `json:"id"`
+	Nombre string    `json:"evento"`
+	Fecha  time.Time `json:"fecha"`
+	Evento Evento    `json:"data"`
+}
+
+type AlumnoCreated struct {
+	Alumno Alumno `json:"alumno"`
+}
+
+func (AlumnoCreated) Nombre() string { return EventoAlumnoCreado }
+
+type AlumnoUpdated struct {
+	Alumno   Alumno `json:"alumno"`
+	Anterior Alumno `json:"anterior"`
+}
+
+func (AlumnoUpdated) Nombre() string { return EventoAlumnoActualizado }
+
+type AlumnoDeleted struct {
+	Alumno Alumno `json:"alumno"`
+}
+
+func (AlumnoDeleted) Nombre() string { return EventoAlumnoEliminado }
+
+type FotoPerfilUpdated struct {
+	Alumno Alumno `json:"alumno"`
+}
+
+func (FotoPerfilUpdated) Nombre() string { return EventoFotoPerfilActualizada }
+
+type ProfesorCreated struct {
+	Profesor Profesor `json:"profesor"`
+}
+
+func (ProfesorCreated) Nombre() string { return EventoProfesorCreado }
+
+type ProfesorUpdated struct {
+	Profesor Profesor `json:"profesor"`
+	Anterior Profesor `json:"anterior"`
+}
+
+func (ProfesorUpdated) Nombre() string { return EventoProfesorActualizado }
+
+type ProfesorDeleted struct {
+	Profesor Profesor `json:"profesor"`
+}
+
+func (ProfesorDeleted) Nombre() string { return EventoProfesorEliminado }
+
+// SesionStarted no incluye el sessionString: es la credencial del alumno
+type SesionStarted struct {
+	SesionID string `json:"sesionId"`
+	AlumnoID uint   `json:"alumnoId"`
+}
+
+func (SesionStarted) Nombre() string { return EventoSesionIniciada }
+
+type SesionEnded struct {
+	SesionID string `json:"sesionId"`
+	AlumnoID uint   `json:"alumnoId"`
+}
+
+func (SesionEnded) Nombre() string { return EventoSesionFinalizada }
+
+// EventoSalida - Evento pendiente de entregar a un sink externo del bus (SNS,
+// log, ...). Se guarda en la transacción del cambio y se reintenta igual que
+// una entrega de webhook; usa los mismos estados
+type EventoSalida struct {
+	ID               uint       `json:"id" gorm:"primaryKey"`
+	Sink             string     `json:"sink" gorm:"not null"`
+	EventoID         string     `json:"eventoId" gorm:"not null"`
+	Evento           string     `json:"evento" gorm:"not null"`
+	Payload          string     `json:"payload" gorm:"type:text;not null"` // EventoPublicado en JSON
+	Estado           string     `json:"estado" gorm:"not null;default:pendiente;index:idx_evento_salida_despacho,priority:1"`
+	Intentos         int        `json:"intentos" gorm:"not null;default:0"`
+	SiguienteIntento time.Time  `json:"siguienteIntento" gorm:"not null;index:idx_evento_salida_despacho,priority:2"`
+	UltimoError      string     `json:"ultimoError,omitempty" gorm:"type:text"`
+	EnviadaEn        *time.Time `json:"enviadaEn,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+func (EventoSalida) TableName() string {
+	return "eventos_salida"
+}
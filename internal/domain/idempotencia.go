@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// Estados de una clave de idempotencia
+const (
+	IdempotenciaEnProceso  = "en_proceso" // la petición original aún no responde
+	IdempotenciaCompletada = "completada"
+)
+
+// RegistroIdempotencia - Respuesta guardada para un Idempotency-Key. Un reintento
+// con la misma clave y la misma petición recibe esta respuesta sin ejecutarse
+type RegistroIdempotencia struct {
+	Clave     string              `gorm:"primaryKey"` // SHA-256 del cliente, la ruta y el Idempotency-Key
+	Hash      string              `gorm:"not null"`   // SHA-256 de método, ruta y cuerpo; vacío mientras está en proceso
+	Estado    string              `gorm:"not null"`
+	Status    int                 `gorm:"not null;default:0"`
+	Headers   map[string][]string `gorm:"serializer:json"`
+	Cuerpo    []byte
+	ExpiraEn  time.Time `gorm:"not null;index"`
+	CreatedAt time.Time
+}
+
+func (RegistroIdempotencia) TableName() string {
+	return "idempotencia"
+}
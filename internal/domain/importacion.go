@@ -0,0 +1,42 @@
+package domain
+
+import apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+
+// Acciones de una fila importada
+const (
+	ImportacionCrear      = "crear"
+	ImportacionActualizar = "actualizar"
+)
+
+// ResultadoImportacion - Reporte por fila de una importación masiva. Si alguna
+// fila tiene errores no se guarda ninguna
+type ResultadoImportacion struct {
+	DryRun       bool            `json:"dryRun"`
+	Aplicado     bool            `json:"aplicado"`
+	Total        int             `json:"total"`
+	Creados      int             `json:"creados"`
+	Actualizados int             `json:"actualizados"`
+	ConErrores   int             `json:"conErrores"`
+	Filas        []FilaImportada `json:"filas"`
+}
+
+type FilaImportada struct {
+	Fila    int                         `json:"fila"`  // número de fila en el archivo; el encabezado es la 1
+	Clave   string                      `json:"clave"` // matrícula o número de empleado
+	Accion  string                      `json:"accion,omitempty"`
+	Errores []apperrors.ValidationError `json:"errores,omitempty"`
+}
+
+// Add agrega la fila al reporte y actualiza los contadores
+func (r *ResultadoImportacion) Add(fila FilaImportada) {
+	r.Total++
+	switch {
+	case len(fila.Errores) > 0:
+		r.ConErrores++
+	case fila.Accion == ImportacionCrear:
+		r.Creados++
+	case fila.Accion == ImportacionActualizar:
+		r.Actualizados++
+	}
+	r.Filas = append(r.Filas, fila)
+}
@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+// Tipos de job
+const (
+	JobImportarAlumnos    = "alumnos.importar"
+	JobImportarProfesores = "profesores.importar"
+	JobExportarAlumnos    = "alumnos.exportar"
+	JobExportarProfesores = "profesores.exportar"
+)
+
+// Estados de un job
+const (
+	JobPendiente  = "pendiente"
+	JobEnProceso  = "en_proceso"
+	JobCompletado = "completado"
+	JobFallido    = "fallido"
+	JobCancelado  = "cancelado"
+)
+
+// Job - Tarea larga (importación, exportación) que ejecutan los workers fuera de
+// la petición HTTP. Los archivos de entrada y de resultado viven en FileStorage.
+type Job struct {
+	ID                    uint       `json:"id" gorm:"primaryKey"`
+	Tipo                  string     `json:"tipo" gorm:"not null"`
+	Estado                string     `json:"estado" gorm:"not null;default:pendiente;index:idx_job_cola,priority:1"`
+	Formato               string     `json:"formato" gorm:"not null"`
+	DryRun                bool       `json:"dryRun,omitempty"`
+	EntradaKey            string     `json:"-"`
+	ResultadoKey          string     `json:"-"`
+	ResultadoURL          string     `json:"resultadoUrl,omitempty" gorm:"-"` // URL temporal generada al leer
+	Total                 int        `json:"total"`                           // 0 mientras no se conoce
+	Procesados            int        `json:"procesados"`
+	Progreso              int        `json:"progreso" gorm:"-"` // porcentaje; -1 si aún no se conoce el total
+	Intentos              int        `json:"intentos" gorm:"not null;default:0"`
+	CancelacionSolicitada bool       `json:"cancelacionSolicitada,omitempty"`
+	Error                 string     `json:"error,omitempty" gorm:"type:text"`
+	ReservadoHasta        time.Time  `json:"-" gorm:"not null;index:idx_job_cola,priority:2"` // vence si el worker deja de reportar
+	IniciadoEn            *time.Time `json:"iniciadoEn,omitempty"`
+	TerminadoEn           *time.Time `json:"terminadoEn,omitempty"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	UpdatedAt             time.Time  `json:"updatedAt"`
+}
+
+func (Job) TableName() string {
+	return "jobs"
+}
+
+// CalcularProgreso llena Progreso a partir de Procesados y Total
+func (j *Job) CalcularProgreso() {
+	switch {
+	case j.Estado == JobCompletado:
+		j.Progreso = 100
+	case j.Total == 0:
+		j.Progreso = -1
+	default:
+		j.Progreso = min(j.Procesados*100/j.Total, 100)
+	}
+}
+
+// Terminado indica si el job ya no cambiará de estado
+func (j *Job) Terminado() bool {
+	return j.Estado == JobCompletado || j.Estado == JobFallido || j.Estado == JobCancelado
+}
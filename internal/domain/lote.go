@@ -0,0 +1,53 @@
+package domain
+
+import apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+
+// Operaciones de un lote
+const (
+	OperacionCrear      = "crear"
+	OperacionActualizar = "actualizar"
+	OperacionEliminar   = "eliminar"
+)
+
+// Modos de un lote
+const (
+	LoteAtomico = "atomico" // la primera operación que falla revierte todo el lote
+	LoteParcial = "parcial" // cada operación se aplica o falla por separado
+)
+
+// Estado de cada operación en el resultado del lote
+const (
+	OperacionAplicada  = "aplicada"
+	OperacionFallida   = "fallida"
+	OperacionRevertida = "revertida" // se ejecutó pero otra operación falló en modo atómico
+	OperacionOmitida   = "omitida"   // no se ejecutó porque una anterior falló en modo atómico
+)
+
+// OperacionLote - Una operación sobre T; ID aplica a actualizar y eliminar,
+// Datos a crear y actualizar
+type OperacionLote[T any] struct {
+	Op    string
+	ID    uint
+	Datos *T
+}
+
+// ResultadoLote - Reporte por operación de un lote ejecutado en una transacción
+type ResultadoLote struct {
+	Modo       string               `json:"modo"`
+	Aplicado   bool                 `json:"aplicado"` // false si el lote atómico se revirtió
+	Exitosas   int                  `json:"exitosas"`
+	Fallidas   int                  `json:"fallidas"`
+	Resultados []ResultadoOperacion `json:"resultados"`
+}
+
+type ResultadoOperacion struct {
+	Indice  int                         `json:"indice"`
+	Op      string                      `json:"op"`
+	ID      uint                        `json:"id,omitempty"`
+	Estado  string                      `json:"estado"`
+	Status  int                         `json:"status,omitempty"` // código HTTP equivalente, lo asigna el handler
+	Code    string                      `json:"code,omitempty"`
+	Error   string                      `json:"error,omitempty"`
+	Errores []apperrors.ValidationError `json:"errores,omitempty"`
+	Err     error                       `json:"-"`
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// IdiomaPorDefecto - Idioma usado cuando no hay plantilla para el solicitado
+const IdiomaPorDefecto = "es"
+
+// Destinatario - A quién va dirigida una notificación individual
+type Destinatario struct {
+	Email    string
+	Telefono string // E.164, opcional
+	Idioma   string
+}
+
+// PorCanal separa el destinatario en uno por canal (email y SMS), para que
+// cada entrega se reintente por su lado
+func (d Destinatario) PorCanal() []Destinatario {
+	var canales []Destinatario
+	if d.Email != "" {
+		canales = append(canales, Destinatario{Email: d.Email, Idioma: d.Idioma})
+	}
+	if d.Telefono != "" {
+		canales = append(canales, Destinatario{Telefono: d.Telefono, Idioma: d.Idioma})
+	}
+	return canales
+}
+
+// Mensaje - Notificación ya renderizada; los canales que no soportan HTML usan Texto
+type Mensaje struct {
+	Asunto string `json:"asunto"`
+	Texto  string `json:"texto"`
+	HTML   string `json:"html,omitempty"`
+}
+
+// Tipos de notificación en la bandeja de salida
+const (
+	NotificacionEnvio       = "envio"       // Send al destinatario
+	NotificacionAnuncio     = "anuncio"     // Publish a todos los suscriptores
+	NotificacionSuscripcion = "suscripcion" // Subscribe del destinatario
+)
+
+// Estados de una notificación en la bandeja de salida
+const (
+	NotificacionPendiente = "pendiente"
+	NotificacionEnviada   = "enviada"
+	NotificacionFallida   = "fallida" // agotó los reintentos; solo se reenvía manualmente
+	NotificacionOmitida   = "omitida" // el notificador no tiene canal para el destinatario
+)
+
+// ErrCanalNoSoportado - El notificador no puede llegar al destinatario (p. ej.
+// un SMS con SMTP). Reintentar no cambia nada: la notificación queda omitida
+var ErrCanalNoSoportado = errors.New("el notificador no soporta el canal del destinatario")
+
+// Notificacion - Registro de la bandeja de salida (outbox). Se guarda en la misma
+// transacción que el cambio que la origina y la entrega un despachador en segundo plano
+type Notificacion struct {
+	ID               uint       `json:"id" gorm:"primaryKey"`
+	Tipo             string     `json:"tipo" gorm:"not null"`
+	Email            string     `json:"email,omitempty"`
+	Telefono         string     `json:"telefono,omitempty"`
+	Idioma           string     `json:"idioma,omitempty"`
+	Asunto           string     `json:"asunto,omitempty"`
+	Texto            string     `json:"texto,omitempty" gorm:"type:text"`
+	HTML             string     `json:"html,omitempty" gorm:"type:text"`
+	Estado           string     `json:"estado" gorm:"not null;default:pendiente;index:idx_notificacion_despacho,priority:1"`
+	Intentos         int        `json:"intentos" gorm:"not null;default:0"`
+	SiguienteIntento time.Time  `json:"siguienteIntento" gorm:"not null;index:idx_notificacion_despacho,priority:2"`
+	UltimoError      string     `json:"ultimoError,omitempty" gorm:"type:text"`
+	EnviadaEn        *time.Time `json:"enviadaEn,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+func (Notificacion) TableName() string {
+	return "notificaciones"
+}
+
+func (n *Notificacion) Destinatario() Destinatario {
+	return Destinatario{
+		Email:    n.Email,
+		Telefono: n.Telefono,
+		Idioma:   n.Idioma,
+	}
+}
+
+func (n *Notificacion) Mensaje() Mensaje {
+	return Mensaje{
+		Asunto: n.Asunto,
+		Texto:  n.Texto,
+		HTML:   n.HTML,
+	}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// Claves de las plantillas que generan las notificaciones del sistema
+const (
+	PlantillaCalificaciones = "calificaciones"
+)
+
+// PlantillaNotificacion - Plantilla editable; tiene prioridad sobre la incluida
+// en el binario para la misma clave e idioma
+type PlantillaNotificacion struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	Clave      string    `json:"clave" gorm:"not null;uniqueIndex:idx_plantilla_clave_idioma"`
+	Idioma     string    `json:"idioma" gorm:"not null;uniqueIndex:idx_plantilla_clave_idioma"`
+	Asunto     string    `json:"asunto" gorm:"not null"`
+	Cuerpo     string    `json:"cuerpo" gorm:"type:text;not null"`
+	CuerpoHTML string    `json:"cuerpoHtml,omitempty" gorm:"type:text"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+func (PlantillaNotificacion) TableName() string {
+	return "plantillas_notificacion"
+}
+
+// DatosCalificaciones - Lo único que ve la plantilla de calificaciones; las
+// plantillas son editables, así que nunca reciben el Alumno completo (con el
+// hash de la contraseña y demás campos internos)
+type DatosCalificaciones struct {
+	Nombres   string
+	Apellidos string
+	Matricula string
+	Promedio  float64
+	Email     string
+	Idioma    string
+}
+
+func NuevosDatosCalificaciones(a *Alumno) DatosCalificaciones {
+	return DatosCalificaciones{
+		Nombres:   a.Nombres,
+		Apellidos: a.Apellidos,
+		Matricula: a.Matricula,
+		Promedio:  a.Promedio,
+		Email:     a.Email,
+		Idioma:    a.Idioma,
+	}
+}
@@ -1,9 +1,13 @@
 package domain
 
 type Sesion struct {
-	ID            string `json:"id" dynamodbav:"id"`       // UUID
-	Fecha         int64  `json:"fecha" dynamodbav:"fecha"` // UNIX TIMESTAMP
-	AlumnoID      uint   `json:"alumnoId" dynamodbav:"alumnoId"`
-	Active        bool   `json:"active" dynamodbav:"active"`
-	SessionString string `json:"sessionString" dynamodbav:"sessionString"` // 128 CARACTERES ALEATORIOS
+	ID            string `json:"id" dynamodbav:"id" gorm:"primaryKey;size:36"` // UUID
+	Fecha         int64  `json:"fecha" dynamodbav:"fecha" gorm:"not null"`     // UNIX TIMESTAMP
+	AlumnoID      uint   `json:"alumnoId" dynamodbav:"alumnoId" gorm:"not null;index"`
+	Active        bool   `json:"active" dynamodbav:"active" gorm:"not null"`
+	SessionString string `json:"sessionString" dynamodbav:"sessionString" gorm:"not null;uniqueIndex;size:128"` // 128 CARACTERES ALEATORIOS
+}
+
+func (Sesion) TableName() string {
+	return "sesiones"
 }
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// EventoTodos - Suscribe el webhook a todos los eventos
+const EventoTodos = "*"
+
+// Estados de una entrega de webhook o de un EventoSalida
+const (
+	EntregaPendiente = "pendiente"
+	EntregaEnviada   = "enviada"
+	EntregaFallida   = "fallida" // agotó los reintentos; solo se reenvía manualmente
+)
+
+// WebhookSuscripcion - Endpoint externo que recibe los eventos indicados,
+// firmados con HMAC-SHA256 usando Secret
+type WebhookSuscripcion struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	URL       string    `json:"url" gorm:"not null"`
+	Secret    string    `json:"secret,omitempty" gorm:"not null"` // solo se devuelve al crearla
+	Eventos   []string  `json:"eventos" gorm:"not null;serializer:json"`
+	Activa    *bool     `json:"activa" gorm:"not null;default:true"` // nil en una actualización: sin cambios
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (WebhookSuscripcion) TableName() string {
+	return "webhook_suscripciones"
+}
+
+// EstaActiva indica si la suscripción recibe entregas
+func (s *WebhookSuscripcion) EstaActiva() bool {
+	return s.Activa != nil && *s.Activa
+}
+
+// Acepta indica si la suscripción está activa y escucha evento
+func (s *WebhookSuscripcion) Acepta(evento string) bool {
+	return s.EstaActiva() && (slices.Contains(s.Eventos, EventoTodos) || slices.Contains(s.Eventos, evento))
+}
+
+// WebhookEntrega - Envío de un evento a una suscripción; funciona como cola y
+// como bitácora de entregas
+type WebhookEntrega struct {
+	ID               uint       `json:"id" gorm:"primaryKey"`
+	SuscripcionID    uint       `json:"suscripcionId" gorm:"not null;index"`
+	EventoID         string     `json:"eventoId" gorm:"not null"` // igual en todos los reintentos, para deduplicar
+	Evento           string     `json:"evento" gorm:"not null"`
+	Payload          string     `json:"payload" gorm:"type:text;not null"`
+	Estado           string     `json:"estado" gorm:"not null;default:pendiente;index:idx_webhook_entrega_despacho,priority:1"`
+	Intentos         int        `json:"intentos" gorm:"not null;default:0"`
+	SiguienteIntento time.Time  `json:"siguienteIntento" gorm:"not null;index:idx_webhook_entrega_despacho,priority:2"`
+	UltimoStatus     int        `json:"ultimoStatus,omitempty"`
+	UltimoError      string     `json:"ultimoError,omitempty" gorm:"type:text"`
+	EnviadaEn        *time.Time `json:"enviadaEn,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+func (WebhookEntrega) TableName() string {
+	return "webhook_entregas"
+}
@@ -10,7 +10,13 @@ type AlumnoHandler interface {
 	Update(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request)
 	UploadFotoPerfil(w http.ResponseWriter, r *http.Request)
+	CreateFotoPerfilUploadURL(w http.ResponseWriter, r *http.Request)
+	ConfirmFotoPerfil(w http.ResponseWriter, r *http.Request)
+	DeleteFotoPerfil(w http.ResponseWriter, r *http.Request)
 	SendEmail(w http.ResponseWriter, r *http.Request)
+	Import(w http.ResponseWriter, r *http.Request)
+	Export(w http.ResponseWriter, r *http.Request)
+	Batch(w http.ResponseWriter, r *http.Request)
 }
 
 // ProfesorHandler - Endpoints HTTP para Profesor
@@ -20,6 +26,50 @@ type ProfesorHandler interface {
 	Create(w http.ResponseWriter, r *http.Request)
 	Update(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request)
+	Import(w http.ResponseWriter, r *http.Request)
+	Export(w http.ResponseWriter, r *http.Request)
+	Batch(w http.ResponseWriter, r *http.Request)
+}
+
+// DocumentoHandler - Endpoints HTTP para los documentos del alumno
+type DocumentoHandler interface {
+	GetAll(w http.ResponseWriter, r *http.Request)
+	GetByID(w http.ResponseWriter, r *http.Request)
+	Upload(w http.ResponseWriter, r *http.Request)
+	UploadStream(w http.ResponseWriter, r *http.Request)
+	Download(w http.ResponseWriter, r *http.Request)
+	Review(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+}
+
+// PlantillaHandler - Endpoints HTTP de administración de plantillas
+type PlantillaHandler interface {
+	GetAll(w http.ResponseWriter, r *http.Request)
+	GetByID(w http.ResponseWriter, r *http.Request)
+	Create(w http.ResponseWriter, r *http.Request)
+	Update(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	Preview(w http.ResponseWriter, r *http.Request)
+	PreviewByID(w http.ResponseWriter, r *http.Request)
+}
+
+// NotificacionHandler - Endpoints HTTP de la bandeja de salida de notificaciones
+type NotificacionHandler interface {
+	GetAll(w http.ResponseWriter, r *http.Request)
+	GetByID(w http.ResponseWriter, r *http.Request)
+	Replay(w http.ResponseWriter, r *http.Request)
+	ReplayFallidas(w http.ResponseWriter, r *http.Request)
+}
+
+// WebhookHandler - Endpoints HTTP de administración de webhooks
+type WebhookHandler interface {
+	GetAll(w http.ResponseWriter, r *http.Request)
+	GetByID(w http.ResponseWriter, r *http.Request)
+	Create(w http.ResponseWriter, r *http.Request)
+	Update(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	GetEntregas(w http.ResponseWriter, r *http.Request)
+	ReplayEntrega(w http.ResponseWriter, r *http.Request)
 }
 
 // SessionHandler - Endpoints HTTP para Sesiones
@@ -28,3 +78,17 @@ type SesionHandler interface {
 	Verify(w http.ResponseWriter, r *http.Request)
 	Logout(w http.ResponseWriter, r *http.Request)
 }
+
+// FileHandler - Descarga de archivos locales con URL firmada
+type FileHandler interface {
+	Download(w http.ResponseWriter, r *http.Request)
+	Upload(w http.ResponseWriter, r *http.Request)
+}
+
+// JobHandler - Endpoints HTTP de jobs en segundo plano
+type JobHandler interface {
+	CreateImport(w http.ResponseWriter, r *http.Request)
+	CreateExport(w http.ResponseWriter, r *http.Request)
+	GetByID(w http.ResponseWriter, r *http.Request)
+	Cancel(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,160 @@
+// Package porttest contiene suites de conformidad para las implementaciones de
+// los puertos. Cada adaptador (DynamoDB, PostgreSQL, Redis, ...) debe pasarlas.
+package porttest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/google/uuid"
+)
+
+// TestSesionRepository ejecuta la suite de conformidad de port.SesionRepository
+// contra repo y devuelve todos los fallos encontrados (nil si pasa).
+// Al estilo de testing/fstest, se puede llamar desde un test o desde una
+// herramienta de diagnóstico contra un almacén real.
+func TestSesionRepository(ctx context.Context, repo port.SesionRepository) error {
+	var errs []error
+	check := func(name string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	check("GetBySessionString inexistente", testGetMissing(ctx, repo))
+	check("Create y GetBySessionString", testCreateAndGet(ctx, repo))
+	check("Deactivate", testDeactivate(ctx, repo))
+	check("Deactivate inexistente", testDeactivateMissing(ctx, repo))
+	check("Sesiones independientes", testIndependentSessions(ctx, repo))
+
+	return errors.Join(errs...)
+}
+
+func newSesion(alumnoID uint) (*domain.Sesion, error) {
+	sessionString, err := utils.GenerateSessionString()
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Sesion{
+		ID:            uuid.New().String(),
+		Fecha:         time.Now().Unix(),
+		AlumnoID:      alumnoID,
+		Active:        true,
+		SessionString: sessionString,
+	}, nil
+}
+
+func testGetMissing(ctx context.Context, repo port.SesionRepository) error {
+	sessionString, err := utils.GenerateSessionString()
+	if err != nil {
+		return err
+	}
+
+	sesion, err := repo.GetBySessionString(ctx, sessionString)
+	if err != nil {
+		return fmt.Errorf("se esperaba (nil, nil), error: %w", err)
+	}
+	if sesion != nil {
+		return fmt.Errorf("se esperaba nil, se obtuvo %+v", *sesion)
+	}
+	return nil
+}
+
+func testCreateAndGet(ctx context.Context, repo port.SesionRepository) error {
+	want, err := newSesion(1)
+	if err != nil {
+		return err
+	}
+	if err := repo.Create(ctx, want); err != nil {
+		return fmt.Errorf("Create: %w", err)
+	}
+
+	got, err := repo.GetBySessionString(ctx, want.SessionString)
+	if err != nil {
+		return fmt.Errorf("GetBySessionString: %w", err)
+	}
+	if got == nil {
+		return fmt.Errorf("la sesión creada no se encontró")
+	}
+	if *got != *want {
+		return fmt.Errorf("se esperaba %+v, se obtuvo %+v", *want, *got)
+	}
+	return nil
+}
+
+func testDeactivate(ctx context.Context, repo port.SesionRepository) error {
+	sesion, err := newSesion(2)
+	if err != nil {
+		return err
+	}
+	if err := repo.Create(ctx, sesion); err != nil {
+		return fmt.Errorf("Create: %w", err)
+	}
+
+	if err := repo.Deactivate(ctx, sesion.SessionString); err != nil {
+		return fmt.Errorf("Deactivate: %w", err)
+	}
+
+	got, err := repo.GetBySessionString(ctx, sesion.SessionString)
+	if err != nil {
+		return fmt.Errorf("GetBySessionString: %w", err)
+	}
+	if got == nil {
+		return fmt.Errorf("la sesión desactivada ya no existe")
+	}
+	if got.Active {
+		return fmt.Errorf("la sesión sigue activa")
+	}
+	if got.ID != sesion.ID || got.AlumnoID != sesion.AlumnoID {
+		return fmt.Errorf("Deactivate modificó otros campos: %+v", *got)
+	}
+	return nil
+}
+
+func testDeactivateMissing(ctx context.Context, repo port.SesionRepository) error {
+	sessionString, err := utils.GenerateSessionString()
+	if err != nil {
+		return err
+	}
+
+	err = repo.Deactivate(ctx, sessionString)
+	if !errors.Is(err, apperrors.ErrNotFound) {
+		return fmt.Errorf("se esperaba ErrNotFound, se obtuvo %v", err)
+	}
+	return nil
+}
+
+func testIndependentSessions(ctx context.Context, repo port.SesionRepository) error {
+	first, err := newSesion(3)
+	if err != nil {
+		return err
+	}
+	second, err := newSesion(3)
+	if err != nil {
+		return err
+	}
+	for _, sesion := range []*domain.Sesion{first, second} {
+		if err := repo.Create(ctx, sesion); err != nil {
+			return fmt.Errorf("Create: %w", err)
+		}
+	}
+
+	if err := repo.Deactivate(ctx, first.SessionString); err != nil {
+		return fmt.Errorf("Deactivate: %w", err)
+	}
+
+	got, err := repo.GetBySessionString(ctx, second.SessionString)
+	if err != nil {
+		return fmt.Errorf("GetBySessionString: %w", err)
+	}
+	if got == nil || !got.Active {
+		return fmt.Errorf("desactivar una sesión afectó a otra del mismo alumno")
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package port
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
 )
@@ -11,6 +12,9 @@ import (
 type AlumnoRepository interface {
 	GetAll(ctx context.Context) ([]domain.Alumno, error)
 	GetByID(ctx context.Context, id uint) (*domain.Alumno, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]domain.Alumno, error) // sin orden garantizado; omite los que no existen
+	GetByMatriculas(ctx context.Context, matriculas []string) ([]domain.Alumno, error)
+	Each(ctx context.Context, fn func(*domain.Alumno) error) error // recorre la tabla por lotes
 	Create(ctx context.Context, alumno *domain.Alumno) error
 	Update(ctx context.Context, alumno *domain.Alumno) error
 	Delete(ctx context.Context, id uint) error
@@ -20,11 +24,104 @@ type AlumnoRepository interface {
 type ProfesorRepository interface {
 	GetAll(ctx context.Context) ([]domain.Profesor, error)
 	GetByID(ctx context.Context, id uint) (*domain.Profesor, error)
+	GetByNumerosEmpleado(ctx context.Context, numeros []int) ([]domain.Profesor, error)
+	Each(ctx context.Context, fn func(*domain.Profesor) error) error // recorre la tabla por lotes
 	Create(ctx context.Context, profesor *domain.Profesor) error
 	Update(ctx context.Context, profesor *domain.Profesor) error
 	Delete(ctx context.Context, id uint) error
 }
 
+// DocumentoRepository - Operaciones de persistencia para Documento
+type DocumentoRepository interface {
+	GetByAlumno(ctx context.Context, alumnoID uint) ([]domain.Documento, error)
+	GetByAlumnos(ctx context.Context, alumnoIDs []uint) ([]domain.Documento, error)
+	GetByID(ctx context.Context, id uint) (*domain.Documento, error)
+	Create(ctx context.Context, documento *domain.Documento) error
+	Update(ctx context.Context, documento *domain.Documento) error
+	Delete(ctx context.Context, id uint) error
+	// DeleteByAlumno borra los registros de todos los documentos del alumno
+	DeleteByAlumno(ctx context.Context, alumnoID uint) error
+}
+
+// PlantillaRepository - Plantillas de notificación editadas desde la API
+type PlantillaRepository interface {
+	GetAll(ctx context.Context) ([]domain.PlantillaNotificacion, error)
+	GetByID(ctx context.Context, id uint) (*domain.PlantillaNotificacion, error)
+	GetByClave(ctx context.Context, clave string, idioma string) (*domain.PlantillaNotificacion, error)
+	Create(ctx context.Context, plantilla *domain.PlantillaNotificacion) error
+	Update(ctx context.Context, plantilla *domain.PlantillaNotificacion) error
+	Delete(ctx context.Context, id uint) error
+}
+
+// NotificacionRepository - Bandeja de salida de notificaciones
+type NotificacionRepository interface {
+	// GetAll devuelve las más recientes primero; estado vacío las incluye todas
+	GetAll(ctx context.Context, estado string, limit int) ([]domain.Notificacion, error)
+	GetByID(ctx context.Context, id uint) (*domain.Notificacion, error)
+	Create(ctx context.Context, notificacion *domain.Notificacion) error
+	Update(ctx context.Context, notificacion *domain.Notificacion) error
+	// ClaimPendientes reserva hasta limit notificaciones pendientes y vencidas moviendo
+	// su siguiente intento a ahora+lease, para que otro despachador no las tome
+	ClaimPendientes(ctx context.Context, limit int, lease time.Duration) ([]domain.Notificacion, error)
+}
+
+// WebhookRepository - Suscripciones de webhooks
+type WebhookRepository interface {
+	GetAll(ctx context.Context) ([]domain.WebhookSuscripcion, error)
+	GetActivas(ctx context.Context) ([]domain.WebhookSuscripcion, error)
+	GetByID(ctx context.Context, id uint) (*domain.WebhookSuscripcion, error)
+	Create(ctx context.Context, suscripcion *domain.WebhookSuscripcion) error
+	Update(ctx context.Context, suscripcion *domain.WebhookSuscripcion) error
+	Delete(ctx context.Context, id uint) error
+}
+
+// WebhookEntregaRepository - Cola y bitácora de entregas de webhooks
+type WebhookEntregaRepository interface {
+	// GetBySuscripcion devuelve las más recientes primero
+	GetBySuscripcion(ctx context.Context, suscripcionID uint, limit int) ([]domain.WebhookEntrega, error)
+	GetByID(ctx context.Context, id uint) (*domain.WebhookEntrega, error)
+	Create(ctx context.Context, entrega *domain.WebhookEntrega) error
+	Update(ctx context.Context, entrega *domain.WebhookEntrega) error
+	DeleteBySuscripcion(ctx context.Context, suscripcionID uint) error
+	// ClaimPendientes funciona igual que en NotificacionRepository
+	ClaimPendientes(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookEntrega, error)
+}
+
+// WebhookSender - Envía una entrega firmada; devuelve el status HTTP recibido
+type WebhookSender interface {
+	Send(ctx context.Context, url string, secret string, entrega *domain.WebhookEntrega) (int, error)
+}
+
+// Transactor - Agrupa operaciones de varios repositorios en una transacción
+type Transactor interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithSavepoint aísla fn dentro de la transacción de ctx: si falla solo se revierte fn
+	WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error
+	// AfterCommit difiere fn hasta que la transacción de ctx se confirme
+	AfterCommit(ctx context.Context, fn func(ctx context.Context))
+}
+
+// EventBus - Publica eventos de dominio a los suscriptores y sinks registrados
+type EventBus interface {
+	Publish(ctx context.Context, eventos ...domain.Evento) error
+}
+
+// EventSink - Destino externo de los eventos del bus (SNS, log, ...). Recibe el
+// evento ya serializado desde la bandeja de salida, así puede reintentarse
+type EventSink interface {
+	// Nombre identifica al sink en la bandeja; no debe cambiar entre versiones
+	Nombre() string
+	Handle(ctx context.Context, evento *domain.EventoSalida) error
+}
+
+// EventoSalidaRepository - Bandeja de salida de los sinks del bus
+type EventoSalidaRepository interface {
+	Create(ctx context.Context, evento *domain.EventoSalida) error
+	Update(ctx context.Context, evento *domain.EventoSalida) error
+	// ClaimPendientes funciona igual que en NotificacionRepository
+	ClaimPendientes(ctx context.Context, limit int, lease time.Duration) ([]domain.EventoSalida, error)
+}
+
 // SesionRepository - Operaciones de persistencia para Sesión
 type SesionRepository interface {
 	Create(ctx context.Context, sesion *domain.Sesion) error
@@ -32,14 +129,67 @@ type SesionRepository interface {
 	Deactivate(ctx context.Context, sessionString string) error
 }
 
+// JobRepository - Cola de jobs; las actualizaciones del worker solo tocan sus
+// columnas para no pisar una cancelación hecha desde la API
+type JobRepository interface {
+	GetByID(ctx context.Context, id uint) (*domain.Job, error)
+	Create(ctx context.Context, job *domain.Job) error
+	// ClaimPendiente toma un job pendiente (o uno cuya reserva venció), lo marca
+	// en_proceso y suma un intento. Devuelve nil si no hay ninguno
+	ClaimPendiente(ctx context.Context, lease time.Duration) (*domain.Job, error)
+	// Heartbeat renueva la reserva y guarda el avance; devuelve si se pidió cancelar
+	Heartbeat(ctx context.Context, id uint, procesados, total int, lease time.Duration) (bool, error)
+	// Cancel cancela un job pendiente o marca uno en_proceso para que su worker lo detenga
+	Cancel(ctx context.Context, id uint) error
+	// Terminar guarda el estado final del job solo si sigue en_proceso con el
+	// mismo intento con que se tomó; devuelve false si otro worker lo retomó
+	Terminar(ctx context.Context, job *domain.Job) (bool, error)
+}
+
+// IdempotenciaRepository - Respuestas guardadas por Idempotency-Key
+type IdempotenciaRepository interface {
+	// Reservar guarda la clave si no existe (o si la existente ya expiró) y
+	// devuelve true. Si ya existe devuelve el registro guardado y false
+	Reservar(ctx context.Context, registro *domain.RegistroIdempotencia) (*domain.RegistroIdempotencia, bool, error)
+	Update(ctx context.Context, registro *domain.RegistroIdempotencia) error
+	Delete(ctx context.Context, clave string) error
+	DeleteExpirados(ctx context.Context) (int64, error)
+}
+
 // FileStorage - Operaciones de alamacenamiento de archivos
 type FileStorage interface {
 	Upload(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
-	GetURL(ctx context.Context, key string) string
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	GetURL(ctx context.Context, key string) (string, error)
+	PresignUpload(ctx context.Context, key string, contentType string, size int64) (*domain.PresignedUpload, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	List(ctx context.Context, prefix string) ([]domain.StoredObject, error)
 	Delete(ctx context.Context, key string) error
 }
 
+// SignedFileStorage - Almacenamiento que sirve sus propias descargas con URLs firmadas
+type SignedFileStorage interface {
+	FileStorage
+	OpenSigned(ctx context.Context, key string, expires int64, signature string) (io.ReadSeekCloser, time.Time, error)
+	UploadSigned(ctx context.Context, key string, expires int64, signature string, file io.Reader, contentType string, size int64) error
+}
+
+// TemplateRenderer - Genera mensajes de notificación a partir de plantillas por idioma
+type TemplateRenderer interface {
+	Render(ctx context.Context, key string, idioma string, data any) (*domain.Mensaje, error)
+	// RenderPlantilla compila y ejecuta una plantilla aún no guardada; los errores
+	// de sintaxis o de datos se devuelven como ErrInvalidInput
+	RenderPlantilla(ctx context.Context, plantilla *domain.PlantillaNotificacion, data any) (*domain.Mensaje, error)
+	// Invalidar descarta la copia en caché de la plantilla guardada para clave e idioma
+	Invalidar(key string, idioma string)
+}
+
 // NotificationService - Operaciones de notificación
 type NotificationService interface {
+	// Publish difunde un anuncio a todos los suscriptores del topic
 	Publish(ctx context.Context, subject string, message string) error
+	// Send entrega el mensaje solo al destinatario indicado
+	Send(ctx context.Context, to domain.Destinatario, mensaje domain.Mensaje) error
+	// Subscribe registra al destinatario para recibir sus mensajes de Send
+	Subscribe(ctx context.Context, to domain.Destinatario) error
 }
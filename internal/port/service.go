@@ -5,17 +5,26 @@ import (
 	"io"
 
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
 )
 
 // AlumnoService - Lógica de negocio para Alumno
 type AlumnoService interface {
 	GetAll(ctx context.Context) ([]domain.Alumno, error)
 	GetByID(ctx context.Context, id uint) (*domain.Alumno, error)
+	// GetByIDs carga varios alumnos en una consulta; omite los que no existen
+	GetByIDs(ctx context.Context, ids []uint) ([]domain.Alumno, error)
 	Create(ctx context.Context, alumno *domain.Alumno) error
 	Update(ctx context.Context, id uint, alumno *domain.Alumno) error
 	Delete(ctx context.Context, id uint) error
-	UploadFotoPerfil(ctx context.Context, id uint, file io.Reader, filename string, contentType string) (string, error)
+	UploadFotoPerfil(ctx context.Context, id uint, file io.Reader) (map[string]string, error)
+	CreateFotoPerfilUploadURL(ctx context.Context, id uint, contentType string, size int64) (*domain.PresignedUpload, error)
+	ConfirmFotoPerfil(ctx context.Context, id uint, key string) (map[string]string, error)
+	DeleteFotoPerfil(ctx context.Context, id uint) error
 	SendEmail(ctx context.Context, id uint) error
+	Import(ctx context.Context, records []tabular.Record, dryRun bool) (*domain.ResultadoImportacion, error)
+	Export(ctx context.Context, w io.Writer, format string, progress func(procesados int)) error
+	Batch(ctx context.Context, modo string, ops []domain.OperacionLote[domain.Alumno]) (*domain.ResultadoLote, error)
 }
 
 // ProfesorService - Lógica de negocio para Profesor
@@ -25,6 +34,53 @@ type ProfesorService interface {
 	Create(ctx context.Context, profesor *domain.Profesor) error
 	Update(ctx context.Context, id uint, profesor *domain.Profesor) error
 	Delete(ctx context.Context, id uint) error
+	Import(ctx context.Context, records []tabular.Record, dryRun bool) (*domain.ResultadoImportacion, error)
+	Export(ctx context.Context, w io.Writer, format string, progress func(procesados int)) error
+	Batch(ctx context.Context, modo string, ops []domain.OperacionLote[domain.Profesor]) (*domain.ResultadoLote, error)
+}
+
+// DocumentoService - Lógica de negocio para los documentos del alumno
+type DocumentoService interface {
+	GetByAlumno(ctx context.Context, alumnoID uint) ([]domain.Documento, error)
+	// GetByAlumnos devuelve los documentos de varios alumnos en una consulta
+	GetByAlumnos(ctx context.Context, alumnoIDs []uint) ([]domain.Documento, error)
+	GetByID(ctx context.Context, alumnoID uint, id uint) (*domain.Documento, error)
+	// Upload registra como subidoPor al principal de ctx (pkg/auth)
+	Upload(ctx context.Context, alumnoID uint, tipo string, nombreArchivo string, file io.Reader) (*domain.Documento, error)
+	Download(ctx context.Context, alumnoID uint, id uint) (*domain.Documento, io.ReadCloser, error)
+	// Review registra como revisadoPor al principal de ctx
+	Review(ctx context.Context, alumnoID uint, id uint, estado string, comentario string) (*domain.Documento, error)
+	Delete(ctx context.Context, alumnoID uint, id uint) error
+}
+
+// PlantillaService - Administración de plantillas de notificación
+type PlantillaService interface {
+	GetAll(ctx context.Context) ([]domain.PlantillaNotificacion, error)
+	GetByID(ctx context.Context, id uint) (*domain.PlantillaNotificacion, error)
+	Create(ctx context.Context, plantilla *domain.PlantillaNotificacion) error
+	Update(ctx context.Context, id uint, plantilla *domain.PlantillaNotificacion) error
+	Delete(ctx context.Context, id uint) error
+	// Preview renderiza la plantilla con los datos de ejemplo de su clave
+	Preview(ctx context.Context, plantilla *domain.PlantillaNotificacion) (*domain.Mensaje, error)
+}
+
+// OutboxService - Consulta y reenvío de la bandeja de salida de notificaciones
+type OutboxService interface {
+	GetAll(ctx context.Context, estado string) ([]domain.Notificacion, error)
+	GetByID(ctx context.Context, id uint) (*domain.Notificacion, error)
+	Replay(ctx context.Context, id uint) (*domain.Notificacion, error)
+	ReplayFallidas(ctx context.Context) (int, error)
+}
+
+// WebhookService - Administración de suscripciones de webhooks
+type WebhookService interface {
+	GetAll(ctx context.Context) ([]domain.WebhookSuscripcion, error)
+	GetByID(ctx context.Context, id uint) (*domain.WebhookSuscripcion, error)
+	Create(ctx context.Context, suscripcion *domain.WebhookSuscripcion) error
+	Update(ctx context.Context, id uint, suscripcion *domain.WebhookSuscripcion) error
+	Delete(ctx context.Context, id uint) error
+	GetEntregas(ctx context.Context, id uint) ([]domain.WebhookEntrega, error)
+	ReplayEntrega(ctx context.Context, id uint, entregaID uint) (*domain.WebhookEntrega, error)
 }
 
 type SesionService interface {
@@ -32,3 +88,11 @@ type SesionService interface {
 	Verify(ctx context.Context, alumnoID uint, sessionString string) error
 	Logout(ctx context.Context, alumnoID uint, sessionString string) error
 }
+
+// JobService - Importaciones y exportaciones en segundo plano
+type JobService interface {
+	CreateImport(ctx context.Context, tipo string, file io.Reader, format string, dryRun bool) (*domain.Job, error)
+	CreateExport(ctx context.Context, tipo string, format string) (*domain.Job, error)
+	GetByID(ctx context.Context, id uint) (*domain.Job, error)
+	Cancel(ctx context.Context, id uint) (*domain.Job, error)
+}
@@ -1,34 +1,65 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"path/filepath"
+	"log"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
 	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/imaging"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
 	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/google/uuid"
 )
 
+const fotoPerfilOriginal = "original"
+
+// Tamaños (lado mayor en pixeles) de las variantes de la foto de perfil
+var fotoPerfilSizes = []int{64, 256, 1024}
+
 type AlumnoUseCase struct {
 	repo        port.AlumnoRepository
+	documentos  port.DocumentoRepository
 	fileStorage port.FileStorage
-	notifier    port.NotificationService
+	transactor  port.Transactor
+	outbox      port.NotificacionRepository
+	renderer    port.TemplateRenderer
+	events      port.EventBus
 }
 
-func NewAlumnoUseCase(repo port.AlumnoRepository, fileStorage port.FileStorage, notifier port.NotificationService) *AlumnoUseCase {
+func NewAlumnoUseCase(repo port.AlumnoRepository, documentos port.DocumentoRepository, fileStorage port.FileStorage, transactor port.Transactor, outbox port.NotificacionRepository, renderer port.TemplateRenderer, events port.EventBus) *AlumnoUseCase {
 	return &AlumnoUseCase{
 		repo:        repo,
+		documentos:  documentos,
 		fileStorage: fileStorage,
-		notifier:    notifier,
+		transactor:  transactor,
+		outbox:      outbox,
+		renderer:    renderer,
+		events:      events,
 	}
 }
 
 func (u *AlumnoUseCase) GetAll(ctx context.Context) ([]domain.Alumno, error) {
-	return u.repo.GetAll(ctx)
+	alumnos, err := u.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range alumnos {
+		if err := u.resolveFotoPerfilURL(ctx, &alumnos[i]); err != nil {
+			return nil, err
+		}
+	}
+	return alumnos, nil
 }
 
 func (u *AlumnoUseCase) GetByID(ctx context.Context, id uint) (*domain.Alumno, error) {
@@ -39,32 +70,68 @@ func (u *AlumnoUseCase) GetByID(ctx context.Context, id uint) (*domain.Alumno, e
 	if alumno == nil {
 		return nil, apperrors.ErrNotFound
 	}
+	if err := u.resolveFotoPerfilURL(ctx, alumno); err != nil {
+		return nil, err
+	}
 	return alumno, nil
 }
 
+func (u *AlumnoUseCase) GetByIDs(ctx context.Context, ids []uint) ([]domain.Alumno, error) {
+	alumnos, err := u.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range alumnos {
+		if err := u.resolveFotoPerfilURL(ctx, &alumnos[i]); err != nil {
+			return nil, err
+		}
+	}
+	return alumnos, nil
+}
+
 func (u *AlumnoUseCase) Create(ctx context.Context, alumno *domain.Alumno) error {
+	return u.create(ctx, alumno, utils.HashPassword)
+}
+
+// create da de alta al alumno usando hash para su password; Batch le pasa los
+// hashes calculados antes de abrir la transacción
+func (u *AlumnoUseCase) create(ctx context.Context, alumno *domain.Alumno, hash func(string) (string, error)) error {
 	validationErrors := utils.ValidateAlumno(
 		alumno.Nombres,
 		alumno.Apellidos,
 		alumno.Matricula,
 		alumno.Promedio,
+		alumno.Email,
+		alumno.Telefono,
 		alumno.Password,
 		true,
 	)
 	if validationErrors.HasErrors() {
-		return fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, validationErrors.Errors)
+		return validationErrors
 	}
 
-	hashedPassword, err := utils.HashPassword(alumno.Password)
+	hashedPassword, err := hash(alumno.Password)
 	if err != nil {
 		return fmt.Errorf("error al hashear password: %w", err)
 	}
 	alumno.Password = hashedPassword
+	if alumno.Idioma == "" {
+		alumno.Idioma = idiomaPreferido(ctx)
+	}
 
-	return u.repo.Create(ctx, alumno)
+	return u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.repo.Create(ctx, alumno); err != nil {
+			return err
+		}
+		return u.publish(ctx, domain.AlumnoCreated{Alumno: *alumno})
+	})
 }
 
 func (u *AlumnoUseCase) Update(ctx context.Context, id uint, alumno *domain.Alumno) error {
+	return u.update(ctx, id, alumno, utils.HashPassword)
+}
+
+func (u *AlumnoUseCase) update(ctx context.Context, id uint, alumno *domain.Alumno, hash func(string) (string, error)) error {
 	existing, err := u.repo.GetByID(ctx, id)
 	if err != nil {
 		return err
@@ -78,27 +145,40 @@ func (u *AlumnoUseCase) Update(ctx context.Context, id uint, alumno *domain.Alum
 		alumno.Apellidos,
 		alumno.Matricula,
 		alumno.Promedio,
+		alumno.Email,
+		alumno.Telefono,
 		alumno.Password,
 		false,
 	)
 	if validationErrors.HasErrors() {
-		return fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, validationErrors.Errors)
+		return validationErrors
 	}
 
+	anterior := *existing
 	existing.Nombres = alumno.Nombres
 	existing.Apellidos = alumno.Apellidos
 	existing.Matricula = alumno.Matricula
 	existing.Promedio = alumno.Promedio
+	existing.Email = alumno.Email
+	existing.Telefono = alumno.Telefono
+	if alumno.Idioma != "" {
+		existing.Idioma = alumno.Idioma
+	}
 
 	if alumno.Password != "" {
-		hashedPassword, err := utils.HashPassword(alumno.Password)
+		hashedPassword, err := hash(alumno.Password)
 		if err != nil {
 			return fmt.Errorf("error al hashear password: %w", err)
 		}
 		existing.Password = hashedPassword
 	}
 
-	return u.repo.Update(ctx, existing)
+	return u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.repo.Update(ctx, existing); err != nil {
+			return err
+		}
+		return u.publish(ctx, domain.AlumnoUpdated{Alumno: *existing, Anterior: anterior})
+	})
 }
 
 func (u *AlumnoUseCase) Delete(ctx context.Context, id uint) error {
@@ -110,32 +190,289 @@ func (u *AlumnoUseCase) Delete(ctx context.Context, id uint) error {
 		return apperrors.ErrNotFound
 	}
 
-	return u.repo.Delete(ctx, id)
+	return u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		// Los documentos del alumno se van con él
+		documentos, err := u.documentos.GetByAlumno(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := u.documentos.DeleteByAlumno(ctx, id); err != nil {
+			return err
+		}
+		if err := u.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		if err := u.publish(ctx, domain.AlumnoDeleted{Alumno: *existing}); err != nil {
+			return err
+		}
+		// Los archivos se borran solo si el borrado se confirma (puede ir dentro de un lote)
+		u.transactor.AfterCommit(ctx, func(ctx context.Context) {
+			u.deleteFotoPerfilObjects(ctx, existing, nil)
+			for _, documento := range documentos {
+				if err := u.fileStorage.Delete(ctx, documento.Key); err != nil {
+					log.Printf("No se pudo eliminar el documento %s: %v", documento.Key, err)
+				}
+			}
+		})
+		return nil
+	})
+}
+
+func (u *AlumnoUseCase) UploadFotoPerfil(ctx context.Context, id uint, file io.Reader) (map[string]string, error) {
+	alumno, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if alumno == nil {
+		return nil, apperrors.ErrNotFound
+	}
+
+	return u.storeFotoPerfil(ctx, alumno, file)
+}
+
+// CreateFotoPerfilUploadURL prepara una subida directa del navegador al almacenamiento;
+// la foto no se asocia al alumno hasta llamar a ConfirmFotoPerfil
+func (u *AlumnoUseCase) CreateFotoPerfilUploadURL(ctx context.Context, id uint, contentType string, size int64) (*domain.PresignedUpload, error) {
+	alumno, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if alumno == nil {
+		return nil, apperrors.ErrNotFound
+	}
+
+	validationErrors := utils.ValidateFotoPerfil(contentType, size)
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	// Una llave por subida para que dos peticiones simultáneas no compartan objeto
+	ext := utils.FotoPerfilContentTypes[contentType]
+	key := fmt.Sprintf("%sfoto_perfil_%s%s", fotoPerfilUploadsPrefix(id), uuid.NewString(), ext)
+
+	upload, err := u.fileStorage.PresignUpload(ctx, key, contentType, size)
+	if err != nil {
+		return nil, fmt.Errorf("error al generar URL de subida: %w", err)
+	}
+
+	return upload, nil
+}
+
+// ConfirmFotoPerfil procesa el archivo subido directamente como si llegara por
+// UploadFotoPerfil y después elimina el original sin procesar
+func (u *AlumnoUseCase) ConfirmFotoPerfil(ctx context.Context, id uint, key string) (map[string]string, error) {
+	alumno, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if alumno == nil {
+		return nil, apperrors.ErrNotFound
+	}
+
+	if !strings.HasPrefix(key, fotoPerfilUploadsPrefix(id)) || strings.Contains(key, "..") {
+		return nil, fmt.Errorf("%w: la llave no pertenece al alumno", apperrors.ErrInvalidInput)
+	}
+
+	file, err := u.fileStorage.Open(ctx, key)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: el archivo no se ha subido", apperrors.ErrInvalidInput)
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	urls, err := u.storeFotoPerfil(ctx, alumno, file)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.fileStorage.Delete(ctx, key); err != nil {
+		log.Printf("No se pudo eliminar la subida sin procesar %s: %v", key, err)
+	}
+
+	return urls, nil
+}
+
+// storeFotoPerfil valida la imagen por su contenido, genera las variantes y las
+// guarda bajo alumnos/{id}/foto_perfil_{uuid}/{variante}.{ext}
+func (u *AlumnoUseCase) storeFotoPerfil(ctx context.Context, alumno *domain.Alumno, file io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(io.LimitReader(file, utils.MaxFotoPerfilSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error al leer foto: %w", err)
+	}
+	if len(data) > utils.MaxFotoPerfilSize {
+		return nil, fmt.Errorf("%w: la foto excede 10 MB", apperrors.ErrInvalidInput)
+	}
+
+	variants, err := imaging.Process(data, fotoPerfilSizes)
+	if err != nil {
+		if errors.Is(err, imaging.ErrUnsupportedFormat) || errors.Is(err, imaging.ErrTooLarge) {
+			return nil, fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, err)
+		}
+		return nil, fmt.Errorf("error al procesar foto: %w", err)
+	}
+
+	prefix := fmt.Sprintf("alumnos/%d/foto_perfil_%s/", alumno.ID, uuid.NewString())
+	keys := make(map[string]string, len(variants))
+	for _, variant := range variants {
+		key := prefix + variant.Name + variant.Ext
+		if _, err := u.fileStorage.Upload(ctx, key, bytes.NewReader(variant.Data), variant.ContentType); err != nil {
+			return nil, fmt.Errorf("error al subir foto: %w", err)
+		}
+		keys[variant.Name] = key
+	}
+
+	previous := *alumno
+	alumno.FotoPerfilKey = keys[fotoPerfilOriginal]
+	alumno.FotoPerfilKeys = keys
+	if err := u.updateFotoPerfil(ctx, alumno); err != nil {
+		return nil, err
+	}
+	u.deleteFotoPerfilObjects(ctx, &previous, keys)
+
+	if err := u.resolveFotoPerfilURL(ctx, alumno); err != nil {
+		return nil, err
+	}
+	return alumno.FotoPerfilVariantes, nil
 }
 
-func (u *AlumnoUseCase) UploadFotoPerfil(ctx context.Context, id uint, file io.Reader, filename string, contentType string) (string, error) {
+func (u *AlumnoUseCase) updateFotoPerfil(ctx context.Context, alumno *domain.Alumno) error {
+	err := u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.repo.Update(ctx, alumno); err != nil {
+			return err
+		}
+		return u.publish(ctx, domain.FotoPerfilUpdated{Alumno: *alumno})
+	})
+	if err != nil {
+		return fmt.Errorf("error al actualizar alumno: %w", err)
+	}
+	return nil
+}
+
+// DeleteFotoPerfil quita la foto del alumno y borra todas sus variantes
+func (u *AlumnoUseCase) DeleteFotoPerfil(ctx context.Context, id uint) error {
 	alumno, err := u.repo.GetByID(ctx, id)
 	if err != nil {
-		return "", err
+		return err
 	}
 	if alumno == nil {
-		return "", apperrors.ErrNotFound
+		return apperrors.ErrNotFound
+	}
+	if alumno.FotoPerfilKey == "" {
+		return nil
 	}
 
-	ext := filepath.Ext(filename)
-	key := fmt.Sprintf("alumnos/%d/foto_perfil_%d%s", id, time.Now().Unix(), ext)
+	previous := *alumno
+	alumno.FotoPerfilKey = ""
+	alumno.FotoPerfilKeys = nil
+	if err := u.updateFotoPerfil(ctx, alumno); err != nil {
+		return err
+	}
 
-	url, err := u.fileStorage.Upload(ctx, key, file, contentType)
+	u.deleteFotoPerfilObjects(ctx, &previous, nil)
+	return nil
+}
+
+// ReconcileFotosPerfil borra las fotos bajo alumnos/ que ya no están referenciadas
+// por ningún alumno. Solo considera llaves de fotos (foto_perfil_* y uploads/) y
+// omite las modificadas hace menos de minAge para no tocar subidas en curso.
+func (u *AlumnoUseCase) ReconcileFotosPerfil(ctx context.Context, minAge time.Duration, dryRun bool) ([]string, error) {
+	alumnos, err := u.repo.GetAll(ctx)
 	if err != nil {
-		return "", fmt.Errorf("error al subir foto: %w", err)
+		return nil, err
+	}
+
+	referenced := make(map[string]bool)
+	for _, alumno := range alumnos {
+		for _, key := range fotoPerfilKeys(&alumno) {
+			referenced[key] = true
+		}
+	}
+
+	objects, err := u.fileStorage.List(ctx, "alumnos/")
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-minAge)
+	var orphans []string
+	for _, object := range objects {
+		if referenced[object.Key] || !isFotoPerfilObject(object.Key) || object.LastModified.After(cutoff) {
+			continue
+		}
+		if !dryRun {
+			if err := u.fileStorage.Delete(ctx, object.Key); err != nil {
+				return orphans, err
+			}
+		}
+		orphans = append(orphans, object.Key)
+	}
+
+	return orphans, nil
+}
+
+// deleteFotoPerfilObjects borra los archivos de la foto salvo los que están en
+// conservar (las variantes nuevas); los fallos solo se registran porque la
+// reconciliación eliminará lo que quede
+func (u *AlumnoUseCase) deleteFotoPerfilObjects(ctx context.Context, alumno *domain.Alumno, conservar map[string]string) {
+	nuevas := slices.Collect(maps.Values(conservar))
+	for _, key := range fotoPerfilKeys(alumno) {
+		if slices.Contains(nuevas, key) {
+			continue
+		}
+		if err := u.fileStorage.Delete(ctx, key); err != nil {
+			log.Printf("No se pudo eliminar la foto %s: %v", key, err)
+		}
+	}
+}
+
+func fotoPerfilKeys(alumno *domain.Alumno) []string {
+	keys := make([]string, 0, len(alumno.FotoPerfilKeys)+1)
+	for _, key := range alumno.FotoPerfilKeys {
+		keys = append(keys, key)
+	}
+	if alumno.FotoPerfilKey != "" && alumno.FotoPerfilKeys[fotoPerfilOriginal] != alumno.FotoPerfilKey {
+		keys = append(keys, alumno.FotoPerfilKey)
+	}
+	return keys
+}
+
+// isFotoPerfilObject indica si la llave tiene la forma alumnos/{id}/foto_perfil_* o alumnos/{id}/uploads/*
+func isFotoPerfilObject(key string) bool {
+	parts := strings.SplitN(key, "/", 3)
+	if len(parts) != 3 || parts[0] != "alumnos" {
+		return false
+	}
+	return strings.HasPrefix(parts[2], "foto_perfil_") || strings.HasPrefix(parts[2], "uploads/")
+}
+
+// resolveFotoPerfilURL genera las URLs temporales de la foto a partir de sus llaves
+func (u *AlumnoUseCase) resolveFotoPerfilURL(ctx context.Context, alumno *domain.Alumno) error {
+	if alumno.FotoPerfilKey == "" {
+		return nil
 	}
 
-	alumno.FotoPerfilUrl = url
-	if err := u.repo.Update(ctx, alumno); err != nil {
-		return "", fmt.Errorf("error al actualizar alumno: %w", err)
+	keys := alumno.FotoPerfilKeys
+	if len(keys) == 0 {
+		// Fotos anteriores a las variantes: solo existe el archivo original
+		keys = map[string]string{fotoPerfilOriginal: alumno.FotoPerfilKey}
 	}
 
-	return url, nil
+	alumno.FotoPerfilVariantes = make(map[string]string, len(keys))
+	for name, key := range keys {
+		url, err := u.fileStorage.GetURL(ctx, key)
+		if err != nil {
+			return fmt.Errorf("error al generar URL de foto: %w", err)
+		}
+		alumno.FotoPerfilVariantes[name] = url
+	}
+	alumno.FotoPerfilUrl = alumno.FotoPerfilVariantes[fotoPerfilOriginal]
+	return nil
+}
+
+func fotoPerfilUploadsPrefix(id uint) string {
+	return fmt.Sprintf("alumnos/%d/uploads/", id)
 }
 
 func (u *AlumnoUseCase) SendEmail(ctx context.Context, id uint) error {
@@ -147,18 +484,229 @@ func (u *AlumnoUseCase) SendEmail(ctx context.Context, id uint) error {
 		return apperrors.ErrNotFound
 	}
 
-	subject := fmt.Sprintf("Calificaciones de %s %s", alumno.Nombres, alumno.Apellidos)
-	message := fmt.Sprintf(
-		"Información del alumno:\n\nNombre: %s %s\nMatrícula: %s\nPromedio: %.2f",
-		alumno.Nombres,
-		alumno.Apellidos,
-		alumno.Matricula,
-		alumno.Promedio,
-	)
+	if alumno.Email == "" && alumno.Telefono == "" {
+		return fmt.Errorf("%w: el alumno no tiene email ni teléfono registrado", apperrors.ErrInvalidInput)
+	}
 
-	if u.notifier == nil {
+	if u.outbox == nil || u.renderer == nil {
 		return fmt.Errorf("notificador no configurado")
 	}
 
-	return u.notifier.Publish(ctx, subject, message)
+	mensaje, err := u.renderer.Render(ctx, domain.PlantillaCalificaciones, alumno.Idioma, domain.NuevosDatosCalificaciones(alumno))
+	if err != nil {
+		return fmt.Errorf("error al generar mensaje: %w", err)
+	}
+
+	// Solo el alumno recibe sus calificaciones; el despachador lo entrega y
+	// reintenta. Una notificación por canal: si falla el SMS no se reenvía el correo
+	return u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		for _, to := range alumno.Destinatario().PorCanal() {
+			if err := u.outbox.Create(ctx, nuevaNotificacion(domain.NotificacionEnvio, to, mensaje)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Batch aplica operaciones de crear, actualizar y eliminar en una sola
+// transacción, con las mismas reglas que Create, Update y Delete
+func (u *AlumnoUseCase) Batch(ctx context.Context, modo string, ops []domain.OperacionLote[domain.Alumno]) (*domain.ResultadoLote, error) {
+	if err := validarLote(modo, len(ops)); err != nil {
+		return nil, err
+	}
+
+	// Como en Import, bcrypt corre antes de abrir la transacción para no
+	// retenerla mientras se hashea. Los passwords que bcrypt rechaza por largos
+	// se quedan fuera y fallan en su propia operación.
+	datos := make([]*domain.Alumno, 0, len(ops))
+	passwords := make([]string, 0, len(ops))
+	for _, op := range ops {
+		if (op.Op == domain.OperacionCrear || op.Op == domain.OperacionActualizar) && op.Datos != nil && op.Datos.Password != "" && len(op.Datos.Password) <= maxPasswordBytes {
+			datos = append(datos, op.Datos)
+			passwords = append(passwords, op.Datos.Password)
+		}
+	}
+	hashes, err := hashPasswords(ctx, passwords)
+	if err != nil {
+		return nil, err
+	}
+	porDatos := make(map[*domain.Alumno]string, len(datos))
+	for i, alumno := range datos {
+		porDatos[alumno] = hashes[i]
+	}
+
+	return ejecutarLote(ctx, u.transactor, modo, ops, func(ctx context.Context, op domain.OperacionLote[domain.Alumno]) (uint, error) {
+		hash := func(password string) (string, error) {
+			if hashed, ok := porDatos[op.Datos]; ok {
+				return hashed, nil
+			}
+			return utils.HashPassword(password)
+		}
+		switch op.Op {
+		case domain.OperacionCrear:
+			err := u.create(ctx, op.Datos, hash)
+			return op.Datos.ID, err
+		case domain.OperacionActualizar:
+			return op.ID, u.update(ctx, op.ID, op.Datos, hash)
+		default:
+			return op.ID, u.Delete(ctx, op.ID)
+		}
+	})
+}
+
+// Import crea o actualiza alumnos por matrícula. Todas las filas se validan antes
+// de escribir; si alguna tiene errores, o si dryRun es true, solo se devuelve el
+// reporte. En otro caso los cambios se aplican en una sola transacción.
+func (u *AlumnoUseCase) Import(ctx context.Context, records []tabular.Record, dryRun bool) (*domain.ResultadoImportacion, error) {
+	if len(records) > MaxFilasImportacion {
+		return nil, fmt.Errorf("%w: el archivo excede el máximo de %d filas", apperrors.ErrInvalidInput, MaxFilasImportacion)
+	}
+
+	matriculas := make([]string, 0, len(records))
+	for _, record := range records {
+		matriculas = append(matriculas, strings.TrimSpace(record.Get("matricula")))
+	}
+	existentes, err := u.repo.GetByMatriculas(ctx, matriculas)
+	if err != nil {
+		return nil, err
+	}
+	porMatricula := make(map[string]*domain.Alumno, len(existentes))
+	for i := range existentes {
+		porMatricula[existentes[i].Matricula] = &existentes[i]
+	}
+
+	resultado := &domain.ResultadoImportacion{DryRun: dryRun, Filas: make([]domain.FilaImportada, 0, len(records))}
+	alumnos := make([]domain.Alumno, 0, len(records))
+	vistas := make(map[string]int, len(records))
+	for _, record := range records {
+		alumno, errores := alumnoFromRecord(record)
+		existing := porMatricula[alumno.Matricula]
+
+		validationErrors := utils.ValidateAlumno(
+			alumno.Nombres,
+			alumno.Apellidos,
+			alumno.Matricula,
+			alumno.Promedio,
+			alumno.Email,
+			alumno.Telefono,
+			alumno.Password,
+			existing == nil,
+		)
+		mergeErrores(errores, validationErrors)
+		if fila, ok := vistas[alumno.Matricula]; ok && alumno.Matricula != "" {
+			errores.Add("matricula", i18n.MsgCampoRepetido, "matricula", fila)
+		} else {
+			vistas[alumno.Matricula] = record.Line
+		}
+
+		fila := domain.FilaImportada{Fila: record.Line, Clave: alumno.Matricula, Accion: domain.ImportacionCrear, Errores: errores.Errors}
+		if existing != nil {
+			fila.Accion = domain.ImportacionActualizar
+		}
+		resultado.Add(fila)
+		alumnos = append(alumnos, alumno)
+	}
+	if dryRun || resultado.ConErrores > 0 {
+		return resultado, nil
+	}
+
+	passwords := make([]string, len(alumnos))
+	for i := range alumnos {
+		passwords[i] = alumnos[i].Password
+	}
+	hashes, err := hashPasswords(ctx, passwords)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		for i := range alumnos {
+			alumno := &alumnos[i]
+			existing := porMatricula[alumno.Matricula]
+			if existing == nil {
+				alumno.Password = hashes[i]
+				if alumno.Idioma == "" {
+					alumno.Idioma = idiomaPreferido(ctx)
+				}
+				if err := u.repo.Create(ctx, alumno); err != nil {
+					return err
+				}
+				if err := u.publish(ctx, domain.AlumnoCreated{Alumno: *alumno}); err != nil {
+					return err
+				}
+				continue
+			}
+
+			anterior := *existing
+			existing.Nombres = alumno.Nombres
+			existing.Apellidos = alumno.Apellidos
+			existing.Promedio = alumno.Promedio
+			existing.Email = alumno.Email
+			existing.Telefono = alumno.Telefono
+			if alumno.Idioma != "" {
+				existing.Idioma = alumno.Idioma
+			}
+			if hashes[i] != "" {
+				existing.Password = hashes[i]
+			}
+			if err := u.repo.Update(ctx, existing); err != nil {
+				return err
+			}
+			if err := u.publish(ctx, domain.AlumnoUpdated{Alumno: *existing, Anterior: anterior}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resultado.Aplicado = true
+	return resultado, nil
+}
+
+// alumnoFromRecord convierte una fila; los errores de formato se reportan por campo
+func alumnoFromRecord(record tabular.Record) (domain.Alumno, *apperrors.ValidationErrors) {
+	errores := &apperrors.ValidationErrors{}
+	alumno := domain.Alumno{
+		Nombres:   strings.TrimSpace(record.Get("nombres")),
+		Apellidos: strings.TrimSpace(record.Get("apellidos")),
+		Matricula: strings.TrimSpace(record.Get("matricula")),
+		Email:     strings.TrimSpace(record.Get("email")),
+		Telefono:  strings.TrimSpace(record.Get("telefono")),
+		Idioma:    strings.TrimSpace(record.Get("idioma")),
+		Password:  record.Get("password"),
+	}
+	if value := strings.TrimSpace(record.Get("promedio")); value != "" {
+		promedio, err := parseDecimal(value)
+		if err != nil {
+			errores.Add("promedio", i18n.MsgCampoNumero, "promedio")
+		}
+		alumno.Promedio = promedio
+	}
+	return alumno, errores
+}
+
+// Export escribe todos los registros en el formato pedido (csv, xlsx o ndjson).
+// La tabla se recorre por lotes, sin cargarla en memoria.
+func (u *AlumnoUseCase) Export(ctx context.Context, w io.Writer, format string, progress func(procesados int)) error {
+	return exportar(ctx, w, format, alumnoExportHeader, u.repo.Each, alumnoExportRow, progress)
+}
+
+func (u *AlumnoUseCase) publish(ctx context.Context, evento domain.Evento) error {
+	if u.events == nil {
+		return nil
+	}
+	return u.events.Publish(ctx, evento)
+}
+
+// idiomaPreferido - Idioma de las notificaciones de un alumno que no lo indica:
+// el negociado con Accept-Language o el idioma por defecto
+func idiomaPreferido(ctx context.Context) string {
+	if i18n.HasLang(ctx) {
+		return i18n.FromContext(ctx)
+	}
+	return domain.IdiomaPorDefecto
 }
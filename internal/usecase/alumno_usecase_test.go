@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/local"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+// nuevoAlumnoUseCase arma el caso de uso sobre SQLite y un FileStorage local
+// con un alumno dado de alta
+func nuevoAlumnoUseCase(t *testing.T) (*AlumnoUseCase, *local.FileStorage, *domain.Alumno) {
+	t.Helper()
+	db := storagetest.NewSQLite(t)
+	fileStorage := local.NewFileStorage(t.TempDir(), "http://localhost", []byte("clave"), time.Minute)
+	alumnos := NewAlumnoUseCase(relational.NewAlumnoRepository(db), relational.NewDocumentoRepository(db), fileStorage, storage.NewTransactor(db), relational.NewNotificacionRepository(db), nil, nil)
+
+	alumno := nuevoAlumno("A001", "ana@example.com")
+	if err := alumnos.Create(context.Background(), alumno); err != nil {
+		t.Fatal(err)
+	}
+	return alumnos, fileStorage, alumno
+}
+
+func fotoPNG(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 80, 60))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func existe(t *testing.T, fileStorage *local.FileStorage, key string) bool {
+	t.Helper()
+	ok, err := fileStorage.Exists(context.Background(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ok
+}
+
+// Dos subidas seguidas (en el mismo segundo) dejan la segunda foto completa y
+// borran solo las variantes de la primera
+func TestUploadFotoPerfilSeguidas(t *testing.T) {
+	ctx := context.Background()
+	alumnos, fileStorage, alumno := nuevoAlumnoUseCase(t)
+
+	if _, err := alumnos.UploadFotoPerfil(ctx, alumno.ID, bytes.NewReader(fotoPNG(t))); err != nil {
+		t.Fatalf("primera UploadFotoPerfil: %v", err)
+	}
+	primera, err := alumnos.GetByID(ctx, alumno.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := alumnos.UploadFotoPerfil(ctx, alumno.ID, bytes.NewReader(fotoPNG(t))); err != nil {
+		t.Fatalf("segunda UploadFotoPerfil: %v", err)
+	}
+	segunda, err := alumnos.GetByID(ctx, alumno.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(segunda.FotoPerfilKeys) != len(fotoPerfilSizes)+1 {
+		t.Fatalf("variantes = %v", segunda.FotoPerfilKeys)
+	}
+	for name, key := range segunda.FotoPerfilKeys {
+		if key == primera.FotoPerfilKeys[name] {
+			t.Errorf("la variante %s reutilizó la llave %s", name, key)
+		}
+		if !existe(t, fileStorage, key) {
+			t.Errorf("la variante %s de la foto actual (%s) fue borrada", name, key)
+		}
+	}
+	for _, key := range primera.FotoPerfilKeys {
+		if existe(t, fileStorage, key) {
+			t.Errorf("quedó la variante reemplazada %s", key)
+		}
+	}
+}
+
+// Al reemplazar la foto nunca se borra una llave que también está en la nueva
+func TestDeleteFotoPerfilObjectsConservaLasNuevas(t *testing.T) {
+	ctx := context.Background()
+	alumnos, fileStorage, _ := nuevoAlumnoUseCase(t)
+
+	anterior := &domain.Alumno{
+		FotoPerfilKey:  "alumnos/1/foto_perfil_a/original.jpg",
+		FotoPerfilKeys: map[string]string{"original": "alumnos/1/foto_perfil_a/original.jpg", "64": "alumnos/1/foto_perfil_a/64.jpg"},
+	}
+	for _, key := range anterior.FotoPerfilKeys {
+		if _, err := fileStorage.Upload(ctx, key, strings.NewReader("x"), "image/jpeg"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	nuevas := map[string]string{"original": "alumnos/1/foto_perfil_a/original.jpg"}
+	alumnos.deleteFotoPerfilObjects(ctx, anterior, nuevas)
+	if !existe(t, fileStorage, "alumnos/1/foto_perfil_a/original.jpg") {
+		t.Error("se borró una llave de la foto nueva")
+	}
+	if existe(t, fileStorage, "alumnos/1/foto_perfil_a/64.jpg") {
+		t.Error("no se borró la variante que ya no se usa")
+	}
+}
+
+// Dos URLs de subida pedidas a la vez nunca comparten objeto
+func TestCreateFotoPerfilUploadURLUnica(t *testing.T) {
+	ctx := context.Background()
+	alumnos, _, alumno := nuevoAlumnoUseCase(t)
+
+	keys := make(map[string]bool)
+	for range 3 {
+		upload, err := alumnos.CreateFotoPerfilUploadURL(ctx, alumno.ID, "image/png", 1024)
+		if err != nil {
+			t.Fatalf("CreateFotoPerfilUploadURL: %v", err)
+		}
+		if !strings.HasPrefix(upload.Key, fotoPerfilUploadsPrefix(alumno.ID)) || !strings.HasSuffix(upload.Key, ".png") {
+			t.Fatalf("llave %q fuera de %s", upload.Key, fotoPerfilUploadsPrefix(alumno.ID))
+		}
+		if keys[upload.Key] {
+			t.Fatalf("llave repetida %q", upload.Key)
+		}
+		keys[upload.Key] = true
+	}
+}
+
+// subirFirmado hace lo que el navegador con una URL de subida: un PUT con el
+// Content-Type y tamaño firmados
+func subirFirmado(t *testing.T, fileStorage *local.FileStorage, upload *domain.PresignedUpload, data []byte) {
+	t.Helper()
+	u, err := url.Parse(upload.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expires, err := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size, err := strconv.ParseInt(upload.Headers["Content-Length"], 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = fileStorage.UploadSigned(context.Background(), upload.Key, expires, u.Query().Get("signature"), bytes.NewReader(data), upload.Headers["Content-Type"], size)
+	if err != nil {
+		t.Fatalf("UploadSigned: %v", err)
+	}
+}
+
+// Subida directa: la foto se asocia al confirmar, sus URLs son temporales y
+// el archivo sin procesar se borra
+func TestConfirmFotoPerfil(t *testing.T) {
+	ctx := context.Background()
+	alumnos, fileStorage, alumno := nuevoAlumnoUseCase(t)
+	foto := fotoPNG(t)
+
+	// El Content-Type declarado no importa: la imagen se detecta por su contenido
+	upload, err := alumnos.CreateFotoPerfilUploadURL(ctx, alumno.ID, "image/jpeg", int64(len(foto)))
+	if err != nil {
+		t.Fatalf("CreateFotoPerfilUploadURL: %v", err)
+	}
+	if upload.Method != "PUT" || upload.Headers["Content-Type"] != "image/jpeg" {
+		t.Fatalf("upload = %+v", upload)
+	}
+	if sinConfirmar, err := alumnos.GetByID(ctx, alumno.ID); err != nil || sinConfirmar.FotoPerfilKey != "" {
+		t.Fatalf("la foto se asoció antes de confirmar: %+v, %v", sinConfirmar, err)
+	}
+	subirFirmado(t, fileStorage, upload, foto)
+
+	variantes, err := alumnos.ConfirmFotoPerfil(ctx, alumno.ID, upload.Key)
+	if err != nil {
+		t.Fatalf("ConfirmFotoPerfil: %v", err)
+	}
+	if len(variantes) != len(fotoPerfilSizes)+1 {
+		t.Fatalf("variantes = %v", variantes)
+	}
+	for name, rawURL := range variantes {
+		u, err := url.Parse(rawURL)
+		if err != nil || u.Query().Get("signature") == "" || u.Query().Get("expires") == "" {
+			t.Errorf("la variante %s no es una URL firmada: %q", name, rawURL)
+		}
+	}
+	if existe(t, fileStorage, upload.Key) {
+		t.Error("quedó el archivo sin procesar")
+	}
+
+	got, err := alumnos.GetByID(ctx, alumno.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(got.FotoPerfilKey, "alumnos/") || got.FotoPerfilUrl != got.FotoPerfilVariantes[fotoPerfilOriginal] || got.FotoPerfilUrl == "" {
+		t.Fatalf("alumno tras confirmar: key=%q url=%q", got.FotoPerfilKey, got.FotoPerfilUrl)
+	}
+}
+
+func TestConfirmFotoPerfilRechaza(t *testing.T) {
+	ctx := context.Background()
+	alumnos, fileStorage, alumno := nuevoAlumnoUseCase(t)
+
+	otro := nuevoAlumno("A002", "beto@example.com")
+	if err := alumnos.Create(ctx, otro); err != nil {
+		t.Fatal(err)
+	}
+	ajena, err := alumnos.CreateFotoPerfilUploadURL(ctx, otro.ID, "image/png", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sinSubir, err := alumnos.CreateFotoPerfilUploadURL(ctx, alumno.ID, "image/png", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	texto := []byte("no soy una imagen")
+	disfrazada, err := alumnos.CreateFotoPerfilUploadURL(ctx, alumno.ID, "image/png", int64(len(texto)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subirFirmado(t, fileStorage, disfrazada, texto)
+
+	for nombre, key := range map[string]string{
+		"llave de otro alumno":       ajena.Key,
+		"archivo no subido":          sinSubir.Key,
+		"fuera de uploads":           "alumnos/1/documentos/x.png",
+		"con ..":                     fotoPerfilUploadsPrefix(alumno.ID) + "../../2/uploads/x.png",
+		"contenido que no es imagen": disfrazada.Key,
+	} {
+		if _, err := alumnos.ConfirmFotoPerfil(ctx, alumno.ID, key); !errors.Is(err, apperrors.ErrInvalidInput) {
+			t.Errorf("%s: ConfirmFotoPerfil = %v, se esperaba ErrInvalidInput", nombre, err)
+		}
+	}
+
+	for nombre, tc := range map[string]struct {
+		contentType string
+		size        int64
+	}{
+		"tipo no permitido": {"image/gif", 10},
+		"demasiado grande":  {"image/png", 11 << 20},
+		"tamaño vacío":      {"image/png", 0},
+	} {
+		var validacion *apperrors.ValidationErrors
+		if _, err := alumnos.CreateFotoPerfilUploadURL(ctx, alumno.ID, tc.contentType, tc.size); !errors.As(err, &validacion) {
+			t.Errorf("%s: CreateFotoPerfilUploadURL = %v, se esperaba ValidationErrors", nombre, err)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/google/uuid"
+)
+
+// TipoDocumento - Tipos MIME aceptados y tamaño máximo por tipo de documento
+type TipoDocumento struct {
+	ContentTypes []string
+	MaxSize      int64
+}
+
+var TiposDocumento = map[string]TipoDocumento{
+	"acta_nacimiento":       {ContentTypes: []string{"application/pdf", "image/jpeg", "image/png"}, MaxSize: 5 << 20},
+	"identificacion":        {ContentTypes: []string{"application/pdf", "image/jpeg", "image/png"}, MaxSize: 5 << 20},
+	"comprobante_domicilio": {ContentTypes: []string{"application/pdf", "image/jpeg", "image/png"}, MaxSize: 5 << 20},
+	"certificado_estudios":  {ContentTypes: []string{"application/pdf"}, MaxSize: utils.MaxDocumentoSize},
+	"kardex":                {ContentTypes: []string{"application/pdf"}, MaxSize: utils.MaxDocumentoSize},
+}
+
+var documentoExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+type DocumentoUseCase struct {
+	repo        port.DocumentoRepository
+	alumnoRepo  port.AlumnoRepository
+	fileStorage port.FileStorage
+}
+
+func NewDocumentoUseCase(repo port.DocumentoRepository, alumnoRepo port.AlumnoRepository, fileStorage port.FileStorage) *DocumentoUseCase {
+	return &DocumentoUseCase{
+		repo:        repo,
+		alumnoRepo:  alumnoRepo,
+		fileStorage: fileStorage,
+	}
+}
+
+func (u *DocumentoUseCase) GetByAlumno(ctx context.Context, alumnoID uint) ([]domain.Documento, error) {
+	if err := u.ensureAlumno(ctx, alumnoID); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByAlumno(ctx, alumnoID)
+}
+
+func (u *DocumentoUseCase) GetByAlumnos(ctx context.Context, alumnoIDs []uint) ([]domain.Documento, error) {
+	return u.repo.GetByAlumnos(ctx, alumnoIDs)
+}
+
+func (u *DocumentoUseCase) GetByID(ctx context.Context, alumnoID uint, id uint) (*domain.Documento, error) {
+	documento, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if documento == nil || documento.AlumnoID != alumnoID {
+		return nil, apperrors.ErrNotFound
+	}
+	return documento, nil
+}
+
+// Upload guarda el documento sin cargarlo completo en memoria: el tipo se detecta
+// por los primeros bytes y el checksum/tamaño se calculan mientras se sube. La
+// subida se corta en cuanto excede el máximo del tipo. Quien sube es el
+// principal de la petición
+func (u *DocumentoUseCase) Upload(ctx context.Context, alumnoID uint, tipo string, nombreArchivo string, file io.Reader) (*domain.Documento, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperrors.ErrUnauthorized
+	}
+	if err := u.ensureAlumno(ctx, alumnoID); err != nil {
+		return nil, err
+	}
+
+	validationErrors := utils.ValidateDocumento(tipo, nombreArchivo)
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+	tipoDocumento, ok := TiposDocumento[tipo]
+	if !ok {
+		return nil, fmt.Errorf("%w: tipo de documento desconocido: %s", apperrors.ErrInvalidInput, tipo)
+	}
+
+	reader := bufio.NewReaderSize(file, 512)
+	head, err := reader.Peek(512)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, fmt.Errorf("error al leer documento: %w", err)
+	}
+	if len(head) == 0 {
+		return nil, fmt.Errorf("%w: el documento está vacío", apperrors.ErrInvalidInput)
+	}
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if !containsString(tipoDocumento.ContentTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s no admite archivos %s (permitidos: %s)",
+			apperrors.ErrInvalidInput, tipo, contentType, strings.Join(tipoDocumento.ContentTypes, ", "))
+	}
+
+	key := fmt.Sprintf("alumnos/%d/documentos/%s%s", alumnoID, uuid.New().String(), documentoExtensions[contentType])
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	body := io.TeeReader(&maxSizeReader{r: reader, max: tipoDocumento.MaxSize}, io.MultiWriter(hash, counter))
+
+	if _, err := u.fileStorage.Upload(ctx, key, body, contentType); err != nil {
+		// El almacenamiento pudo guardar una parte antes del corte
+		u.deleteObject(ctx, key)
+		if errors.Is(err, errDocumentoMuyGrande) {
+			return nil, fmt.Errorf("%w: %s admite máximo %d MB", apperrors.ErrInvalidInput, tipo, tipoDocumento.MaxSize>>20)
+		}
+		return nil, fmt.Errorf("error al subir documento: %w", err)
+	}
+
+	documento := &domain.Documento{
+		AlumnoID:      alumnoID,
+		Tipo:          tipo,
+		Estado:        domain.DocumentoPendiente,
+		NombreArchivo: nombreArchivo,
+		ContentType:   contentType,
+		Size:          counter.n,
+		Checksum:      hex.EncodeToString(hash.Sum(nil)),
+		Key:           key,
+		SubidoPor:     principal.String(),
+	}
+	if err := u.repo.Create(ctx, documento); err != nil {
+		u.deleteObject(ctx, key)
+		return nil, err
+	}
+
+	return documento, nil
+}
+
+func (u *DocumentoUseCase) Download(ctx context.Context, alumnoID uint, id uint) (*domain.Documento, io.ReadCloser, error) {
+	documento, err := u.GetByID(ctx, alumnoID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := u.fileStorage.Open(ctx, documento.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return documento, file, nil
+}
+
+func (u *DocumentoUseCase) Review(ctx context.Context, alumnoID uint, id uint, estado string, comentario string) (*domain.Documento, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	documento, err := u.GetByID(ctx, alumnoID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	validationErrors := utils.ValidateRevisionDocumento(estado, comentario)
+	if validationErrors.HasErrors() {
+		return nil, validationErrors
+	}
+
+	now := time.Now()
+	documento.Estado = estado
+	documento.Comentario = comentario
+	documento.RevisadoPor = principal.String()
+	documento.RevisadoEn = &now
+
+	if err := u.repo.Update(ctx, documento); err != nil {
+		return nil, err
+	}
+	return documento, nil
+}
+
+func (u *DocumentoUseCase) Delete(ctx context.Context, alumnoID uint, id uint) error {
+	documento, err := u.GetByID(ctx, alumnoID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	u.deleteObject(ctx, documento.Key)
+	return nil
+}
+
+func (u *DocumentoUseCase) ensureAlumno(ctx context.Context, alumnoID uint) error {
+	alumno, err := u.alumnoRepo.GetByID(ctx, alumnoID)
+	if err != nil {
+		return err
+	}
+	if alumno == nil {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+func (u *DocumentoUseCase) deleteObject(ctx context.Context, key string) {
+	if err := u.fileStorage.Delete(ctx, key); err != nil {
+		log.Printf("No se pudo eliminar el documento %s: %v", key, err)
+	}
+}
+
+var errDocumentoMuyGrande = errors.New("el documento excede el tamaño máximo")
+
+// maxSizeReader falla con errDocumentoMuyGrande en cuanto se leen más de max
+// bytes, para que el almacenamiento aborte la subida sin recibir el resto
+type maxSizeReader struct {
+	r    io.Reader
+	max  int64
+	read int64
+}
+
+func (m *maxSizeReader) Read(p []byte) (int, error) {
+	n, err := m.r.Read(p)
+	m.read += int64(n)
+	if m.read > m.max {
+		return 0, errDocumentoMuyGrande
+	}
+	return n, err
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/local"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/auth"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+// pdf - Lo mínimo para que http.DetectContentType lo reconozca
+var pdf = []byte("%PDF-1.7\n1 0 obj << >> endobj\ntrailer << >>\n%%EOF\n")
+
+func nuevoDocumentoUseCase(t *testing.T) (*DocumentoUseCase, *local.FileStorage, *domain.Alumno, context.Context) {
+	t.Helper()
+	db := storagetest.NewSQLite(t)
+	fileStorage := local.NewFileStorage(t.TempDir(), "http://localhost", []byte("clave"), time.Minute)
+	alumnoRepo := relational.NewAlumnoRepository(db)
+	alumno := nuevoAlumno("A001", "ana@example.com")
+	if err := alumnoRepo.Create(context.Background(), alumno); err != nil {
+		t.Fatal(err)
+	}
+
+	documentos := NewDocumentoUseCase(relational.NewDocumentoRepository(db), alumnoRepo, fileStorage)
+	ctx := auth.WithPrincipal(context.Background(), auth.Principal{AlumnoID: alumno.ID})
+	return documentos, fileStorage, alumno, ctx
+}
+
+// conRelleno devuelve data seguido de ceros hasta size bytes
+func conRelleno(data []byte, size int) io.Reader {
+	return io.MultiReader(bytes.NewReader(data), io.LimitReader(zeros{}, int64(size-len(data))))
+}
+
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func documentosGuardados(t *testing.T, fileStorage *local.FileStorage, alumnoID uint) []domain.StoredObject {
+	t.Helper()
+	objetos, err := fileStorage.List(context.Background(), fmt.Sprintf("alumnos/%d/documentos/", alumnoID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return objetos
+}
+
+// El tipo se detecta por el contenido, no por la extensión del nombre
+func TestDocumentoUploadDetectaTipo(t *testing.T) {
+	documentos, fileStorage, alumno, ctx := nuevoDocumentoUseCase(t)
+	png := fotoPNG(t)
+
+	for _, tc := range []struct {
+		nombre      string
+		tipo        string
+		archivo     string
+		data        []byte
+		contentType string // vacío si se rechaza
+	}{
+		{"pdf", "kardex", "kardex.pdf", pdf, "application/pdf"},
+		{"png como acta", "acta_nacimiento", "acta.png", png, "image/png"},
+		{"png con nombre .pdf", "acta_nacimiento", "acta.pdf", png, "image/png"},
+		{"png donde solo se admite pdf", "kardex", "kardex.pdf", png, ""},
+		{"texto con nombre .pdf", "kardex", "kardex.pdf", []byte("no soy un pdf"), ""},
+		{"html con nombre .png", "identificacion", "ine.png", []byte("<html><body>hola</body></html>"), ""},
+		{"vacío", "kardex", "kardex.pdf", nil, ""},
+	} {
+		documento, err := documentos.Upload(ctx, alumno.ID, tc.tipo, tc.archivo, bytes.NewReader(tc.data))
+		if tc.contentType == "" {
+			if !errors.Is(err, apperrors.ErrInvalidInput) {
+				t.Errorf("%s: Upload = %+v, %v; se esperaba ErrInvalidInput", tc.nombre, documento, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: Upload: %v", tc.nombre, err)
+		}
+		sum := sha256.Sum256(tc.data)
+		if documento.ContentType != tc.contentType || documento.Size != int64(len(tc.data)) || documento.Checksum != hex.EncodeToString(sum[:]) {
+			t.Errorf("%s: documento = %+v", tc.nombre, documento)
+		}
+		if documento.Estado != domain.DocumentoPendiente || documento.SubidoPor != "alumno:1" {
+			t.Errorf("%s: estado=%s subidoPor=%s", tc.nombre, documento.Estado, documento.SubidoPor)
+		}
+	}
+
+	if n := len(documentosGuardados(t, fileStorage, alumno.ID)); n != 3 {
+		t.Errorf("hay %d archivos guardados, se esperaban 3", n)
+	}
+}
+
+// El límite es por tipo y la subida cortada no deja archivo ni registro
+func TestDocumentoUploadLimiteDeTamaño(t *testing.T) {
+	documentos, fileStorage, alumno, ctx := nuevoDocumentoUseCase(t)
+	maximo := int(TiposDocumento["acta_nacimiento"].MaxSize)
+
+	documento, err := documentos.Upload(ctx, alumno.ID, "acta_nacimiento", "acta.pdf", conRelleno(pdf, maximo))
+	if err != nil {
+		t.Fatalf("Upload del tamaño máximo: %v", err)
+	}
+	if documento.Size != int64(maximo) {
+		t.Fatalf("size = %d, se esperaba %d", documento.Size, maximo)
+	}
+
+	if _, err := documentos.Upload(ctx, alumno.ID, "acta_nacimiento", "acta.pdf", conRelleno(pdf, maximo+1)); !errors.Is(err, apperrors.ErrInvalidInput) {
+		t.Fatalf("Upload de un byte más = %v, se esperaba ErrInvalidInput", err)
+	}
+	// El kardex admite más que el acta
+	if _, err := documentos.Upload(ctx, alumno.ID, "kardex", "kardex.pdf", conRelleno(pdf, maximo+1)); err != nil {
+		t.Fatalf("Upload de kardex: %v", err)
+	}
+
+	guardados, err := documentos.GetByAlumno(ctx, alumno.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(guardados) != 2 || len(documentosGuardados(t, fileStorage, alumno.ID)) != 2 {
+		t.Fatalf("quedaron %d registros y %d archivos, se esperaban 2", len(guardados), len(documentosGuardados(t, fileStorage, alumno.ID)))
+	}
+}
+
+func TestDocumentoUploadValida(t *testing.T) {
+	documentos, _, alumno, ctx := nuevoDocumentoUseCase(t)
+
+	if _, err := documentos.Upload(context.Background(), alumno.ID, "kardex", "k.pdf", bytes.NewReader(pdf)); !errors.Is(err, apperrors.ErrUnauthorized) {
+		t.Errorf("sin principal: %v, se esperaba ErrUnauthorized", err)
+	}
+	if _, err := documentos.Upload(ctx, 999, "kardex", "k.pdf", bytes.NewReader(pdf)); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Errorf("alumno inexistente: %v, se esperaba ErrNotFound", err)
+	}
+	var validacion *apperrors.ValidationErrors
+	if _, err := documentos.Upload(ctx, alumno.ID, "kardex", " ", bytes.NewReader(pdf)); !errors.As(err, &validacion) {
+		t.Errorf("sin nombre: %v, se esperaba ValidationErrors", err)
+	}
+	if _, err := documentos.Upload(ctx, alumno.ID, "pasaporte", "p.pdf", bytes.NewReader(pdf)); !errors.Is(err, apperrors.ErrInvalidInput) {
+		t.Errorf("tipo desconocido: %v, se esperaba ErrInvalidInput", err)
+	}
+}
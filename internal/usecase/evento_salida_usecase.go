@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+)
+
+// EventoSalidaUseCase - Entrega los eventos del bus a los sinks externos con
+// la misma bandeja de salida y reintentos que las notificaciones
+type EventoSalidaUseCase struct {
+	repo  port.EventoSalidaRepository
+	sinks map[string]port.EventSink
+	cfg   OutboxConfig
+}
+
+func NewEventoSalidaUseCase(repo port.EventoSalidaRepository, sinks []port.EventSink, cfg OutboxConfig) *EventoSalidaUseCase {
+	porNombre := make(map[string]port.EventSink, len(sinks))
+	for _, sink := range sinks {
+		porNombre[sink.Nombre()] = sink
+	}
+	return &EventoSalidaUseCase{
+		repo:  repo,
+		sinks: porNombre,
+		cfg:   cfg,
+	}
+}
+
+// Handle encola el evento para cada sink. Se suscribe al bus, así corre dentro
+// de la transacción del cambio y el evento sale solo si el cambio se guarda
+func (u *EventoSalidaUseCase) Handle(ctx context.Context, evento domain.EventoPublicado) error {
+	if len(u.sinks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(evento)
+	if err != nil {
+		return fmt.Errorf("error al serializar evento %s: %w", evento.Nombre, err)
+	}
+	for nombre := range u.sinks {
+		err := u.repo.Create(ctx, &domain.EventoSalida{
+			Sink:             nombre,
+			EventoID:         evento.ID,
+			Evento:           evento.Nombre,
+			Payload:          string(payload),
+			Estado:           domain.EntregaPendiente,
+			SiguienteIntento: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run despacha la bandeja cada interval hasta que se cancele ctx
+func (u *EventoSalidaUseCase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := u.Dispatch(ctx)
+			if err != nil {
+				log.Printf("Error al despachar eventos: %v", err)
+			}
+			if err != nil || n < u.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch entrega un lote de eventos vencidos y devuelve cuántos tomó
+func (u *EventoSalidaUseCase) Dispatch(ctx context.Context) (int, error) {
+	eventos, err := u.repo.ClaimPendientes(ctx, u.cfg.BatchSize, u.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range eventos {
+		evento := &eventos[i]
+		sink, ok := u.sinks[evento.Sink]
+		if !ok {
+			// El sink se quitó de EVENT_SINKS después de encolar el evento
+			evento.Estado = domain.EntregaFallida
+			evento.UltimoError = "sink no configurado"
+		} else if err := sink.Handle(ctx, evento); err != nil {
+			u.fail(evento, err)
+		} else {
+			now := time.Now()
+			evento.Estado = domain.EntregaEnviada
+			evento.EnviadaEn = &now
+			evento.UltimoError = ""
+		}
+		evento.Intentos++
+
+		if err := u.repo.Update(context.WithoutCancel(ctx), evento); err != nil {
+			return i, err
+		}
+	}
+	return len(eventos), nil
+}
+
+func (u *EventoSalidaUseCase) fail(evento *domain.EventoSalida, err error) {
+	evento.UltimoError = err.Error()
+	if evento.Intentos+1 >= u.cfg.MaxIntentos {
+		evento.Estado = domain.EntregaFallida
+		log.Printf("Evento %s (%s) para el sink %s fallido tras %d intentos: %v", evento.Evento, evento.EventoID, evento.Sink, evento.Intentos+1, err)
+		return
+	}
+
+	evento.SiguienteIntento = time.Now().Add(u.cfg.backoff(evento.Intentos))
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/eventbus"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+)
+
+// fakeSink - port.EventSink que registra los eventos y falla mientras err no sea nil
+type fakeSink struct {
+	err       error
+	recibidos []domain.EventoSalida
+}
+
+func (s *fakeSink) Nombre() string { return "fake" }
+
+func (s *fakeSink) Handle(ctx context.Context, evento *domain.EventoSalida) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.recibidos = append(s.recibidos, *evento)
+	return nil
+}
+
+func TestEventoSalidaReintentaSinPerderEventos(t *testing.T) {
+	ctx := context.Background()
+	db := storagetest.NewSQLite(t)
+	transactor := storage.NewTransactor(db)
+	sink := &fakeSink{err: errors.New("SNS caído")}
+	eventos := NewEventoSalidaUseCase(relational.NewEventoSalidaRepository(db), []port.EventSink{sink}, OutboxConfig{
+		BatchSize:   10,
+		MaxIntentos: 5,
+		BackoffBase: time.Millisecond,
+		BackoffMax:  time.Millisecond,
+		Lease:       time.Minute,
+	})
+
+	bus := eventbus.New()
+	bus.Subscribe(domain.EventoTodos, eventos.Handle)
+	alumnoUseCase := NewAlumnoUseCase(relational.NewAlumnoRepository(db), relational.NewDocumentoRepository(db), nil, transactor, relational.NewNotificacionRepository(db), nil, bus)
+
+	if err := alumnoUseCase.Create(ctx, nuevoAlumno("A001", "ana@example.com")); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// Un alta revertida no deja evento en la bandeja
+	transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		alumnoUseCase.Create(ctx, nuevoAlumno("A002", "beto@example.com"))
+		return errors.New("revertir")
+	})
+
+	// El sink falla: el evento queda para un reintento en lugar de perderse
+	if n, err := eventos.Dispatch(ctx); err != nil || n != 1 {
+		t.Fatalf("Dispatch = %d, %v", n, err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	sink.err = nil
+	if n, err := eventos.Dispatch(ctx); err != nil || n != 1 {
+		t.Fatalf("Dispatch tras el fallo = %d, %v", n, err)
+	}
+	if n, _ := eventos.Dispatch(ctx); n != 0 {
+		t.Fatalf("un evento enviado se volvió a tomar")
+	}
+
+	if len(sink.recibidos) != 1 {
+		t.Fatalf("el sink recibió %d eventos", len(sink.recibidos))
+	}
+	recibido := sink.recibidos[0]
+	if recibido.Evento != domain.EventoAlumnoCreado || recibido.Intentos != 1 {
+		t.Fatalf("evento = %s, intentos previos = %d", recibido.Evento, recibido.Intentos)
+	}
+	var publicado struct {
+		ID   string `json:"id"`
+		Data struct {
+			Alumno map[string]any `json:"alumno"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(recibido.Payload), &publicado); err != nil {
+		t.Fatalf("payload: %v", err)
+	}
+	if publicado.ID != recibido.EventoID || publicado.Data.Alumno["matricula"] != "A001" {
+		t.Fatalf("payload = %s", recibido.Payload)
+	}
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
+)
+
+// Columnas exportadas; coinciden con las que acepta la importación
+var (
+	alumnoExportHeader   = []string{"id", "matricula", "nombres", "apellidos", "promedio", "email", "telefono", "idioma"}
+	profesorExportHeader = []string{"id", "numeroEmpleado", "nombres", "apellidos", "horasClase"}
+)
+
+// exportar escribe en w las filas que produce each. progress, si no es nil,
+// recibe el número de filas escritas después de cada una.
+func exportar[T any](ctx context.Context, w io.Writer, format string, header []string, each func(context.Context, func(*T) error) error, row func(*T) []any, progress func(int)) error {
+	writer, err := tabular.NewWriter(w, format, header)
+	if err != nil {
+		return err
+	}
+
+	procesados := 0
+	err = each(ctx, func(item *T) error {
+		if err := writer.Write(row(item)); err != nil {
+			return err
+		}
+		procesados++
+		if progress != nil {
+			progress(procesados)
+		}
+		return nil
+	})
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func alumnoExportRow(a *domain.Alumno) []any {
+	return []any{a.ID, a.Matricula, a.Nombres, a.Apellidos, a.Promedio, a.Email, a.Telefono, a.Idioma}
+}
+
+func profesorExportRow(p *domain.Profesor) []any {
+	return []any{p.ID, p.NumeroEmpleado, p.Nombres, p.Apellidos, p.HorasClase}
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+)
+
+// MaxFilasImportacion - Límite de filas por archivo importado
+const MaxFilasImportacion = 5000
+
+// parseDecimal acepta coma decimal ("9,5") como la exporta Excel en español
+func parseDecimal(value string) (float64, error) {
+	if !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// parseEntero acepta "12" y "12.0" (Excel guarda los números como decimales)
+func parseEntero(value string) (int, error) {
+	value = strings.TrimSuffix(value, ".0")
+	return strconv.Atoi(value)
+}
+
+// maxPasswordBytes - bcrypt rechaza passwords más largos sin hashearlos
+const maxPasswordBytes = 72
+
+// hashPasswords hashea en paralelo; bcrypt tarda decenas de ms por password y
+// una importación puede traer cientos. Se detiene si ctx se cancela.
+func hashPasswords(ctx context.Context, passwords []string) ([]string, error) {
+	hashes := make([]string, len(passwords))
+	errs := make([]error, len(passwords))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for i, password := range passwords {
+		if password == "" {
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			hashes[i], errs[i] = utils.HashPassword(password)
+		})
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("error al hashear password: %w", err)
+		}
+	}
+	return hashes, nil
+}
+
+// mergeErrores agrega los errores de validación omitiendo los campos que ya
+// tienen un error de formato
+func mergeErrores(errores, validationErrors *apperrors.ValidationErrors) {
+	conError := make(map[string]bool, len(errores.Errors))
+	for _, e := range errores.Errors {
+		conError[e.Field] = true
+	}
+	for _, e := range validationErrors.Errors {
+		if !conError[e.Field] {
+			errores.Errors = append(errores.Errors, e)
+		}
+	}
+}
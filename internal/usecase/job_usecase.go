@@ -0,0 +1,366 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
+	"github.com/google/uuid"
+)
+
+// JobConfig - Parámetros de los workers de jobs
+type JobConfig struct {
+	Lease       time.Duration // reserva del job; el worker la renueva mientras trabaja
+	MaxIntentos int           // veces que se retoma un job cuyo worker se cayó
+}
+
+var errJobCancelado = errors.New("job cancelado")
+
+// JobUseCase - Encola importaciones y exportaciones y las ejecuta en segundo plano
+type JobUseCase struct {
+	repo        port.JobRepository
+	fileStorage port.FileStorage
+	alumnos     port.AlumnoService
+	profesores  port.ProfesorService
+	cfg         JobConfig
+}
+
+func NewJobUseCase(repo port.JobRepository, fileStorage port.FileStorage, alumnos port.AlumnoService, profesores port.ProfesorService, cfg JobConfig) *JobUseCase {
+	return &JobUseCase{
+		repo:        repo,
+		fileStorage: fileStorage,
+		alumnos:     alumnos,
+		profesores:  profesores,
+		cfg:         cfg,
+	}
+}
+
+// CreateImport guarda el archivo en FileStorage y encola su importación
+func (u *JobUseCase) CreateImport(ctx context.Context, tipo string, file io.Reader, format string, dryRun bool) (*domain.Job, error) {
+	if tipo != domain.JobImportarAlumnos && tipo != domain.JobImportarProfesores {
+		return nil, fmt.Errorf("%w: tipo de job no es una importación: %s", apperrors.ErrInvalidInput, tipo)
+	}
+
+	key := fmt.Sprintf("jobs/entradas/%s.%s", uuid.NewString(), format)
+	if _, err := u.fileStorage.Upload(ctx, key, file, tabular.ContentType(format)); err != nil {
+		return nil, fmt.Errorf("error al guardar archivo: %w", err)
+	}
+
+	job := &domain.Job{Tipo: tipo, Formato: format, DryRun: dryRun, EntradaKey: key}
+	if err := u.enqueue(ctx, job); err != nil {
+		_ = u.fileStorage.Delete(ctx, key)
+		return nil, err
+	}
+	return job, nil
+}
+
+// CreateExport encola la exportación de una tabla completa
+func (u *JobUseCase) CreateExport(ctx context.Context, tipo string, format string) (*domain.Job, error) {
+	if tipo != domain.JobExportarAlumnos && tipo != domain.JobExportarProfesores {
+		return nil, fmt.Errorf("%w: tipo de job no es una exportación: %s", apperrors.ErrInvalidInput, tipo)
+	}
+
+	job := &domain.Job{Tipo: tipo, Formato: format}
+	if err := u.enqueue(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (u *JobUseCase) enqueue(ctx context.Context, job *domain.Job) error {
+	job.Estado = domain.JobPendiente
+	job.ReservadoHasta = time.Now()
+	if err := u.repo.Create(ctx, job); err != nil {
+		return err
+	}
+	job.CalcularProgreso()
+	return nil
+}
+
+// GetByID devuelve el estado del job y, si terminó, la URL temporal del resultado
+func (u *JobUseCase) GetByID(ctx context.Context, id uint) (*domain.Job, error) {
+	job, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, apperrors.ErrNotFound
+	}
+
+	job.CalcularProgreso()
+	if job.Estado == domain.JobCompletado && job.ResultadoKey != "" {
+		url, err := u.fileStorage.GetURL(ctx, job.ResultadoKey)
+		if err != nil {
+			return nil, fmt.Errorf("error al generar URL del resultado: %w", err)
+		}
+		job.ResultadoURL = url
+	}
+	return job, nil
+}
+
+// Cancel cancela un job pendiente de inmediato; uno en proceso se detiene en el
+// siguiente heartbeat de su worker y sus cambios se descartan
+func (u *JobUseCase) Cancel(ctx context.Context, id uint) (*domain.Job, error) {
+	job, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Terminado() {
+		return nil, fmt.Errorf("%w: el job ya terminó (%s)", apperrors.ErrConflict, job.Estado)
+	}
+
+	if err := u.repo.Cancel(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.GetByID(ctx, id)
+}
+
+// Run toma y ejecuta jobs uno a uno hasta que ctx se cancela. Cada worker es
+// una llamada a Run; interval es también la frecuencia del heartbeat.
+func (u *JobUseCase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			job, err := u.repo.ClaimPendiente(ctx, u.cfg.Lease)
+			if err != nil {
+				log.Printf("Error al tomar job: %v", err)
+			}
+			if job == nil {
+				break
+			}
+			u.process(ctx, job, interval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// jobAvance - Avance que el runner reporta y el heartbeat guarda
+type jobAvance struct {
+	procesados atomic.Int64
+	total      atomic.Int64
+}
+
+// jobCancelacion - El heartbeat cancela el contexto del job cuando se pide
+// desde la API, hasta que el runner la cierra antes de un paso que no se puede
+// deshacer (confirmar una importación); después los pedidos se ignoran
+type jobCancelacion struct {
+	mu      sync.Mutex
+	cancel  context.CancelCauseFunc
+	cerrada bool
+	pedida  bool
+}
+
+// cancelar cancela el job si aún se puede; devuelve si lo hizo
+func (c *jobCancelacion) cancelar() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cerrada {
+		return false
+	}
+	c.pedida = true
+	c.cancel(errJobCancelado)
+	return true
+}
+
+// cerrar impide cancelar desde ahora; devuelve false si el job ya se canceló
+func (c *jobCancelacion) cerrar() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cerrada = true
+	return !c.pedida
+}
+
+func (u *JobUseCase) process(ctx context.Context, job *domain.Job, interval time.Duration) {
+	var err error
+	switch {
+	case job.CancelacionSolicitada:
+		err = errJobCancelado
+	case job.Intentos > u.cfg.MaxIntentos:
+		err = fmt.Errorf("se agotaron los %d intentos", u.cfg.MaxIntentos)
+	default:
+		jobCtx, cancel := context.WithCancelCause(ctx)
+		avance := &jobAvance{}
+		cancelacion := &jobCancelacion{cancel: cancel}
+		done := make(chan struct{})
+		var heartbeat sync.WaitGroup
+		heartbeat.Go(func() {
+			u.heartbeat(jobCtx, job.ID, avance, interval, cancelacion, done)
+		})
+
+		err = u.execute(jobCtx, job, avance, cancelacion)
+		close(done)
+		heartbeat.Wait()
+		// Solo cuenta como cancelado si la cancelación interrumpió el trabajo; un
+		// pedido que llega cuando el job ya terminó no cambia su resultado
+		if err != nil && errors.Is(context.Cause(jobCtx), errJobCancelado) && (errors.Is(err, context.Canceled) || errors.Is(err, errJobCancelado)) {
+			err = errJobCancelado
+		}
+		cancel(nil)
+
+		if ctx.Err() != nil {
+			// Apagado: la reserva vence y otro worker retoma el job
+			return
+		}
+		job.Procesados = int(avance.procesados.Load())
+		job.Total = int(avance.total.Load())
+	}
+
+	now := time.Now()
+	job.TerminadoEn = &now
+	switch {
+	case err == nil:
+		job.Estado = domain.JobCompletado
+		job.Error = ""
+	case errors.Is(err, errJobCancelado):
+		job.Estado = domain.JobCancelado
+	default:
+		job.Estado = domain.JobFallido
+		job.Error = err.Error()
+		log.Printf("Job %d (%s) fallido: %v", job.ID, job.Tipo, err)
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	guardado, err := u.repo.Terminar(ctx, job)
+	if err != nil {
+		log.Printf("Error al guardar job %d: %v", job.ID, err)
+		return
+	}
+	if !guardado {
+		// Otro worker retomó el job y la entrada le pertenece. El resultado de
+		// este intento tiene su propia llave y ya nadie lo usará
+		log.Printf("Job %d retomado por otro worker; se descarta el intento %d", job.ID, job.Intentos)
+		if job.ResultadoKey != "" {
+			if err := u.fileStorage.Delete(ctx, job.ResultadoKey); err != nil {
+				log.Printf("Error al eliminar resultado del job %d: %v", job.ID, err)
+			}
+		}
+		return
+	}
+	if job.EntradaKey != "" {
+		if err := u.fileStorage.Delete(ctx, job.EntradaKey); err != nil {
+			log.Printf("Error al eliminar entrada del job %d: %v", job.ID, err)
+		}
+	}
+}
+
+// heartbeat renueva la reserva y guarda el avance cada interval; si desde la
+// API se pidió cancelar y la cancelación sigue abierta, cancela el contexto del job
+func (u *JobUseCase) heartbeat(ctx context.Context, id uint, avance *jobAvance, interval time.Duration, cancelacion *jobCancelacion, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		cancelar, err := u.repo.Heartbeat(ctx, id, int(avance.procesados.Load()), int(avance.total.Load()), u.cfg.Lease)
+		if err != nil {
+			log.Printf("Error al renovar job %d: %v", id, err)
+			continue
+		}
+		if cancelar && cancelacion.cancelar() {
+			return
+		}
+	}
+}
+
+func (u *JobUseCase) execute(ctx context.Context, job *domain.Job, avance *jobAvance, cancelacion *jobCancelacion) error {
+	switch job.Tipo {
+	case domain.JobImportarAlumnos:
+		return u.importar(ctx, job, avance, cancelacion, u.alumnos.Import)
+	case domain.JobImportarProfesores:
+		return u.importar(ctx, job, avance, cancelacion, u.profesores.Import)
+	case domain.JobExportarAlumnos:
+		return u.exportar(ctx, job, avance, "alumnos", u.alumnos.Export)
+	case domain.JobExportarProfesores:
+		return u.exportar(ctx, job, avance, "profesores", u.profesores.Export)
+	default:
+		return fmt.Errorf("tipo de job desconocido: %s", job.Tipo)
+	}
+}
+
+// importar lee la entrada y guarda el reporte por fila como resultado. Las filas
+// con errores no hacen fallar el job: el reporte indica que no se aplicó nada.
+func (u *JobUseCase) importar(ctx context.Context, job *domain.Job, avance *jobAvance, cancelacion *jobCancelacion, importar func(context.Context, []tabular.Record, bool) (*domain.ResultadoImportacion, error)) error {
+	file, err := u.fileStorage.Open(ctx, job.EntradaKey)
+	if err != nil {
+		return fmt.Errorf("error al abrir archivo: %w", err)
+	}
+	defer file.Close()
+
+	records, err := tabular.ReadRecords(file, job.Formato)
+	if err != nil {
+		return fmt.Errorf("error al leer el archivo: %w", err)
+	}
+	avance.total.Store(int64(len(records)))
+
+	// Una vez confirmada la importación ya no se puede cancelar: se cierra antes,
+	// para que un pedido que llegue después no deje como cancelado un job cuyas
+	// filas se aplicaron
+	if !job.DryRun && !cancelacion.cerrar() {
+		return errJobCancelado
+	}
+	resultado, err := importar(ctx, records, job.DryRun)
+	if err != nil {
+		return err
+	}
+	avance.procesados.Store(int64(len(records)))
+
+	data, err := json.Marshal(resultado)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("jobs/%d/resultado-%d.json", job.ID, job.Intentos)
+	if _, err := u.fileStorage.Upload(ctx, key, bytes.NewReader(data), "application/json"); err != nil {
+		return fmt.Errorf("error al guardar resultado: %w", err)
+	}
+	job.ResultadoKey = key
+	return nil
+}
+
+// exportar sube el archivo a FileStorage conforme se genera, sin armarlo en memoria
+func (u *JobUseCase) exportar(ctx context.Context, job *domain.Job, avance *jobAvance, nombre string, export func(context.Context, io.Writer, string, func(int)) error) error {
+	reader, writer := io.Pipe()
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		writer.CloseWithError(export(ctx, writer, job.Formato, func(procesados int) {
+			avance.procesados.Store(int64(procesados))
+		}))
+	})
+
+	key := fmt.Sprintf("jobs/%d/%s-%d.%s", job.ID, nombre, job.Intentos, job.Formato)
+	_, err := u.fileStorage.Upload(ctx, key, reader, tabular.ContentType(job.Formato))
+	reader.CloseWithError(err)
+	wg.Wait()
+	if err != nil {
+		return fmt.Errorf("error al guardar resultado: %w", err)
+	}
+
+	job.ResultadoKey = key
+	job.Total = int(avance.procesados.Load())
+	avance.total.Store(int64(job.Total))
+	return nil
+}
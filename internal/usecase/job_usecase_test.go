@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/local"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
+)
+
+// nuevoJobUseCase arma los jobs sobre SQLite y un FileStorage local, con dos
+// alumnos dados de alta
+func nuevoJobUseCase(t *testing.T) (*JobUseCase, *relational.JobRepository, *local.FileStorage) {
+	t.Helper()
+	ctx := context.Background()
+	db := storagetest.NewSQLite(t)
+	transactor := storage.NewTransactor(db)
+	fileStorage := local.NewFileStorage(t.TempDir(), "http://localhost", []byte("clave"), time.Minute)
+	alumnos := NewAlumnoUseCase(relational.NewAlumnoRepository(db), relational.NewDocumentoRepository(db), fileStorage, transactor, relational.NewNotificacionRepository(db), nil, nil)
+	profesores := NewProfesorUseCase(relational.NewProfesorRepository(db), transactor, nil)
+
+	for _, alumno := range []*domain.Alumno{nuevoAlumno("A001", "ana@example.com"), nuevoAlumno("A002", "beto@example.com")} {
+		if err := alumnos.Create(ctx, alumno); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	repo := relational.NewJobRepository(db)
+	return NewJobUseCase(repo, fileStorage, alumnos, profesores, JobConfig{Lease: time.Minute, MaxIntentos: 3}), repo, fileStorage
+}
+
+func leerResultado(t *testing.T, fileStorage *local.FileStorage, key string) string {
+	t.Helper()
+	file, err := fileStorage.Open(context.Background(), key)
+	if err != nil {
+		t.Fatalf("Open(%s): %v", key, err)
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestJobExporta(t *testing.T) {
+	ctx := context.Background()
+	jobs, repo, fileStorage := nuevoJobUseCase(t)
+
+	job, err := jobs.CreateExport(ctx, domain.JobExportarAlumnos, tabular.FormatCSV)
+	if err != nil {
+		t.Fatalf("CreateExport: %v", err)
+	}
+	tomado, err := repo.ClaimPendiente(ctx, time.Minute)
+	if err != nil || tomado == nil || tomado.ID != job.ID {
+		t.Fatalf("ClaimPendiente = %+v, %v", tomado, err)
+	}
+	jobs.process(ctx, tomado, time.Hour)
+
+	got, err := jobs.GetByID(ctx, job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Estado != domain.JobCompletado || got.Total != 2 {
+		t.Fatalf("job = %+v", got)
+	}
+	csv := leerResultado(t, fileStorage, got.ResultadoKey)
+	if !strings.Contains(csv, "A001") || !strings.Contains(csv, "A002") {
+		t.Fatalf("faltan alumnos en el resultado:\n%s", csv)
+	}
+}
+
+// Una cancelación pedida mientras el worker trabaja no se pierde al guardar
+// el resultado, aunque el job alcance a completarse
+func TestJobTerminarConservaCancelacion(t *testing.T) {
+	ctx := context.Background()
+	jobs, repo, _ := nuevoJobUseCase(t)
+
+	job, err := jobs.CreateExport(ctx, domain.JobExportarAlumnos, tabular.FormatCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tomado, err := repo.ClaimPendiente(ctx, time.Minute)
+	if err != nil || tomado == nil {
+		t.Fatalf("ClaimPendiente = %+v, %v", tomado, err)
+	}
+	if _, err := jobs.Cancel(ctx, job.ID); err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	jobs.process(ctx, tomado, time.Hour)
+
+	got, err := repo.GetByID(ctx, job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Estado != domain.JobCompletado || !got.CancelacionSolicitada {
+		t.Fatalf("estado=%s cancelacionSolicitada=%t", got.Estado, got.CancelacionSolicitada)
+	}
+}
+
+// importacionYCancelacion - Importa de verdad y, ya confirmada la importación,
+// pide cancelar el job y espera a que el heartbeat lo vea
+type importacionYCancelacion struct {
+	port.AlumnoService
+	cancelar func()
+}
+
+func (s importacionYCancelacion) Import(ctx context.Context, records []tabular.Record, dryRun bool) (*domain.ResultadoImportacion, error) {
+	resultado, err := s.AlumnoService.Import(ctx, records, dryRun)
+	s.cancelar()
+	return resultado, err
+}
+
+// Un pedido de cancelación que llega después de confirmar la importación no
+// deja el job como cancelado: sus filas ya se aplicaron
+func TestJobCanceladoTrasConfirmarImportacion(t *testing.T) {
+	ctx := context.Background()
+	db := storagetest.NewSQLite(t)
+	fileStorage := local.NewFileStorage(t.TempDir(), "http://localhost", []byte("clave"), time.Minute)
+	alumnos := NewAlumnoUseCase(relational.NewAlumnoRepository(db), relational.NewDocumentoRepository(db), fileStorage, storage.NewTransactor(db), relational.NewNotificacionRepository(db), nil, nil)
+	repo := relational.NewJobRepository(db)
+
+	var jobs *JobUseCase
+	var jobID uint
+	importador := importacionYCancelacion{AlumnoService: alumnos, cancelar: func() {
+		if _, err := jobs.Cancel(ctx, jobID); err != nil {
+			t.Errorf("Cancel: %v", err)
+		}
+		time.Sleep(50 * time.Millisecond) // varios heartbeats
+	}}
+	jobs = NewJobUseCase(repo, fileStorage, importador, NewProfesorUseCase(relational.NewProfesorRepository(db), storage.NewTransactor(db), nil), JobConfig{Lease: time.Minute, MaxIntentos: 3})
+
+	csv := "matricula,nombres,apellidos,promedio,email,password\nA010,Ana,García,9.5,ana@example.com,secreto123\n"
+	job, err := jobs.CreateImport(ctx, domain.JobImportarAlumnos, strings.NewReader(csv), tabular.FormatCSV, false)
+	if err != nil {
+		t.Fatalf("CreateImport: %v", err)
+	}
+	jobID = job.ID
+	tomado, err := repo.ClaimPendiente(ctx, time.Minute)
+	if err != nil || tomado == nil {
+		t.Fatalf("ClaimPendiente = %+v, %v", tomado, err)
+	}
+	jobs.process(ctx, tomado, 5*time.Millisecond)
+
+	got, err := repo.GetByID(ctx, job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Estado != domain.JobCompletado || !got.CancelacionSolicitada || got.ResultadoKey == "" {
+		t.Fatalf("estado=%s cancelacionSolicitada=%t resultado=%q; se esperaba completado", got.Estado, got.CancelacionSolicitada, got.ResultadoKey)
+	}
+	if importados, err := alumnos.GetAll(ctx); err != nil || len(importados) != 1 {
+		t.Fatalf("alumnos = %d, %v", len(importados), err)
+	}
+}
+
+// Un worker cuya reserva venció no pisa el job que otro worker retomó
+func TestJobRetomadoPorOtroWorker(t *testing.T) {
+	ctx := context.Background()
+	jobs, repo, fileStorage := nuevoJobUseCase(t)
+
+	job, err := jobs.CreateExport(ctx, domain.JobExportarAlumnos, tabular.FormatCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vencido, err := repo.ClaimPendiente(ctx, -time.Second)
+	if err != nil || vencido == nil {
+		t.Fatalf("ClaimPendiente = %+v, %v", vencido, err)
+	}
+	retomado, err := repo.ClaimPendiente(ctx, time.Minute)
+	if err != nil || retomado == nil || retomado.ID != job.ID || retomado.Intentos != 2 {
+		t.Fatalf("ClaimPendiente tras vencer = %+v, %v", retomado, err)
+	}
+
+	jobs.process(ctx, vencido, time.Hour)
+	got, err := repo.GetByID(ctx, job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Estado != domain.JobEnProceso || got.Intentos != 2 || got.ResultadoKey != "" || got.TerminadoEn != nil {
+		t.Fatalf("el worker vencido pisó el job: %+v", got)
+	}
+	objetos, err := fileStorage.List(ctx, "jobs/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objetos) != 0 {
+		t.Fatalf("quedó el resultado del intento descartado: %+v", objetos)
+	}
+
+	jobs.process(ctx, retomado, time.Hour)
+	got, err = repo.GetByID(ctx, job.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Estado != domain.JobCompletado || got.Total != 2 {
+		t.Fatalf("job retomado = %+v", got)
+	}
+	if csv := leerResultado(t, fileStorage, got.ResultadoKey); !strings.Contains(csv, "A001") || !strings.Contains(csv, "A002") {
+		t.Fatalf("resultado del job retomado:\n%s", csv)
+	}
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+// MaxOperacionesLote - Límite de operaciones por lote
+const MaxOperacionesLote = 500
+
+var errLoteRevertido = errors.New("lote revertido")
+
+// ejecutarLote corre las operaciones en una sola transacción. En modo atómico la
+// primera que falla revierte todo el lote; en modo parcial cada operación corre
+// en su propio savepoint y las fallidas no afectan a las demás. apply devuelve
+// el ID del registro afectado.
+func ejecutarLote[T any](ctx context.Context, transactor port.Transactor, modo string, ops []domain.OperacionLote[T], apply func(context.Context, domain.OperacionLote[T]) (uint, error)) (*domain.ResultadoLote, error) {
+	if err := validarLote(modo, len(ops)); err != nil {
+		return nil, err
+	}
+	if modo == "" {
+		modo = domain.LoteAtomico
+	}
+
+	resultado := &domain.ResultadoLote{Modo: modo, Resultados: make([]domain.ResultadoOperacion, len(ops))}
+	for i, op := range ops {
+		resultado.Resultados[i] = domain.ResultadoOperacion{Indice: i, Op: op.Op, ID: op.ID, Estado: domain.OperacionOmitida}
+	}
+
+	err := transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		for i, op := range ops {
+			res := &resultado.Resultados[i]
+
+			var id uint
+			var err error
+			if modo == domain.LoteParcial {
+				err = transactor.WithSavepoint(ctx, func(ctx context.Context) error {
+					id, err = applyOperacion(ctx, op, apply)
+					return err
+				})
+			} else {
+				id, err = applyOperacion(ctx, op, apply)
+			}
+
+			if err != nil {
+				res.Estado = domain.OperacionFallida
+				res.Err = err
+				res.Error = err.Error()
+				resultado.Fallidas++
+				if modo == domain.LoteAtomico {
+					return errLoteRevertido
+				}
+				continue
+			}
+			res.ID = id
+			res.Estado = domain.OperacionAplicada
+			resultado.Exitosas++
+		}
+		return nil
+	})
+
+	if errors.Is(err, errLoteRevertido) {
+		for i := range resultado.Resultados {
+			res := &resultado.Resultados[i]
+			if res.Estado == domain.OperacionAplicada {
+				res.Estado = domain.OperacionRevertida
+				if res.Op == domain.OperacionCrear {
+					res.ID = 0 // el ID asignado se descartó con el rollback
+				}
+			}
+		}
+		resultado.Exitosas = 0
+		return resultado, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resultado.Aplicado = true
+	return resultado, nil
+}
+
+// validarLote revisa el modo y el número de operaciones; un modo vacío es atómico
+func validarLote(modo string, n int) error {
+	if modo != "" && modo != domain.LoteAtomico && modo != domain.LoteParcial {
+		return fmt.Errorf("%w: modo debe ser atomico o parcial", apperrors.ErrInvalidInput)
+	}
+	if n == 0 || n > MaxOperacionesLote {
+		return fmt.Errorf("%w: el lote debe tener entre 1 y %d operaciones", apperrors.ErrInvalidInput, MaxOperacionesLote)
+	}
+	return nil
+}
+
+func applyOperacion[T any](ctx context.Context, op domain.OperacionLote[T], apply func(context.Context, domain.OperacionLote[T]) (uint, error)) (uint, error) {
+	switch op.Op {
+	case domain.OperacionCrear:
+		if op.Datos == nil {
+			return 0, fmt.Errorf("%w: datos es requerido para crear", apperrors.ErrInvalidInput)
+		}
+	case domain.OperacionActualizar:
+		if op.ID == 0 || op.Datos == nil {
+			return 0, fmt.Errorf("%w: id y datos son requeridos para actualizar", apperrors.ErrInvalidInput)
+		}
+	case domain.OperacionEliminar:
+		if op.ID == 0 {
+			return 0, fmt.Errorf("%w: id es requerido para eliminar", apperrors.ErrInvalidInput)
+		}
+	default:
+		return 0, fmt.Errorf("%w: op debe ser crear, actualizar o eliminar", apperrors.ErrInvalidInput)
+	}
+	return apply(ctx, op)
+}
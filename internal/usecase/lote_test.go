@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+)
+
+func nuevoProfesorUseCase(t *testing.T) (*ProfesorUseCase, *relational.ProfesorRepository) {
+	t.Helper()
+	db := storagetest.NewSQLite(t)
+	repo := relational.NewProfesorRepository(db)
+	return NewProfesorUseCase(repo, storage.NewTransactor(db), nil), repo
+}
+
+func crearProfesor(numero int) domain.OperacionLote[domain.Profesor] {
+	return domain.OperacionLote[domain.Profesor]{
+		Op:    domain.OperacionCrear,
+		Datos: &domain.Profesor{NumeroEmpleado: numero, Nombres: "Luis", Apellidos: "Pérez", HorasClase: 20},
+	}
+}
+
+func estados(resultado *domain.ResultadoLote) []string {
+	estados := make([]string, len(resultado.Resultados))
+	for i, res := range resultado.Resultados {
+		estados[i] = res.Estado
+	}
+	return estados
+}
+
+func contarProfesores(t *testing.T, repo *relational.ProfesorRepository) int {
+	t.Helper()
+	profesores, err := repo.GetAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(profesores)
+}
+
+func TestLoteAtomicoRevierte(t *testing.T) {
+	ctx := context.Background()
+	profesores, repo := nuevoProfesorUseCase(t)
+
+	resultado, err := profesores.Batch(ctx, domain.LoteAtomico, []domain.OperacionLote[domain.Profesor]{
+		crearProfesor(100),
+		crearProfesor(0), // numeroEmpleado requerido
+		crearProfesor(101),
+	})
+	if err != nil {
+		t.Fatalf("Batch: %v", err)
+	}
+
+	want := []string{domain.OperacionRevertida, domain.OperacionFallida, domain.OperacionOmitida}
+	if resultado.Aplicado || resultado.Exitosas != 0 || resultado.Fallidas != 1 || !slices.Equal(estados(resultado), want) {
+		t.Fatalf("resultado = %+v, estados %v", resultado, estados(resultado))
+	}
+	if resultado.Resultados[0].ID != 0 {
+		t.Errorf("la operación revertida conserva el ID %d", resultado.Resultados[0].ID)
+	}
+	var validacion *apperrors.ValidationErrors
+	if !errors.As(resultado.Resultados[1].Err, &validacion) {
+		t.Errorf("Err = %v, se esperaba ValidationErrors", resultado.Resultados[1].Err)
+	}
+	if n := contarProfesores(t, repo); n != 0 {
+		t.Fatalf("quedaron %d profesores de un lote revertido", n)
+	}
+}
+
+// En modo parcial un error de la base de datos solo revierte el savepoint de
+// su operación
+func TestLoteParcial(t *testing.T) {
+	ctx := context.Background()
+	profesores, repo := nuevoProfesorUseCase(t)
+
+	existente := &domain.Profesor{NumeroEmpleado: 1, Nombres: "Ana", Apellidos: "Ruiz", HorasClase: 10}
+	if err := profesores.Create(ctx, existente); err != nil {
+		t.Fatal(err)
+	}
+
+	resultado, err := profesores.Batch(ctx, domain.LoteParcial, []domain.OperacionLote[domain.Profesor]{
+		crearProfesor(200),
+		crearProfesor(1), // número de empleado duplicado
+		{Op: domain.OperacionEliminar, ID: 9999},
+		{Op: domain.OperacionActualizar, ID: existente.ID, Datos: &domain.Profesor{NumeroEmpleado: 1, Nombres: "Ana", Apellidos: "Ruiz", HorasClase: 12}},
+		{Op: "renombrar", ID: existente.ID},
+	})
+	if err != nil {
+		t.Fatalf("Batch: %v", err)
+	}
+
+	want := []string{domain.OperacionAplicada, domain.OperacionFallida, domain.OperacionFallida, domain.OperacionAplicada, domain.OperacionFallida}
+	if !resultado.Aplicado || resultado.Exitosas != 2 || resultado.Fallidas != 3 || !slices.Equal(estados(resultado), want) {
+		t.Fatalf("resultado = %+v, estados %v", resultado, estados(resultado))
+	}
+	if resultado.Resultados[0].ID == 0 {
+		t.Error("la alta aplicada no devuelve su ID")
+	}
+	if !errors.Is(resultado.Resultados[2].Err, apperrors.ErrNotFound) {
+		t.Errorf("eliminar inexistente: Err = %v", resultado.Resultados[2].Err)
+	}
+	if !errors.Is(resultado.Resultados[4].Err, apperrors.ErrInvalidInput) {
+		t.Errorf("op desconocida: Err = %v", resultado.Resultados[4].Err)
+	}
+
+	if n := contarProfesores(t, repo); n != 2 {
+		t.Fatalf("hay %d profesores, se esperaban 2", n)
+	}
+	actualizado, err := repo.GetByID(ctx, existente.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actualizado.HorasClase != 12 {
+		t.Errorf("horasClase = %d tras actualizar", actualizado.HorasClase)
+	}
+}
+
+func TestLoteValidaModoYTamaño(t *testing.T) {
+	ctx := context.Background()
+	profesores, _ := nuevoProfesorUseCase(t)
+
+	demasiadas := make([]domain.OperacionLote[domain.Profesor], MaxOperacionesLote+1)
+	for i := range demasiadas {
+		demasiadas[i] = crearProfesor(i + 1)
+	}
+	for nombre, tc := range map[string]struct {
+		modo string
+		ops  []domain.OperacionLote[domain.Profesor]
+	}{
+		"modo desconocido": {"todo", []domain.OperacionLote[domain.Profesor]{crearProfesor(1)}},
+		"lote vacío":       {domain.LoteParcial, nil},
+		"lote muy grande":  {domain.LoteAtomico, demasiadas},
+	} {
+		if _, err := profesores.Batch(ctx, tc.modo, tc.ops); !errors.Is(err, apperrors.ErrInvalidInput) {
+			t.Errorf("%s: Batch = %v, se esperaba ErrInvalidInput", nombre, err)
+		}
+	}
+
+	// Sin modo el lote es atómico
+	resultado, err := profesores.Batch(ctx, "", []domain.OperacionLote[domain.Profesor]{crearProfesor(1)})
+	if err != nil || resultado.Modo != domain.LoteAtomico || !resultado.Aplicado {
+		t.Fatalf("Batch sin modo = %+v, %v", resultado, err)
+	}
+}
+
+// cronometro mide cuánto dura cada transacción
+type cronometro struct {
+	port.Transactor
+	maxima time.Duration
+}
+
+func (c *cronometro) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	inicio := time.Now()
+	defer func() { c.maxima = max(c.maxima, time.Since(inicio)) }()
+	return c.Transactor.WithTransaction(ctx, fn)
+}
+
+// bcrypt corre antes de abrir la transacción y cada alumno recibe su propio hash
+func TestLoteAlumnosHasheaFueraDeLaTransaccion(t *testing.T) {
+	ctx := context.Background()
+	db := storagetest.NewSQLite(t)
+	repo := relational.NewAlumnoRepository(db)
+	transactor := &cronometro{Transactor: storage.NewTransactor(db)}
+	alumnos := NewAlumnoUseCase(repo, relational.NewDocumentoRepository(db), nil, transactor, relational.NewNotificacionRepository(db), nil, nil)
+
+	inicio := time.Now()
+	if _, err := utils.HashPassword("secreto123"); err != nil {
+		t.Fatal(err)
+	}
+	unHash := time.Since(inicio)
+
+	ops := []domain.OperacionLote[domain.Alumno]{
+		{Op: domain.OperacionCrear, Datos: nuevoAlumno("A001", "ana@example.com")},
+		{Op: domain.OperacionCrear, Datos: nuevoAlumno("A002", "beto@example.com")},
+		{Op: domain.OperacionCrear, Datos: nuevoAlumno("A003", "carla@example.com")},
+		{Op: domain.OperacionCrear, Datos: nuevoAlumno("A004", "dani@example.com")},
+	}
+	resultado, err := alumnos.Batch(ctx, domain.LoteAtomico, ops)
+	if err != nil || !resultado.Aplicado {
+		t.Fatalf("Batch = %+v, %v", resultado, err)
+	}
+	if transactor.maxima >= unHash {
+		t.Errorf("la transacción duró %v, más que un hash (%v)", transactor.maxima, unHash)
+	}
+
+	guardados, err := repo.GetAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashes := make(map[string]bool)
+	for _, alumno := range guardados {
+		if !utils.CheckPassword(alumno.Password, "secreto123") || hashes[alumno.Password] {
+			t.Errorf("%s: hash %q", alumno.Matricula, alumno.Password)
+		}
+		hashes[alumno.Password] = true
+	}
+}
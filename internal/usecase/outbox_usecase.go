@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+// OutboxConfig - Parámetros del despachador de la bandeja de salida
+type OutboxConfig struct {
+	BatchSize   int
+	MaxIntentos int           // al agotarlos la notificación pasa a fallida
+	BackoffBase time.Duration // espera tras el primer fallo; se duplica en cada intento
+	BackoffMax  time.Duration
+	Lease       time.Duration // tiempo reservado para entregar un lote antes de que otro lo tome
+}
+
+// OutboxUseCase - Entrega las notificaciones encoladas y permite reenviar las fallidas
+type OutboxUseCase struct {
+	repo     port.NotificacionRepository
+	notifier port.NotificationService
+	cfg      OutboxConfig
+}
+
+func NewOutboxUseCase(repo port.NotificacionRepository, notifier port.NotificationService, cfg OutboxConfig) *OutboxUseCase {
+	return &OutboxUseCase{
+		repo:     repo,
+		notifier: notifier,
+		cfg:      cfg,
+	}
+}
+
+const maxNotificacionesListadas = 100
+
+func (u *OutboxUseCase) GetAll(ctx context.Context, estado string) ([]domain.Notificacion, error) {
+	switch estado {
+	case "", domain.NotificacionPendiente, domain.NotificacionEnviada, domain.NotificacionFallida, domain.NotificacionOmitida:
+	default:
+		return nil, fmt.Errorf("%w: estado debe ser pendiente, enviada, fallida u omitida", apperrors.ErrInvalidInput)
+	}
+	return u.repo.GetAll(ctx, estado, maxNotificacionesListadas)
+}
+
+func (u *OutboxUseCase) GetByID(ctx context.Context, id uint) (*domain.Notificacion, error) {
+	notificacion, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if notificacion == nil {
+		return nil, apperrors.ErrNotFound
+	}
+	return notificacion, nil
+}
+
+// Replay devuelve una notificación fallida a la cola con los intentos en cero
+func (u *OutboxUseCase) Replay(ctx context.Context, id uint) (*domain.Notificacion, error) {
+	notificacion, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if notificacion.Estado != domain.NotificacionFallida {
+		return nil, fmt.Errorf("%w: solo se pueden reenviar notificaciones fallidas", apperrors.ErrInvalidInput)
+	}
+
+	reencolar(notificacion)
+	if err := u.repo.Update(ctx, notificacion); err != nil {
+		return nil, err
+	}
+	return notificacion, nil
+}
+
+// ReplayFallidas devuelve a la cola todas las notificaciones fallidas
+func (u *OutboxUseCase) ReplayFallidas(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		fallidas, err := u.repo.GetAll(ctx, domain.NotificacionFallida, maxNotificacionesListadas)
+		if err != nil {
+			return total, err
+		}
+		for i := range fallidas {
+			reencolar(&fallidas[i])
+			if err := u.repo.Update(ctx, &fallidas[i]); err != nil {
+				return total, err
+			}
+			total++
+		}
+		if len(fallidas) < maxNotificacionesListadas {
+			return total, nil
+		}
+	}
+}
+
+// OnAlumnoCreated suscribe al notificador el email del nuevo alumno. Corre en la
+// transacción del alta, así la suscripción no se pierde si el notificador no responde
+func (u *OutboxUseCase) OnAlumnoCreated(ctx context.Context, evento domain.AlumnoCreated) error {
+	if evento.Alumno.Email == "" {
+		return nil
+	}
+	return u.repo.Create(ctx, nuevaNotificacion(domain.NotificacionSuscripcion, evento.Alumno.Destinatario(), nil))
+}
+
+// OnAlumnoUpdated vuelve a suscribir al alumno si cambió su email
+func (u *OutboxUseCase) OnAlumnoUpdated(ctx context.Context, evento domain.AlumnoUpdated) error {
+	if evento.Alumno.Email == "" || evento.Alumno.Email == evento.Anterior.Email {
+		return nil
+	}
+	return u.repo.Create(ctx, nuevaNotificacion(domain.NotificacionSuscripcion, evento.Alumno.Destinatario(), nil))
+}
+
+// Run despacha la bandeja cada interval hasta que se cancele ctx
+func (u *OutboxUseCase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Vaciar lotes completos sin esperar al siguiente tick
+		for {
+			n, err := u.Dispatch(ctx)
+			if err != nil {
+				log.Printf("Error al despachar notificaciones: %v", err)
+			}
+			if err != nil || n < u.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch entrega un lote de notificaciones vencidas y devuelve cuántas tomó
+func (u *OutboxUseCase) Dispatch(ctx context.Context) (int, error) {
+	notificaciones, err := u.repo.ClaimPendientes(ctx, u.cfg.BatchSize, u.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range notificaciones {
+		notificacion := &notificaciones[i]
+		if err := u.deliver(ctx, notificacion); err != nil {
+			u.fail(notificacion, err)
+		} else {
+			now := time.Now()
+			notificacion.Estado = domain.NotificacionEnviada
+			notificacion.EnviadaEn = &now
+			notificacion.UltimoError = ""
+		}
+		notificacion.Intentos++
+
+		// Con ctx cancelado la reserva vence sola y la notificación se reintenta
+		if err := u.repo.Update(context.WithoutCancel(ctx), notificacion); err != nil {
+			return i, err
+		}
+	}
+	return len(notificaciones), nil
+}
+
+func (u *OutboxUseCase) deliver(ctx context.Context, notificacion *domain.Notificacion) error {
+	switch notificacion.Tipo {
+	case domain.NotificacionEnvio:
+		return u.notifier.Send(ctx, notificacion.Destinatario(), notificacion.Mensaje())
+	case domain.NotificacionAnuncio:
+		return u.notifier.Publish(ctx, notificacion.Asunto, notificacion.Texto)
+	case domain.NotificacionSuscripcion:
+		return u.notifier.Subscribe(ctx, notificacion.Destinatario())
+	default:
+		return fmt.Errorf("tipo de notificación desconocido: %s", notificacion.Tipo)
+	}
+}
+
+// fail programa el siguiente intento con backoff exponencial o, si se agotaron
+// los intentos, deja la notificación como fallida. Si el notificador no tiene
+// canal para el destinatario no se reintenta: queda omitida
+func (u *OutboxUseCase) fail(notificacion *domain.Notificacion, err error) {
+	notificacion.UltimoError = err.Error()
+	if errors.Is(err, domain.ErrCanalNoSoportado) {
+		notificacion.Estado = domain.NotificacionOmitida
+		return
+	}
+	if notificacion.Intentos+1 >= u.cfg.MaxIntentos {
+		notificacion.Estado = domain.NotificacionFallida
+		log.Printf("Notificación %d fallida tras %d intentos: %v", notificacion.ID, notificacion.Intentos+1, err)
+		return
+	}
+
+	notificacion.SiguienteIntento = time.Now().Add(u.cfg.backoff(notificacion.Intentos))
+}
+
+// backoff devuelve la espera tras el intento número intentos (desde 0): base, 2x, 4x... hasta BackoffMax
+func (cfg OutboxConfig) backoff(intentos int) time.Duration {
+	espera := cfg.BackoffBase << intentos
+	if espera <= 0 || espera > cfg.BackoffMax {
+		return cfg.BackoffMax
+	}
+	return espera
+}
+
+func reencolar(notificacion *domain.Notificacion) {
+	notificacion.Estado = domain.NotificacionPendiente
+	notificacion.Intentos = 0
+	notificacion.SiguienteIntento = time.Now()
+}
+
+func nuevaNotificacion(tipo string, to domain.Destinatario, mensaje *domain.Mensaje) *domain.Notificacion {
+	notificacion := &domain.Notificacion{
+		Tipo:             tipo,
+		Email:            to.Email,
+		Telefono:         to.Telefono,
+		Idioma:           to.Idioma,
+		Estado:           domain.NotificacionPendiente,
+		SiguienteIntento: time.Now(),
+	}
+	if mensaje != nil {
+		notificacion.Asunto = mensaje.Asunto
+		notificacion.Texto = mensaje.Texto
+		notificacion.HTML = mensaje.HTML
+	}
+	return notificacion
+}
@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/eventbus"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/plantillas"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/relational"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/adapter/storage/storagetest"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+)
+
+// fakeNotifier - port.NotificationService que registra los envíos y falla
+// mientras err no sea nil (errSMS, solo los envíos con teléfono)
+type fakeNotifier struct {
+	mu       sync.Mutex
+	err      error
+	errSMS   error
+	enviados []domain.Destinatario
+}
+
+func (n *fakeNotifier) Publish(ctx context.Context, subject, message string) error {
+	return n.registrar(domain.Destinatario{})
+}
+
+func (n *fakeNotifier) Send(ctx context.Context, to domain.Destinatario, mensaje domain.Mensaje) error {
+	if to.Telefono != "" && n.errSMS != nil {
+		return n.errSMS
+	}
+	return n.registrar(to)
+}
+
+func (n *fakeNotifier) Subscribe(ctx context.Context, to domain.Destinatario) error {
+	return n.registrar(to)
+}
+
+func (n *fakeNotifier) registrar(to domain.Destinatario) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.err != nil {
+		return n.err
+	}
+	n.enviados = append(n.enviados, to)
+	return nil
+}
+
+func nuevoAlumno(matricula, email string) *domain.Alumno {
+	return &domain.Alumno{
+		Nombres:   "Ana",
+		Apellidos: "García",
+		Matricula: matricula,
+		Promedio:  9.5,
+		Email:     email,
+		Password:  "secreto123",
+	}
+}
+
+func TestOutboxEncolaEnLaTransaccion(t *testing.T) {
+	ctx := context.Background()
+	db := storagetest.NewSQLite(t)
+	transactor := storage.NewTransactor(db)
+	notificaciones := relational.NewNotificacionRepository(db)
+	alumnos := relational.NewAlumnoRepository(db)
+
+	bus := eventbus.New()
+	outbox := NewOutboxUseCase(notificaciones, &fakeNotifier{}, OutboxConfig{BatchSize: 10, MaxIntentos: 3})
+	eventbus.On(bus, outbox.OnAlumnoCreated)
+	alumnoUseCase := NewAlumnoUseCase(alumnos, relational.NewDocumentoRepository(db), nil, transactor, notificaciones, nil, bus)
+
+	if err := alumnoUseCase.Create(ctx, nuevoAlumno("A001", "ana@example.com")); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	encoladas, err := notificaciones.GetAll(ctx, domain.NotificacionPendiente, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(encoladas) != 1 || encoladas[0].Tipo != domain.NotificacionSuscripcion || encoladas[0].Email != "ana@example.com" {
+		t.Fatalf("se esperaba la suscripción de ana@example.com, hay %+v", encoladas)
+	}
+
+	// Si la transacción del alta se revierte, la notificación tampoco queda
+	errRevertir := errors.New("revertir")
+	err = transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := alumnoUseCase.Create(ctx, nuevoAlumno("A002", "beto@example.com")); err != nil {
+			return err
+		}
+		return errRevertir
+	})
+	if !errors.Is(err, errRevertir) {
+		t.Fatalf("WithTransaction = %v", err)
+	}
+	encoladas, err = notificaciones.GetAll(ctx, "", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(encoladas) != 1 {
+		t.Fatalf("la notificación de un alta revertida quedó en la bandeja: %+v", encoladas)
+	}
+}
+
+func TestOutboxReintentaHastaFallar(t *testing.T) {
+	ctx := context.Background()
+	db := storagetest.NewSQLite(t)
+	repo := relational.NewNotificacionRepository(db)
+	notifier := &fakeNotifier{err: errors.New("SNS caído")}
+	outbox := NewOutboxUseCase(repo, notifier, OutboxConfig{
+		BatchSize:   10,
+		MaxIntentos: 3,
+		BackoffBase: time.Hour,
+		BackoffMax:  time.Hour,
+		Lease:       time.Minute,
+	})
+
+	notificacion := nuevaNotificacion(domain.NotificacionEnvio, domain.Destinatario{Email: "ana@example.com"}, &domain.Mensaje{Asunto: "Hola", Texto: "hola"})
+	if err := repo.Create(ctx, notificacion); err != nil {
+		t.Fatal(err)
+	}
+
+	for intento := 1; intento <= 3; intento++ {
+		n, err := outbox.Dispatch(ctx)
+		if err != nil || n != 1 {
+			t.Fatalf("intento %d: Dispatch = %d, %v", intento, n, err)
+		}
+		got, err := repo.GetByID(ctx, notificacion.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Intentos != intento || got.UltimoError != "SNS caído" {
+			t.Fatalf("intento %d: intentos=%d error=%q", intento, got.Intentos, got.UltimoError)
+		}
+		if intento < 3 {
+			if got.Estado != domain.NotificacionPendiente || got.SiguienteIntento.Before(time.Now().Add(30*time.Minute)) {
+				t.Fatalf("intento %d: estado=%s siguiente=%s; se esperaba pendiente con backoff", intento, got.Estado, got.SiguienteIntento)
+			}
+			// El backoff aún no vence: nadie la vuelve a tomar
+			if n, _ := outbox.Dispatch(ctx); n != 0 {
+				t.Fatalf("intento %d: se tomó una notificación antes de su siguiente intento", intento)
+			}
+			got.SiguienteIntento = time.Now()
+			if err := repo.Update(ctx, got); err != nil {
+				t.Fatal(err)
+			}
+		} else if got.Estado != domain.NotificacionFallida {
+			t.Fatalf("tras %d intentos estado = %s", intento, got.Estado)
+		}
+	}
+
+	// Replay la devuelve a la cola y, con el notificador de vuelta, se envía
+	if _, err := outbox.Replay(ctx, notificacion.ID); err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	notifier.err = nil
+	if n, err := outbox.Dispatch(ctx); err != nil || n != 1 {
+		t.Fatalf("Dispatch tras Replay = %d, %v", n, err)
+	}
+	got, err := repo.GetByID(ctx, notificacion.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Estado != domain.NotificacionEnviada || got.EnviadaEn == nil || got.UltimoError != "" {
+		t.Fatalf("tras Replay: %+v", got)
+	}
+	if len(notifier.enviados) != 1 || notifier.enviados[0].Email != "ana@example.com" {
+		t.Fatalf("enviados = %+v", notifier.enviados)
+	}
+}
+
+// Cada canal es una notificación aparte: reintentar el SMS no reenvía el correo
+func TestSendEmailUnaNotificacionPorCanal(t *testing.T) {
+	ctx := context.Background()
+	db := storagetest.NewSQLite(t)
+	repo := relational.NewNotificacionRepository(db)
+	renderer, err := plantillas.NewRenderer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alumnos := NewAlumnoUseCase(relational.NewAlumnoRepository(db), relational.NewDocumentoRepository(db), nil, storage.NewTransactor(db), repo, renderer, nil)
+	notifier := &fakeNotifier{errSMS: errors.New("SMS caído")}
+	outbox := NewOutboxUseCase(repo, notifier, OutboxConfig{BatchSize: 10, MaxIntentos: 3, Lease: time.Minute})
+
+	alumno := nuevoAlumno("A001", "ana@example.com")
+	alumno.Telefono = "+525512345678"
+	if err := alumnos.Create(ctx, alumno); err != nil {
+		t.Fatal(err)
+	}
+	if err := alumnos.SendEmail(ctx, alumno.ID); err != nil {
+		t.Fatalf("SendEmail: %v", err)
+	}
+	encoladas, err := repo.GetAll(ctx, domain.NotificacionPendiente, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(encoladas) != 2 {
+		t.Fatalf("se esperaban 2 notificaciones, hay %+v", encoladas)
+	}
+	for _, n := range encoladas {
+		if (n.Email == "") == (n.Telefono == "") || n.Asunto == "" {
+			t.Fatalf("se esperaba un solo canal por notificación: %+v", n)
+		}
+	}
+
+	// Con BackoffMax en cero el SMS se reintenta en cada Dispatch
+	for range 3 {
+		if _, err := outbox.Dispatch(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(notifier.enviados) != 1 || notifier.enviados[0].Email != "ana@example.com" {
+		t.Fatalf("enviados = %+v; el correo debió enviarse una sola vez", notifier.enviados)
+	}
+	fallidas, err := repo.GetAll(ctx, domain.NotificacionFallida, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fallidas) != 1 || fallidas[0].Telefono == "" {
+		t.Fatalf("fallidas = %+v; se esperaba solo el SMS", fallidas)
+	}
+}
+
+// Sin canal para el destinatario no se reintenta ni cuenta como enviada
+func TestOutboxOmiteCanalNoSoportado(t *testing.T) {
+	ctx := context.Background()
+	repo := relational.NewNotificacionRepository(storagetest.NewSQLite(t))
+	notifier := &fakeNotifier{errSMS: fmt.Errorf("%w: SMTP no envía SMS", domain.ErrCanalNoSoportado)}
+	outbox := NewOutboxUseCase(repo, notifier, OutboxConfig{BatchSize: 10, MaxIntentos: 3, Lease: time.Minute})
+
+	notificacion := nuevaNotificacion(domain.NotificacionEnvio, domain.Destinatario{Telefono: "+525512345678"}, &domain.Mensaje{Asunto: "Hola", Texto: "hola"})
+	if err := repo.Create(ctx, notificacion); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := outbox.Dispatch(ctx); err != nil || n != 1 {
+		t.Fatalf("Dispatch = %d, %v", n, err)
+	}
+	if n, _ := outbox.Dispatch(ctx); n != 0 {
+		t.Fatal("se reintentó una notificación omitida")
+	}
+
+	got, err := repo.GetByID(ctx, notificacion.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Estado != domain.NotificacionOmitida || got.EnviadaEn != nil || got.UltimoError == "" {
+		t.Fatalf("notificación = %+v; se esperaba omitida", got)
+	}
+	if omitidas, err := outbox.GetAll(ctx, domain.NotificacionOmitida); err != nil || len(omitidas) != 1 {
+		t.Fatalf("GetAll(omitida) = %d, %v", len(omitidas), err)
+	}
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	cfg := OutboxConfig{BackoffBase: time.Second, BackoffMax: 10 * time.Second}
+	for intentos, want := range map[int]time.Duration{
+		0:  time.Second,
+		1:  2 * time.Second,
+		3:  8 * time.Second,
+		4:  10 * time.Second,
+		70: 10 * time.Second, // el corrimiento se desborda
+	} {
+		if got := cfg.backoff(intentos); got != want {
+			t.Errorf("backoff(%d) = %s, se esperaba %s", intentos, got, want)
+		}
+	}
+}
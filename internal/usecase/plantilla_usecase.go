@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+)
+
+// DatosEjemploPlantilla - Claves de plantilla soportadas y los datos con los que
+// se validan y previsualizan; deben tener la misma forma que los que usa el caso de uso
+var DatosEjemploPlantilla = map[string]any{
+	domain.PlantillaCalificaciones: domain.DatosCalificaciones{
+		Nombres:   "Ana",
+		Apellidos: "García López",
+		Matricula: "A01234567",
+		Promedio:  9.35,
+		Email:     "ana.garcia@example.com",
+		Idioma:    domain.IdiomaPorDefecto,
+	},
+}
+
+type PlantillaUseCase struct {
+	repo     port.PlantillaRepository
+	renderer port.TemplateRenderer
+}
+
+func NewPlantillaUseCase(repo port.PlantillaRepository, renderer port.TemplateRenderer) *PlantillaUseCase {
+	return &PlantillaUseCase{
+		repo:     repo,
+		renderer: renderer,
+	}
+}
+
+func (u *PlantillaUseCase) GetAll(ctx context.Context) ([]domain.PlantillaNotificacion, error) {
+	return u.repo.GetAll(ctx)
+}
+
+func (u *PlantillaUseCase) GetByID(ctx context.Context, id uint) (*domain.PlantillaNotificacion, error) {
+	plantilla, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if plantilla == nil {
+		return nil, apperrors.ErrNotFound
+	}
+	return plantilla, nil
+}
+
+func (u *PlantillaUseCase) Create(ctx context.Context, plantilla *domain.PlantillaNotificacion) error {
+	if err := u.validate(ctx, plantilla); err != nil {
+		return err
+	}
+
+	existing, err := u.repo.GetByClave(ctx, plantilla.Clave, plantilla.Idioma)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("%w: ya hay una plantilla %s para el idioma %s", apperrors.ErrAlreadyExists, plantilla.Clave, plantilla.Idioma)
+	}
+
+	plantilla.ID = 0
+	if err := u.repo.Create(ctx, plantilla); err != nil {
+		return err
+	}
+	u.renderer.Invalidar(plantilla.Clave, plantilla.Idioma)
+	return nil
+}
+
+func (u *PlantillaUseCase) Update(ctx context.Context, id uint, plantilla *domain.PlantillaNotificacion) error {
+	existing, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return apperrors.ErrNotFound
+	}
+
+	if err := u.validate(ctx, plantilla); err != nil {
+		return err
+	}
+
+	duplicate, err := u.repo.GetByClave(ctx, plantilla.Clave, plantilla.Idioma)
+	if err != nil {
+		return err
+	}
+	if duplicate != nil && duplicate.ID != id {
+		return fmt.Errorf("%w: ya hay una plantilla %s para el idioma %s", apperrors.ErrAlreadyExists, plantilla.Clave, plantilla.Idioma)
+	}
+
+	// La clave o el idioma pueden cambiar: se invalidan el anterior y el nuevo
+	defer u.renderer.Invalidar(existing.Clave, existing.Idioma)
+	existing.Clave = plantilla.Clave
+	existing.Idioma = plantilla.Idioma
+	existing.Asunto = plantilla.Asunto
+	existing.Cuerpo = plantilla.Cuerpo
+	existing.CuerpoHTML = plantilla.CuerpoHTML
+
+	if err := u.repo.Update(ctx, existing); err != nil {
+		return err
+	}
+	*plantilla = *existing
+	u.renderer.Invalidar(existing.Clave, existing.Idioma)
+	return nil
+}
+
+func (u *PlantillaUseCase) Delete(ctx context.Context, id uint) error {
+	existing, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return apperrors.ErrNotFound
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	u.renderer.Invalidar(existing.Clave, existing.Idioma)
+	return nil
+}
+
+func (u *PlantillaUseCase) Preview(ctx context.Context, plantilla *domain.PlantillaNotificacion) (*domain.Mensaje, error) {
+	plantilla.Idioma = strings.ToLower(strings.TrimSpace(plantilla.Idioma))
+	if plantilla.Idioma == "" {
+		plantilla.Idioma = domain.IdiomaPorDefecto
+	}
+
+	datos, ok := DatosEjemploPlantilla[plantilla.Clave]
+	if !ok {
+		return nil, fmt.Errorf("%w: clave de plantilla no soportada: %s", apperrors.ErrInvalidInput, plantilla.Clave)
+	}
+	return u.renderer.RenderPlantilla(ctx, plantilla, datos)
+}
+
+// validate revisa los campos y ejecuta la plantilla con los datos de ejemplo,
+// así los errores de sintaxis o de campos inexistentes se detectan al guardar
+func (u *PlantillaUseCase) validate(ctx context.Context, plantilla *domain.PlantillaNotificacion) error {
+	plantilla.Clave = strings.TrimSpace(plantilla.Clave)
+	plantilla.Idioma = strings.ToLower(strings.TrimSpace(plantilla.Idioma))
+
+	validationErrors := utils.ValidatePlantilla(
+		plantilla.Clave,
+		plantilla.Idioma,
+		plantilla.Asunto,
+		plantilla.Cuerpo,
+	)
+	if _, ok := DatosEjemploPlantilla[plantilla.Clave]; plantilla.Clave != "" && !ok {
+		validationErrors.Add("clave", i18n.MsgClavePlantilla)
+	}
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	_, err := u.renderer.RenderPlantilla(ctx, plantilla, DatosEjemploPlantilla[plantilla.Clave])
+	return err
+}
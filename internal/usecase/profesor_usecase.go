@@ -3,19 +3,29 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
 	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/tabular"
 	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
 )
 
 type ProfesorUseCase struct {
-	repo port.ProfesorRepository
+	repo       port.ProfesorRepository
+	transactor port.Transactor
+	events     port.EventBus
 }
 
-func NewProfesorUseCase(repo port.ProfesorRepository) *ProfesorUseCase {
-	return &ProfesorUseCase{repo: repo}
+func NewProfesorUseCase(repo port.ProfesorRepository, transactor port.Transactor, events port.EventBus) *ProfesorUseCase {
+	return &ProfesorUseCase{
+		repo:       repo,
+		transactor: transactor,
+		events:     events,
+	}
 }
 
 func (u *ProfesorUseCase) GetAll(ctx context.Context) ([]domain.Profesor, error) {
@@ -41,10 +51,15 @@ func (u *ProfesorUseCase) Create(ctx context.Context, profesor *domain.Profesor)
 		profesor.HorasClase,
 	)
 	if validationErrors.HasErrors() {
-		return fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, validationErrors.Errors)
+		return validationErrors
 	}
 
-	return u.repo.Create(ctx, profesor)
+	return u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.repo.Create(ctx, profesor); err != nil {
+			return err
+		}
+		return u.publish(ctx, domain.ProfesorCreated{Profesor: *profesor})
+	})
 }
 
 func (u *ProfesorUseCase) Update(ctx context.Context, id uint, profesor *domain.Profesor) error {
@@ -63,15 +78,21 @@ func (u *ProfesorUseCase) Update(ctx context.Context, id uint, profesor *domain.
 		profesor.HorasClase,
 	)
 	if validationErrors.HasErrors() {
-		return fmt.Errorf("%w: %v", apperrors.ErrInvalidInput, validationErrors.Errors)
+		return validationErrors
 	}
 
+	anterior := *existing
 	existing.NumeroEmpleado = profesor.NumeroEmpleado
 	existing.Nombres = profesor.Nombres
 	existing.Apellidos = profesor.Apellidos
 	existing.HorasClase = profesor.HorasClase
 
-	return u.repo.Update(ctx, existing)
+	return u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.repo.Update(ctx, existing); err != nil {
+			return err
+		}
+		return u.publish(ctx, domain.ProfesorUpdated{Profesor: *existing, Anterior: anterior})
+	})
 }
 
 func (u *ProfesorUseCase) Delete(ctx context.Context, id uint) error {
@@ -83,5 +104,153 @@ func (u *ProfesorUseCase) Delete(ctx context.Context, id uint) error {
 		return apperrors.ErrNotFound
 	}
 
-	return u.repo.Delete(ctx, id)
+	return u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return u.publish(ctx, domain.ProfesorDeleted{Profesor: *existing})
+	})
+}
+
+// Batch aplica operaciones de crear, actualizar y eliminar en una sola
+// transacción, con las mismas reglas que Create, Update y Delete
+func (u *ProfesorUseCase) Batch(ctx context.Context, modo string, ops []domain.OperacionLote[domain.Profesor]) (*domain.ResultadoLote, error) {
+	return ejecutarLote(ctx, u.transactor, modo, ops, func(ctx context.Context, op domain.OperacionLote[domain.Profesor]) (uint, error) {
+		switch op.Op {
+		case domain.OperacionCrear:
+			err := u.Create(ctx, op.Datos)
+			return op.Datos.ID, err
+		case domain.OperacionActualizar:
+			return op.ID, u.Update(ctx, op.ID, op.Datos)
+		default:
+			return op.ID, u.Delete(ctx, op.ID)
+		}
+	})
+}
+
+// Import crea o actualiza profesores por número de empleado, con las mismas
+// reglas que AlumnoUseCase.Import
+func (u *ProfesorUseCase) Import(ctx context.Context, records []tabular.Record, dryRun bool) (*domain.ResultadoImportacion, error) {
+	if len(records) > MaxFilasImportacion {
+		return nil, fmt.Errorf("%w: el archivo excede el máximo de %d filas", apperrors.ErrInvalidInput, MaxFilasImportacion)
+	}
+
+	profesores := make([]domain.Profesor, 0, len(records))
+	erroresPorFila := make([]*apperrors.ValidationErrors, 0, len(records))
+	numeros := make([]int, 0, len(records))
+	for _, record := range records {
+		profesor, errores := profesorFromRecord(record)
+		profesores = append(profesores, profesor)
+		erroresPorFila = append(erroresPorFila, errores)
+		if profesor.NumeroEmpleado > 0 {
+			numeros = append(numeros, profesor.NumeroEmpleado)
+		}
+	}
+	existentes, err := u.repo.GetByNumerosEmpleado(ctx, numeros)
+	if err != nil {
+		return nil, err
+	}
+	porNumero := make(map[int]*domain.Profesor, len(existentes))
+	for i := range existentes {
+		porNumero[existentes[i].NumeroEmpleado] = &existentes[i]
+	}
+
+	resultado := &domain.ResultadoImportacion{DryRun: dryRun, Filas: make([]domain.FilaImportada, 0, len(records))}
+	vistas := make(map[int]int, len(records))
+	for i, record := range records {
+		profesor, errores := profesores[i], erroresPorFila[i]
+
+		validationErrors := utils.ValidateProfesor(
+			profesor.NumeroEmpleado,
+			profesor.Nombres,
+			profesor.Apellidos,
+			profesor.HorasClase,
+		)
+		mergeErrores(errores, validationErrors)
+		if fila, ok := vistas[profesor.NumeroEmpleado]; ok && profesor.NumeroEmpleado > 0 {
+			errores.Add("numeroEmpleado", i18n.MsgCampoRepetido, "numeroEmpleado", fila)
+		} else {
+			vistas[profesor.NumeroEmpleado] = record.Line
+		}
+
+		fila := domain.FilaImportada{Fila: record.Line, Clave: strings.TrimSpace(record.Get("numeroEmpleado")), Accion: domain.ImportacionCrear, Errores: errores.Errors}
+		if porNumero[profesor.NumeroEmpleado] != nil {
+			fila.Accion = domain.ImportacionActualizar
+		}
+		resultado.Add(fila)
+	}
+	if dryRun || resultado.ConErrores > 0 {
+		return resultado, nil
+	}
+
+	err = u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		for i := range profesores {
+			profesor := &profesores[i]
+			existing := porNumero[profesor.NumeroEmpleado]
+			if existing == nil {
+				if err := u.repo.Create(ctx, profesor); err != nil {
+					return err
+				}
+				if err := u.publish(ctx, domain.ProfesorCreated{Profesor: *profesor}); err != nil {
+					return err
+				}
+				continue
+			}
+
+			anterior := *existing
+			existing.Nombres = profesor.Nombres
+			existing.Apellidos = profesor.Apellidos
+			existing.HorasClase = profesor.HorasClase
+			if err := u.repo.Update(ctx, existing); err != nil {
+				return err
+			}
+			if err := u.publish(ctx, domain.ProfesorUpdated{Profesor: *existing, Anterior: anterior}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resultado.Aplicado = true
+	return resultado, nil
+}
+
+// profesorFromRecord convierte una fila; los errores de formato se reportan por campo
+func profesorFromRecord(record tabular.Record) (domain.Profesor, *apperrors.ValidationErrors) {
+	errores := &apperrors.ValidationErrors{}
+	profesor := domain.Profesor{
+		Nombres:   strings.TrimSpace(record.Get("nombres")),
+		Apellidos: strings.TrimSpace(record.Get("apellidos")),
+	}
+	if value := strings.TrimSpace(record.Get("numeroEmpleado")); value != "" {
+		numero, err := parseEntero(value)
+		if err != nil {
+			errores.Add("numeroEmpleado", i18n.MsgCampoEntero, "numeroEmpleado")
+		}
+		profesor.NumeroEmpleado = numero
+	}
+	if value := strings.TrimSpace(record.Get("horasClase")); value != "" {
+		horas, err := parseEntero(value)
+		if err != nil {
+			errores.Add("horasClase", i18n.MsgCampoEntero, "horasClase")
+		}
+		profesor.HorasClase = horas
+	}
+	return profesor, errores
+}
+
+// Export escribe todos los registros en el formato pedido (csv, xlsx o ndjson).
+// La tabla se recorre por lotes, sin cargarla en memoria.
+func (u *ProfesorUseCase) Export(ctx context.Context, w io.Writer, format string, progress func(procesados int)) error {
+	return exportar(ctx, w, format, profesorExportHeader, u.repo.Each, profesorExportRow, progress)
+}
+
+func (u *ProfesorUseCase) publish(ctx context.Context, evento domain.Evento) error {
+	if u.events == nil {
+		return nil
+	}
+	return u.events.Publish(ctx, evento)
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
@@ -14,12 +15,14 @@ import (
 type SesionUseCase struct {
 	sesionRepo port.SesionRepository
 	alumnoRepo port.AlumnoRepository
+	events     port.EventBus
 }
 
-func NewSesionUseCase(sesionRepo port.SesionRepository, alumnoRepo port.AlumnoRepository) *SesionUseCase {
+func NewSesionUseCase(sesionRepo port.SesionRepository, alumnoRepo port.AlumnoRepository, events port.EventBus) *SesionUseCase {
 	return &SesionUseCase{
 		sesionRepo: sesionRepo,
 		alumnoRepo: alumnoRepo,
+		events:     events,
 	}
 }
 
@@ -33,7 +36,7 @@ func (u *SesionUseCase) Login(ctx context.Context, alumnoID uint, password strin
 	}
 
 	if !utils.CheckPassword(alumno.Password, password) {
-		return nil, apperrors.ErrUnauthorized
+		return nil, apperrors.ErrInvalidCredentials
 	}
 
 	sessionString, err := utils.GenerateSessionString()
@@ -53,13 +56,18 @@ func (u *SesionUseCase) Login(ctx context.Context, alumnoID uint, password strin
 		return nil, err
 	}
 
+	// La sesión ya existe aunque falle un suscriptor, así que solo se registra
+	if err := u.events.Publish(ctx, domain.SesionStarted{SesionID: sesion.ID, AlumnoID: alumnoID}); err != nil {
+		log.Printf("Error al publicar inicio de sesión de %d: %v", alumnoID, err)
+	}
+
 	return sesion, nil
 }
 
 func (u *SesionUseCase) Verify(ctx context.Context, alumnoID uint, sessionString string) error {
 	validationErrors := utils.ValidateSessionString(sessionString)
 	if validationErrors.HasErrors() {
-		return apperrors.ErrInvalidInput
+		return validationErrors
 	}
 
 	sesion, err := u.sesionRepo.GetBySessionString(ctx, sessionString)
@@ -67,15 +75,15 @@ func (u *SesionUseCase) Verify(ctx context.Context, alumnoID uint, sessionString
 		return err
 	}
 	if sesion == nil {
-		return apperrors.ErrUnauthorized
+		return apperrors.ErrInvalidSession
 	}
 
 	if sesion.AlumnoID != alumnoID {
-		return apperrors.ErrUnauthorized
+		return apperrors.ErrInvalidSession
 	}
 
 	if !sesion.Active {
-		return apperrors.ErrUnauthorized
+		return apperrors.ErrInvalidSession
 	}
 
 	return nil
@@ -84,7 +92,7 @@ func (u *SesionUseCase) Verify(ctx context.Context, alumnoID uint, sessionString
 func (u *SesionUseCase) Logout(ctx context.Context, alumnoID uint, sessionString string) error {
 	validationErrors := utils.ValidateSessionString(sessionString)
 	if validationErrors.HasErrors() {
-		return apperrors.ErrInvalidInput
+		return validationErrors
 	}
 
 	sesion, err := u.sesionRepo.GetBySessionString(ctx, sessionString)
@@ -96,8 +104,15 @@ func (u *SesionUseCase) Logout(ctx context.Context, alumnoID uint, sessionString
 	}
 
 	if sesion.AlumnoID != alumnoID {
-		return apperrors.ErrUnauthorized
+		return apperrors.ErrInvalidSession
 	}
 
-	return u.sesionRepo.Deactivate(ctx, sesion.ID)
+	if err := u.sesionRepo.Deactivate(ctx, sesion.SessionString); err != nil {
+		return err
+	}
+
+	if err := u.events.Publish(ctx, domain.SesionEnded{SesionID: sesion.ID, AlumnoID: alumnoID}); err != nil {
+		log.Printf("Error al publicar fin de sesión de %d: %v", alumnoID, err)
+	}
+	return nil
 }
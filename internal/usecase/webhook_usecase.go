@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/domain"
+	"github.com/abrahamcruzc/aws-segundaentrega/internal/port"
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+)
+
+const maxEntregasListadas = 100
+
+// WebhookUseCase - Administra las suscripciones, encola los eventos y entrega
+// las entregas pendientes con los mismos reintentos que la bandeja de salida
+type WebhookUseCase struct {
+	repo       port.WebhookRepository
+	entregas   port.WebhookEntregaRepository
+	sender     port.WebhookSender
+	transactor port.Transactor
+	cfg        OutboxConfig
+}
+
+func NewWebhookUseCase(repo port.WebhookRepository, entregas port.WebhookEntregaRepository, sender port.WebhookSender, transactor port.Transactor, cfg OutboxConfig) *WebhookUseCase {
+	return &WebhookUseCase{
+		repo:       repo,
+		entregas:   entregas,
+		sender:     sender,
+		transactor: transactor,
+		cfg:        cfg,
+	}
+}
+
+func (u *WebhookUseCase) GetAll(ctx context.Context) ([]domain.WebhookSuscripcion, error) {
+	suscripciones, err := u.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range suscripciones {
+		suscripciones[i].Secret = ""
+	}
+	return suscripciones, nil
+}
+
+func (u *WebhookUseCase) GetByID(ctx context.Context, id uint) (*domain.WebhookSuscripcion, error) {
+	suscripcion, err := u.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	suscripcion.Secret = ""
+	return suscripcion, nil
+}
+
+// Create genera el secreto si no se envía; es la única respuesta que lo incluye
+func (u *WebhookUseCase) Create(ctx context.Context, suscripcion *domain.WebhookSuscripcion) error {
+	validationErrors := utils.ValidateWebhook(suscripcion.URL, suscripcion.Eventos, domain.Eventos)
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	if suscripcion.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return fmt.Errorf("error al generar secreto: %w", err)
+		}
+		suscripcion.Secret = hex.EncodeToString(secret)
+	}
+	activa := true
+	suscripcion.ID = 0
+	suscripcion.Activa = &activa
+
+	return u.repo.Create(ctx, suscripcion)
+}
+
+// Update reemplaza URL y eventos; el estado y el secreto solo cambian si se envían
+func (u *WebhookUseCase) Update(ctx context.Context, id uint, suscripcion *domain.WebhookSuscripcion) error {
+	existing, err := u.get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	validationErrors := utils.ValidateWebhook(suscripcion.URL, suscripcion.Eventos, domain.Eventos)
+	if validationErrors.HasErrors() {
+		return validationErrors
+	}
+
+	existing.URL = suscripcion.URL
+	existing.Eventos = suscripcion.Eventos
+	if suscripcion.Activa != nil {
+		existing.Activa = suscripcion.Activa
+	}
+	if suscripcion.Secret != "" {
+		existing.Secret = suscripcion.Secret
+	}
+
+	if err := u.repo.Update(ctx, existing); err != nil {
+		return err
+	}
+	*suscripcion = *existing
+	suscripcion.Secret = ""
+	return nil
+}
+
+// Delete elimina la suscripción junto con su bitácora de entregas
+func (u *WebhookUseCase) Delete(ctx context.Context, id uint) error {
+	if _, err := u.get(ctx, id); err != nil {
+		return err
+	}
+
+	return u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.entregas.DeleteBySuscripcion(ctx, id); err != nil {
+			return err
+		}
+		return u.repo.Delete(ctx, id)
+	})
+}
+
+func (u *WebhookUseCase) GetEntregas(ctx context.Context, id uint) ([]domain.WebhookEntrega, error) {
+	if _, err := u.get(ctx, id); err != nil {
+		return nil, err
+	}
+	return u.entregas.GetBySuscripcion(ctx, id, maxEntregasListadas)
+}
+
+// ReplayEntrega vuelve a encolar una entrega fallida con los intentos en cero
+func (u *WebhookUseCase) ReplayEntrega(ctx context.Context, id uint, entregaID uint) (*domain.WebhookEntrega, error) {
+	entrega, err := u.entregas.GetByID(ctx, entregaID)
+	if err != nil {
+		return nil, err
+	}
+	if entrega == nil || entrega.SuscripcionID != id {
+		return nil, apperrors.ErrNotFound
+	}
+	if entrega.Estado != domain.EntregaFallida {
+		return nil, fmt.Errorf("%w: solo se pueden reenviar entregas fallidas", apperrors.ErrInvalidInput)
+	}
+
+	entrega.Estado = domain.EntregaPendiente
+	entrega.Intentos = 0
+	entrega.SiguienteIntento = time.Now()
+	if err := u.entregas.Update(ctx, entrega); err != nil {
+		return nil, err
+	}
+	return entrega, nil
+}
+
+// Handle encola el evento para cada suscripción activa que lo escucha. Se suscribe
+// al bus, así corre dentro de la transacción del cambio y solo sale si se guarda
+func (u *WebhookUseCase) Handle(ctx context.Context, evento domain.EventoPublicado) error {
+	suscripciones, err := u.repo.GetActivas(ctx)
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	for _, suscripcion := range suscripciones {
+		if !suscripcion.Acepta(evento.Nombre) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(evento)
+			if err != nil {
+				return fmt.Errorf("error al serializar evento %s: %w", evento.Nombre, err)
+			}
+		}
+
+		err := u.entregas.Create(ctx, &domain.WebhookEntrega{
+			SuscripcionID:    suscripcion.ID,
+			EventoID:         evento.ID,
+			Evento:           evento.Nombre,
+			Payload:          string(payload),
+			Estado:           domain.EntregaPendiente,
+			SiguienteIntento: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run despacha las entregas cada interval hasta que se cancele ctx
+func (u *WebhookUseCase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := u.Dispatch(ctx)
+			if err != nil {
+				log.Printf("Error al despachar webhooks: %v", err)
+			}
+			if err != nil || n < u.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch envía un lote de entregas vencidas y devuelve cuántas tomó
+func (u *WebhookUseCase) Dispatch(ctx context.Context) (int, error) {
+	entregas, err := u.entregas.ClaimPendientes(ctx, u.cfg.BatchSize, u.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	suscripciones := make(map[uint]*domain.WebhookSuscripcion)
+	for i := range entregas {
+		entrega := &entregas[i]
+
+		suscripcion, ok := suscripciones[entrega.SuscripcionID]
+		if !ok {
+			suscripcion, err = u.repo.GetByID(ctx, entrega.SuscripcionID)
+			if err != nil {
+				return i, err
+			}
+			suscripciones[entrega.SuscripcionID] = suscripcion
+		}
+
+		if suscripcion == nil || !suscripcion.EstaActiva() {
+			entrega.Estado = domain.EntregaFallida
+			entrega.UltimoError = "suscripción eliminada o inactiva"
+		} else {
+			status, err := u.sender.Send(ctx, suscripcion.URL, suscripcion.Secret, entrega)
+			entrega.UltimoStatus = status
+			if err != nil {
+				u.fail(entrega, err)
+			} else {
+				now := time.Now()
+				entrega.Estado = domain.EntregaEnviada
+				entrega.EnviadaEn = &now
+				entrega.UltimoError = ""
+			}
+			entrega.Intentos++
+		}
+
+		if err := u.entregas.Update(context.WithoutCancel(ctx), entrega); err != nil {
+			return i, err
+		}
+	}
+	return len(entregas), nil
+}
+
+func (u *WebhookUseCase) fail(entrega *domain.WebhookEntrega, err error) {
+	entrega.UltimoError = err.Error()
+	if entrega.Intentos+1 >= u.cfg.MaxIntentos {
+		entrega.Estado = domain.EntregaFallida
+		log.Printf("Entrega de webhook %d fallida tras %d intentos: %v", entrega.ID, entrega.Intentos+1, err)
+		return
+	}
+	entrega.SiguienteIntento = time.Now().Add(u.cfg.backoff(entrega.Intentos))
+}
+
+func (u *WebhookUseCase) get(ctx context.Context, id uint) (*domain.WebhookSuscripcion, error) {
+	suscripcion, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if suscripcion == nil {
+		return nil, apperrors.ErrNotFound
+	}
+	return suscripcion, nil
+}
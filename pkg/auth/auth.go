@@ -0,0 +1,95 @@
+// Package auth identifica quién hace una petición: un alumno con una sesión
+// verificada o un administrador con el token de ADMIN_API_TOKEN. El
+// Principal viaja en el contexto para que adaptadores y casos de uso lo lean.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strconv"
+	"strings"
+
+	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+)
+
+// HeaderAlumnoID - Header (o metadato gRPC) con el alumno dueño de la sesión
+// que va en Authorization
+const HeaderAlumnoID = "X-Alumno-ID"
+
+// Principal - Identidad verificada de la petición
+type Principal struct {
+	AlumnoID uint // 0 para el administrador
+	Admin    bool
+}
+
+// String identifica al principal en registros como subidoPor
+func (p Principal) String() string {
+	if p.Admin {
+		return "admin"
+	}
+	return "alumno:" + strconv.FormatUint(uint64(p.AlumnoID), 10)
+}
+
+// PuedeActuarSobre indica si p puede leer o modificar los datos del alumno id
+func (p Principal) PuedeActuarSobre(alumnoID uint) bool {
+	return p.Admin || p.AlumnoID == alumnoID
+}
+
+type ctxKey struct{}
+
+// WithPrincipal devuelve ctx con el principal de la petición
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, ctxKey{}, p)
+}
+
+// FromContext devuelve el principal guardado en ctx; false si la petición es anónima
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(ctxKey{}).(Principal)
+	return p, ok
+}
+
+// Verifier - Verifica que una sesión pertenezca al alumno y siga activa
+// (port.SesionService la cumple)
+type Verifier interface {
+	Verify(ctx context.Context, alumnoID uint, sessionString string) error
+}
+
+// Authenticator - Resuelve el principal a partir de "Authorization: Bearer
+// <token>" y del ID de alumno que lo acompaña
+type Authenticator struct {
+	sesiones   Verifier
+	adminToken string
+}
+
+// NewAuthenticator crea el autenticador; con adminToken vacío no hay administrador
+func NewAuthenticator(sesiones Verifier, adminToken string) *Authenticator {
+	return &Authenticator{sesiones: sesiones, adminToken: adminToken}
+}
+
+// Authenticate devuelve ErrUnauthorized si faltan las credenciales y
+// ErrInvalidSession si la sesión no es del alumno o ya no está activa. El
+// token de administrador no necesita ID de alumno
+func (a *Authenticator) Authenticate(ctx context.Context, alumnoID, authorization string) (Principal, error) {
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || token == "" {
+		return Principal{}, apperrors.ErrUnauthorized
+	}
+	if a.adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.adminToken)) == 1 {
+		return Principal{Admin: true}, nil
+	}
+
+	id, err := strconv.ParseUint(alumnoID, 10, 32)
+	if err != nil || id == 0 {
+		return Principal{}, apperrors.ErrUnauthorized
+	}
+	err = a.sesiones.Verify(ctx, uint(id), token)
+	if errors.Is(err, apperrors.ErrInvalidInput) {
+		// Un token mal formado se trata igual que una sesión inexistente
+		err = apperrors.ErrInvalidSession
+	}
+	if err != nil {
+		return Principal{}, err
+	}
+	return Principal{AlumnoID: uint(id)}, nil
+}
@@ -1,32 +1,75 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"strings"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
+)
 
 var (
-	ErrNotFound       = errors.New("recurso no encontrado")
-	ErrInvalidInput   = errors.New("datos de entrada inválidos")
-	ErrUnauthorized   = errors.New("no autorizado")
-	ErrAlreadyExists  = errors.New("el recurso ya existe")
-	ErrInternalServer = errors.New("error interno del servidor")
-	ErrInvalidSession = errors.New("sesión inválida o expirada")
+	ErrNotFound           = errors.New("recurso no encontrado")
+	ErrInvalidInput       = errors.New("datos de entrada inválidos")
+	ErrUnauthorized       = errors.New("no autorizado")
+	ErrForbidden          = errors.New("acceso denegado")
+	ErrAlreadyExists      = errors.New("el recurso ya existe")
+	ErrConflict           = errors.New("la operación no es válida en el estado actual del recurso")
+	ErrInternalServer     = errors.New("error interno del servidor")
+	ErrInvalidSession     = errors.New("sesión inválida o expirada")
+	ErrInvalidCredentials = errors.New("credenciales inválidas")
 )
 
+// ValidationError - Error de un campo. Code es el ID del mensaje en pkg/i18n y
+// Message su texto en el idioma por defecto
 type ValidationError struct {
 	Field   string `json:"field"`
+	Code    string `json:"code"`
 	Message string `json:"message"`
+	Params  []any  `json:"-"`
 }
 
+// ValidationErrors - Errores por campo. Como error equivale a ErrInvalidInput
+// (errors.Is) y conserva la lista para que el handler la devuelva estructurada
 type ValidationErrors struct {
 	Errors []ValidationError `json:"errors"`
 }
 
-func (v *ValidationErrors) Add(field, message string) {
+func (v *ValidationErrors) Add(field, code string, params ...any) {
 	v.Errors = append(v.Errors, ValidationError{
 		Field:   field,
-		Message: message,
+		Code:    code,
+		Message: i18n.T(i18n.Default, code, params...),
+		Params:  params,
 	})
 }
 
 func (v *ValidationErrors) HasErrors() bool {
 	return len(v.Errors) > 0
 }
+
+func (v *ValidationErrors) Error() string {
+	messages := make([]string, len(v.Errors))
+	for i, e := range v.Errors {
+		messages[i] = e.Field + ": " + e.Message
+	}
+	return ErrInvalidInput.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (v *ValidationErrors) Unwrap() error {
+	return ErrInvalidInput
+}
+
+// Localize devuelve una copia de errs con los mensajes traducidos a lang
+func Localize(errs []ValidationError, lang string) []ValidationError {
+	if len(errs) == 0 || lang == i18n.Default {
+		return errs
+	}
+	localized := make([]ValidationError, len(errs))
+	for i, e := range errs {
+		localized[i] = e
+		if e.Code != "" {
+			localized[i].Message = i18n.T(lang, e.Code, e.Params...)
+		}
+	}
+	return localized
+}
@@ -0,0 +1,112 @@
+// Package i18n traduce los mensajes de la API. Cada mensaje tiene un ID estable
+// y una traducción por idioma; el idioma de la petición viaja en el contexto.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Default - Idioma en el que están escritos los mensajes de origen
+const Default = "es"
+
+// Supported - Idiomas con catálogo, en orden de preferencia
+var Supported = []string{"es", "en"}
+
+type ctxKey struct{}
+
+// WithLang devuelve ctx con el idioma de la petición
+func WithLang(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, lang)
+}
+
+// FromContext devuelve el idioma guardado en ctx o Default
+func FromContext(ctx context.Context) string {
+	if lang, ok := ctx.Value(ctxKey{}).(string); ok && lang != "" {
+		return lang
+	}
+	return Default
+}
+
+// HasLang indica si ctx trae un idioma negociado con el cliente
+func HasLang(ctx context.Context) bool {
+	lang, ok := ctx.Value(ctxKey{}).(string)
+	return ok && lang != ""
+}
+
+// T traduce id a lang con args al estilo fmt. Si lang no tiene el mensaje usa
+// Default y, si tampoco existe, devuelve id tal cual, así que un texto libre
+// pasa sin cambios.
+func T(lang, id string, args ...any) string {
+	format, ok := catalogo[lang][id]
+	if !ok {
+		format, ok = catalogo[Default][id]
+	}
+	if !ok {
+		format = id
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Tr traduce id al idioma de ctx
+func Tr(ctx context.Context, id string, args ...any) string {
+	return T(FromContext(ctx), id, args...)
+}
+
+// Negotiate elige el idioma soportado que mejor coincide con un encabezado
+// Accept-Language ("en-US,en;q=0.9,es;q=0.8"). Compara por idioma base y
+// devuelve "" si ninguno coincide. "*" acepta cualquier idioma salvo los
+// excluidos con q=0.
+func Negotiate(acceptLanguage string) string {
+	type rango struct {
+		lang string
+		q    float64
+	}
+	var rangos []rango
+	excluidos := make(map[string]bool)
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			excluidos[tag] = true
+			continue
+		}
+		rangos = append(rangos, rango{tag, q})
+	}
+	sort.SliceStable(rangos, func(i, j int) bool { return rangos[i].q > rangos[j].q })
+
+	for _, r := range rangos {
+		if r.lang == "*" {
+			for _, lang := range Supported {
+				if !excluidos[lang] {
+					return lang
+				}
+			}
+			return ""
+		}
+		base, _, _ := strings.Cut(r.lang, "-")
+		for _, lang := range Supported {
+			if base == lang {
+				return lang
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,117 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	for _, tc := range []struct {
+		acceptLanguage string
+		lang           string
+	}{
+		{"en", "en"},
+		{"es", "es"},
+		{"en-US,en;q=0.9,es;q=0.8", "en"},
+		{"EN-gb", "en"},
+		{"es-MX", "es"},
+		{"es;q=0.5, en;q=0.9", "en"},
+		{"de, en;q=0.1", "en"},
+		{"*", Default},
+		{"fr, *;q=0.5", Default},
+		{"es;q=0, *", "en"},
+		{"en;q=0", ""},
+		{"en;q=0, es", "es"},
+		{"en;q=abc, es;q=0.5", "es"},
+		{"en;q=, es;q=0.5", "es"},
+		{"fr, de-DE", ""},
+		{"", ""},
+		{" , ;q=1", ""},
+	} {
+		if got := Negotiate(tc.acceptLanguage); got != tc.lang {
+			t.Errorf("Negotiate(%q) = %q, se esperaba %q", tc.acceptLanguage, got, tc.lang)
+		}
+	}
+}
+
+// ids devuelve los valores de las constantes Msg* declaradas en mensajes.go
+func ids(t *testing.T) []string {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "mensajes.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || !strings.HasPrefix(spec.Names[0].Name, "Msg") || len(spec.Values) != 1 {
+			return true
+		}
+		if lit, ok := spec.Values[0].(*ast.BasicLit); ok {
+			id, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, id)
+		}
+		return true
+	})
+	return ids
+}
+
+var verbo = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+// verbos devuelve los verbos de formato de un mensaje, sin contar %%
+func verbos(format string) []string {
+	var verbos []string
+	for _, v := range verbo.FindAllString(format, -1) {
+		if v != "%%" {
+			verbos = append(verbos, v)
+		}
+	}
+	return verbos
+}
+
+// Cada mensaje del idioma por defecto existe en los demás con los mismos
+// argumentos de formato, en el mismo orden
+func TestCatalogoCompleto(t *testing.T) {
+	mensajes := ids(t)
+	if len(mensajes) == 0 {
+		t.Fatal("no se encontraron constantes Msg* en mensajes.go")
+	}
+	for _, id := range mensajes {
+		if _, ok := catalogo[Default][id]; !ok {
+			t.Errorf("%s no está en el catálogo %s", id, Default)
+		}
+	}
+
+	for _, lang := range Supported {
+		for id, format := range catalogo[Default] {
+			traduccion, ok := catalogo[lang][id]
+			if !ok {
+				t.Errorf("%s: falta %s", lang, id)
+				continue
+			}
+			if !slices.Equal(verbos(format), verbos(traduccion)) {
+				t.Errorf("%s: %s usa %v, se esperaba %v", lang, id, verbos(traduccion), verbos(format))
+			}
+		}
+		for id := range catalogo[lang] {
+			if _, ok := catalogo[Default][id]; !ok {
+				t.Errorf("%s: %s no existe en %s", lang, id, Default)
+			}
+		}
+	}
+
+	for id := range catalogo[Default] {
+		if strings.HasPrefix(id, "error.") && verbos(catalogo[Default][id]) != nil {
+			t.Errorf("%s: los mensajes genéricos de error no llevan argumentos", id)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package i18n
+
+// IDs de los mensajes de validación
+const (
+	MsgCampoRequerido        = "validacion.campo_requerido"
+	MsgCampoNumero           = "validacion.campo_numero"
+	MsgCampoEntero           = "validacion.campo_entero"
+	MsgCampoRepetido         = "validacion.campo_repetido"
+	MsgPromedioRango         = "validacion.promedio_rango"
+	MsgEmailInvalido         = "validacion.email_invalido"
+	MsgTelefonoFormato       = "validacion.telefono_formato"
+	MsgHorasClaseNegativas   = "validacion.horas_clase_negativas"
+	MsgFotoContentType       = "validacion.foto_content_type"
+	MsgFotoSize              = "validacion.foto_size"
+	MsgEstadoRevision        = "validacion.estado_revision"
+	MsgComentarioRechazo     = "validacion.comentario_rechazo"
+	MsgIdiomaFormato         = "validacion.idioma_formato"
+	MsgClavePlantilla        = "validacion.clave_plantilla"
+	MsgWebhookURL            = "validacion.webhook_url"
+	MsgWebhookURLPrivada     = "validacion.webhook_url_privada"
+	MsgWebhookEventos        = "validacion.webhook_eventos"
+	MsgWebhookEventoInvalido = "validacion.webhook_evento_invalido"
+	MsgCampoDesconocido      = "validacion.campo_desconocido"
+	MsgTipoInvalido          = "validacion.tipo_invalido"
+	MsgValorNoPermitido      = "validacion.valor_no_permitido"
+	MsgValorMinimo           = "validacion.valor_minimo"
+	MsgValorMaximo           = "validacion.valor_maximo"
+	MsgLongitudMinima        = "validacion.longitud_minima"
+)
+
+// IDs de los mensajes de los handlers
+const (
+	MsgIDInvalido            = "http.id_invalido"
+	MsgIDDocumentoInvalido   = "http.id_documento_invalido"
+	MsgIDEntregaInvalido     = "http.id_entrega_invalido"
+	MsgJSONInvalido          = "http.json_invalido"
+	MsgArchivoRequerido      = "http.archivo_requerido"
+	MsgArchivoInvalido       = "http.archivo_invalido"
+	MsgArchivoIlegible       = "http.archivo_ilegible"
+	MsgArchivoMuyGrande      = "http.archivo_muy_grande"
+	MsgPeticionIlegible      = "http.peticion_ilegible"
+	MsgPeticionMuyGrande     = "http.peticion_muy_grande"
+	MsgContentTypeJSON       = "http.content_type_json"
+	MsgContentLength         = "http.content_length"
+	MsgFormatoImportacion    = "http.formato_importacion"
+	MsgFormatoExportacion    = "http.formato_exportacion"
+	MsgURLFirmadaInvalida    = "http.url_firmada_invalida"
+	MsgRecursoNoEncontrado   = "http.recurso_no_encontrado"
+	MsgRutaNoEncontrada      = "http.ruta_no_encontrada"
+	MsgMetodoNoPermitido     = "http.metodo_no_permitido"
+	MsgIdempotencyKeyLarga   = "http.idempotency_key_larga"
+	MsgIdempotencyKeyReusada = "http.idempotency_key_reusada"
+	MsgIdempotencyEnCurso    = "http.idempotency_en_curso"
+	MsgRespuestaInvalida     = "http.respuesta_invalida"
+)
+
+// IDs de las respuestas exitosas
+const (
+	MsgAlumnoActualizado   = "ok.alumno_actualizado"
+	MsgAlumnoEliminado     = "ok.alumno_eliminado"
+	MsgProfesorActualizado = "ok.profesor_actualizado"
+	MsgProfesorEliminado   = "ok.profesor_eliminado"
+	MsgFotoPerfilEliminada = "ok.foto_perfil_eliminada"
+	MsgDocumentoEliminado  = "ok.documento_eliminado"
+	MsgPlantillaEliminada  = "ok.plantilla_eliminada"
+	MsgWebhookEliminado    = "ok.webhook_eliminado"
+	MsgArchivoSubido       = "ok.archivo_subido"
+	MsgEmailProgramado     = "ok.email_programado"
+	MsgSesionValida        = "ok.sesion_valida"
+	MsgSesionCerrada       = "ok.sesion_cerrada"
+)
+
+// ErrorMsg devuelve el ID del mensaje genérico de un código de problem+json
+func ErrorMsg(code string) string {
+	return "error." + code
+}
+
+var catalogo = map[string]map[string]string{
+	"es": {
+		MsgCampoRequerido:        "El campo %s es requerido",
+		MsgCampoNumero:           "El campo %s debe ser un número",
+		MsgCampoEntero:           "El campo %s debe ser un número entero",
+		MsgCampoRepetido:         "El campo %s se repite en la fila %d",
+		MsgPromedioRango:         "El promedio debe estar entre 0 y 10",
+		MsgEmailInvalido:         "El email no es válido",
+		MsgTelefonoFormato:       "El telefono debe estar en formato E.164 (ej. +525512345678)",
+		MsgHorasClaseNegativas:   "El campo horasClase debe ser mayor o igual a 0",
+		MsgFotoContentType:       "El contentType debe ser image/jpeg, image/png o image/webp",
+		MsgFotoSize:              "El tamaño debe ser mayor a 0 y de máximo 10 MB",
+		MsgEstadoRevision:        "El estado debe ser aprobado o rechazado",
+		MsgComentarioRechazo:     "El comentario es requerido al rechazar un documento",
+		MsgIdiomaFormato:         "El idioma debe tener el formato es o es-mx",
+		MsgClavePlantilla:        "Clave de plantilla no soportada",
+		MsgWebhookURL:            "La url debe ser absoluta con esquema http o https",
+		MsgWebhookURLPrivada:     "La url debe apuntar a una dirección pública",
+		MsgWebhookEventos:        "Se requiere al menos un evento",
+		MsgWebhookEventoInvalido: "Evento no soportado: %s",
+		MsgCampoDesconocido:      "El campo %s no está permitido",
+		MsgTipoInvalido:          "El campo %s debe ser de tipo %s",
+		MsgValorNoPermitido:      "El campo %s debe ser uno de: %s",
+		MsgValorMinimo:           "El campo %s debe ser mayor o igual a %v",
+		MsgValorMaximo:           "El campo %s debe ser menor o igual a %v",
+		MsgLongitudMinima:        "El campo %s debe tener al menos %d caracteres",
+
+		MsgIDInvalido:            "ID inválido",
+		MsgIDDocumentoInvalido:   "ID de documento inválido",
+		MsgIDEntregaInvalido:     "ID de entrega inválido",
+		MsgJSONInvalido:          "JSON inválido",
+		MsgArchivoRequerido:      "Archivo '%s' requerido",
+		MsgArchivoInvalido:       "Error al procesar el archivo",
+		MsgArchivoIlegible:       "Error al leer el archivo: %v",
+		MsgArchivoMuyGrande:      "El archivo excede el tamaño máximo",
+		MsgPeticionIlegible:      "Error al leer la petición",
+		MsgPeticionMuyGrande:     "La petición excede el tamaño máximo",
+		MsgContentTypeJSON:       "Content-Type debe ser application/json",
+		MsgContentLength:         "Content-Length requerido",
+		MsgFormatoImportacion:    "Formato no soportado, se espera CSV o XLSX",
+		MsgFormatoExportacion:    "Formato no soportado, se espera csv, xlsx o ndjson",
+		MsgURLFirmadaInvalida:    "URL inválida o expirada",
+		MsgRecursoNoEncontrado:   "Recurso no encontrado",
+		MsgRutaNoEncontrada:      "Ruta no encontrada",
+		MsgMetodoNoPermitido:     "Método no permitido",
+		MsgIdempotencyKeyLarga:   "Idempotency-Key excede 255 caracteres",
+		MsgIdempotencyKeyReusada: "Idempotency-Key ya se usó con una petición distinta",
+		MsgIdempotencyEnCurso:    "Hay una petición en curso con el mismo Idempotency-Key",
+		MsgRespuestaInvalida:     "La respuesta no cumple la especificación OpenAPI",
+
+		MsgAlumnoActualizado:   "Alumno actualizado correctamente",
+		MsgAlumnoEliminado:     "Alumno eliminado correctamente",
+		MsgProfesorActualizado: "Profesor actualizado correctamente",
+		MsgProfesorEliminado:   "Profesor eliminado correctamente",
+		MsgFotoPerfilEliminada: "Foto de perfil eliminada correctamente",
+		MsgDocumentoEliminado:  "Documento eliminado correctamente",
+		MsgPlantillaEliminada:  "Plantilla eliminada correctamente",
+		MsgWebhookEliminado:    "Webhook eliminado correctamente",
+		MsgArchivoSubido:       "Archivo subido correctamente",
+		MsgEmailProgramado:     "Email programado para envío",
+		MsgSesionValida:        "Sesión válida",
+		MsgSesionCerrada:       "Sesión cerrada correctamente",
+
+		"error.invalid_input":       "Datos de entrada inválidos",
+		"error.validation_failed":   "Datos de entrada inválidos",
+		"error.not_found":           "Recurso no encontrado",
+		"error.unauthorized":        "No autorizado",
+		"error.forbidden":           "Acceso denegado",
+		"error.already_exists":      "El recurso ya existe",
+		"error.conflict":            "La operación no es válida en el estado actual del recurso",
+		"error.invalid_session":     "Sesión inválida o expirada",
+		"error.invalid_credentials": "Credenciales inválidas",
+		"error.payload_too_large":   "La petición excede el tamaño máximo",
+		"error.internal_error":      "Error interno del servidor",
+	},
+	"en": {
+		MsgCampoRequerido:        "The %s field is required",
+		MsgCampoNumero:           "The %s field must be a number",
+		MsgCampoEntero:           "The %s field must be an integer",
+		MsgCampoRepetido:         "The %s field is repeated in row %d",
+		MsgPromedioRango:         "promedio must be between 0 and 10",
+		MsgEmailInvalido:         "The email is not valid",
+		MsgTelefonoFormato:       "telefono must be in E.164 format (e.g. +525512345678)",
+		MsgHorasClaseNegativas:   "The horasClase field must be greater than or equal to 0",
+		MsgFotoContentType:       "contentType must be image/jpeg, image/png or image/webp",
+		MsgFotoSize:              "The size must be greater than 0 and at most 10 MB",
+		MsgEstadoRevision:        "estado must be aprobado or rechazado",
+		MsgComentarioRechazo:     "A comment is required when rejecting a document",
+		MsgIdiomaFormato:         "idioma must have the format es or es-mx",
+		MsgClavePlantilla:        "Unsupported template key",
+		MsgWebhookURL:            "The url must be absolute with an http or https scheme",
+		MsgWebhookURLPrivada:     "The url must point to a public address",
+		MsgWebhookEventos:        "At least one event is required",
+		MsgWebhookEventoInvalido: "Unsupported event: %s",
+		MsgCampoDesconocido:      "The %s field is not allowed",
+		MsgTipoInvalido:          "The %s field must be of type %s",
+		MsgValorNoPermitido:      "The %s field must be one of: %s",
+		MsgValorMinimo:           "The %s field must be greater than or equal to %v",
+		MsgValorMaximo:           "The %s field must be less than or equal to %v",
+		MsgLongitudMinima:        "The %s field must have at least %d characters",
+
+		MsgIDInvalido:            "Invalid ID",
+		MsgIDDocumentoInvalido:   "Invalid document ID",
+		MsgIDEntregaInvalido:     "Invalid delivery ID",
+		MsgJSONInvalido:          "Invalid JSON",
+		MsgArchivoRequerido:      "File '%s' is required",
+		MsgArchivoInvalido:       "Error processing the file",
+		MsgArchivoIlegible:       "Error reading the file: %v",
+		MsgArchivoMuyGrande:      "The file exceeds the maximum size",
+		MsgPeticionIlegible:      "Error reading the request",
+		MsgPeticionMuyGrande:     "The request exceeds the maximum size",
+		MsgContentTypeJSON:       "Content-Type must be application/json",
+		MsgContentLength:         "Content-Length is required",
+		MsgFormatoImportacion:    "Unsupported format, expected CSV or XLSX",
+		MsgFormatoExportacion:    "Unsupported format, expected csv, xlsx or ndjson",
+		MsgURLFirmadaInvalida:    "Invalid or expired URL",
+		MsgRecursoNoEncontrado:   "Resource not found",
+		MsgRutaNoEncontrada:      "Route not found",
+		MsgMetodoNoPermitido:     "Method not allowed",
+		MsgIdempotencyKeyLarga:   "Idempotency-Key exceeds 255 characters",
+		MsgIdempotencyKeyReusada: "Idempotency-Key was already used with a different request",
+		MsgIdempotencyEnCurso:    "A request with the same Idempotency-Key is in progress",
+		MsgRespuestaInvalida:     "The response does not match the OpenAPI specification",
+
+		MsgAlumnoActualizado:   "Student updated successfully",
+		MsgAlumnoEliminado:     "Student deleted successfully",
+		MsgProfesorActualizado: "Professor updated successfully",
+		MsgProfesorEliminado:   "Professor deleted successfully",
+		MsgFotoPerfilEliminada: "Profile photo deleted successfully",
+		MsgDocumentoEliminado:  "Document deleted successfully",
+		MsgPlantillaEliminada:  "Template deleted successfully",
+		MsgWebhookEliminado:    "Webhook deleted successfully",
+		MsgArchivoSubido:       "File uploaded successfully",
+		MsgEmailProgramado:     "Email scheduled for delivery",
+		MsgSesionValida:        "Valid session",
+		MsgSesionCerrada:       "Session closed successfully",
+
+		"error.invalid_input":       "Invalid input data",
+		"error.validation_failed":   "Invalid input data",
+		"error.not_found":           "Resource not found",
+		"error.unauthorized":        "Unauthorized",
+		"error.forbidden":           "Access denied",
+		"error.already_exists":      "The resource already exists",
+		"error.conflict":            "The operation is not valid in the current state of the resource",
+		"error.invalid_session":     "Invalid or expired session",
+		"error.invalid_credentials": "Invalid credentials",
+		"error.payload_too_large":   "The request exceeds the maximum size",
+		"error.internal_error":      "Internal server error",
+	},
+}
@@ -0,0 +1,133 @@
+// Package imaging valida, normaliza y redimensiona las fotos de perfil.
+// Las imágenes se detectan por sus bytes mágicos (no por el Content-Type del
+// cliente) y siempre se re-codifican, lo que elimina los metadatos EXIF.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatWebP = "webp"
+)
+
+// MaxDimension - Lado máximo (en pixeles) aceptado para una imagen de entrada
+const MaxDimension = 6000
+
+var (
+	ErrUnsupportedFormat = errors.New("formato de imagen no soportado (solo JPEG, PNG o WebP)")
+	ErrTooLarge          = fmt.Errorf("la imagen excede %dx%d pixeles", MaxDimension, MaxDimension)
+)
+
+// Variant - Una versión codificada de la imagen
+type Variant struct {
+	Name        string
+	Data        []byte
+	ContentType string
+	Ext         string
+}
+
+// Sniff identifica el formato por los bytes mágicos del archivo
+func Sniff(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		return FormatJPEG, nil
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return FormatPNG, nil
+	case len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return FormatWebP, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// Process valida la imagen y genera la variante "original" (re-codificada, sin EXIF)
+// más una variante por cada tamaño, escalada para que su lado mayor no exceda ese tamaño
+func Process(data []byte, sizes []int) ([]Variant, error) {
+	format, err := Sniff(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width > MaxDimension || cfg.Height > MaxDimension {
+		return nil, ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+
+	if format == FormatJPEG {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+
+	variants := make([]Variant, 0, len(sizes)+1)
+
+	original, err := encode("original", img)
+	if err != nil {
+		return nil, err
+	}
+	variants = append(variants, original)
+
+	for _, size := range sizes {
+		variant, err := encode(fmt.Sprintf("%d", size), resize(img, size))
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant)
+	}
+
+	return variants, nil
+}
+
+// resize escala img para que su lado mayor sea maxSide; nunca agranda
+func resize(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w <= maxSide && h <= maxSide {
+		return img
+	}
+
+	if w >= h {
+		h = max(1, h*maxSide/w)
+		w = maxSide
+	} else {
+		w = max(1, w*maxSide/h)
+		h = maxSide
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// encode usa PNG si la imagen tiene transparencia y JPEG en otro caso
+func encode(name string, img image.Image) (Variant, error) {
+	var buf bytes.Buffer
+
+	if opaque, ok := img.(interface{ Opaque() bool }); ok && !opaque.Opaque() {
+		if err := png.Encode(&buf, img); err != nil {
+			return Variant{}, fmt.Errorf("error al codificar PNG: %w", err)
+		}
+		return Variant{Name: name, Data: buf.Bytes(), ContentType: "image/png", Ext: ".png"}, nil
+	}
+
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+		return Variant{}, fmt.Errorf("error al codificar JPEG: %w", err)
+	}
+	return Variant{Name: name, Data: buf.Bytes(), ContentType: "image/jpeg", Ext: ".jpg"}, nil
+}
@@ -0,0 +1,269 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// WebP de 1x1: con pérdida (opaca) y sin pérdida (transparente); Go no trae
+// codificador de WebP, por eso van como bytes fijos
+var (
+	webpLossy    = decodeBase64("UklGRiIAAABXRUJQVlA4IBYAAAAwAQCdASoBAAEADsD+JaQAA3AAAAAA")
+	webpLossless = decodeBase64("UklGRhoAAABXRUJQVlA4TA0AAAAvAAAAEAcQERGIiP4HAA==")
+)
+
+func decodeBase64(s string) []byte {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// mitades crea una imagen w x h con la mitad izquierda roja y la derecha azul
+func mitades(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// conOrientacion inserta después del SOI un segmento APP1 con un IFD EXIF que
+// solo tiene la etiqueta Orientation
+func conOrientacion(data []byte, order binary.ByteOrder, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)
+	order.PutUint16(tiff[8:], 1)
+	order.PutUint16(tiff[10:], 0x0112) // Orientation
+	order.PutUint16(tiff[12:], 3)      // SHORT
+	order.PutUint32(tiff[14:], 1)
+	order.PutUint16(tiff[18:], orientation)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, app1...)
+	return append(out, data[2:]...)
+}
+
+func TestSniff(t *testing.T) {
+	for _, tc := range []struct {
+		nombre string
+		data   []byte
+		want   string
+	}{
+		{"jpeg", encodeJPEG(t, mitades(4, 4)), FormatJPEG},
+		{"png", encodePNG(t, mitades(4, 4)), FormatPNG},
+		{"webp con pérdida", webpLossy, FormatWebP},
+		{"webp sin pérdida", webpLossless, FormatWebP},
+		{"gif", []byte("GIF89a\x01\x00\x01\x00"), ""},
+		{"html", []byte("<html><script>alert(1)</script>"), ""},
+		{"riff que no es webp", []byte("RIFF\x00\x00\x00\x00WAVEfmt "), ""},
+		{"vacío", nil, ""},
+	} {
+		got, err := Sniff(tc.data)
+		if tc.want == "" {
+			if !errors.Is(err, ErrUnsupportedFormat) {
+				t.Errorf("%s: Sniff = %q, %v; se esperaba ErrUnsupportedFormat", tc.nombre, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Errorf("%s: Sniff = %q, %v; se esperaba %q", tc.nombre, got, err, tc.want)
+		}
+	}
+}
+
+func TestProcess(t *testing.T) {
+	transparente := image.NewNRGBA(image.Rect(0, 0, 300, 100))
+	transparente.Set(0, 0, color.NRGBA{R: 255, A: 128})
+
+	for _, tc := range []struct {
+		nombre      string
+		data        []byte
+		contentType string
+		original    image.Point
+		variantes   map[string]image.Point
+	}{
+		{"jpeg horizontal", encodeJPEG(t, mitades(300, 100)), "image/jpeg", image.Pt(300, 100),
+			map[string]image.Point{"64": image.Pt(64, 21), "256": image.Pt(256, 85), "1024": image.Pt(300, 100)}},
+		{"png opaco se vuelve jpeg", encodePNG(t, mitades(50, 200)), "image/jpeg", image.Pt(50, 200),
+			map[string]image.Point{"64": image.Pt(16, 64), "256": image.Pt(50, 200), "1024": image.Pt(50, 200)}},
+		{"png con transparencia se queda png", encodePNG(t, transparente), "image/png", image.Pt(300, 100),
+			map[string]image.Point{"64": image.Pt(64, 21), "256": image.Pt(256, 85), "1024": image.Pt(300, 100)}},
+		{"webp opaco", webpLossy, "image/jpeg", image.Pt(1, 1),
+			map[string]image.Point{"64": image.Pt(1, 1), "256": image.Pt(1, 1), "1024": image.Pt(1, 1)}},
+		{"webp transparente", webpLossless, "image/png", image.Pt(1, 1),
+			map[string]image.Point{"64": image.Pt(1, 1), "256": image.Pt(1, 1), "1024": image.Pt(1, 1)}},
+	} {
+		variants, err := Process(tc.data, []int{64, 256, 1024})
+		if err != nil {
+			t.Fatalf("%s: Process: %v", tc.nombre, err)
+		}
+		if len(variants) != 4 || variants[0].Name != "original" {
+			t.Fatalf("%s: %d variantes, la primera %q", tc.nombre, len(variants), variants[0].Name)
+		}
+
+		for _, variant := range variants {
+			want := tc.original
+			if variant.Name != "original" {
+				want = tc.variantes[variant.Name]
+			}
+			if variant.ContentType != tc.contentType {
+				t.Errorf("%s/%s: ContentType = %s, se esperaba %s", tc.nombre, variant.Name, variant.ContentType, tc.contentType)
+			}
+			cfg, format, err := image.DecodeConfig(bytes.NewReader(variant.Data))
+			if err != nil {
+				t.Fatalf("%s/%s: la variante no se puede decodificar: %v", tc.nombre, variant.Name, err)
+			}
+			if "image/"+format != variant.ContentType {
+				t.Errorf("%s/%s: se codificó como %s con ContentType %s", tc.nombre, variant.Name, format, variant.ContentType)
+			}
+			if got := image.Pt(cfg.Width, cfg.Height); got != want {
+				t.Errorf("%s/%s: %v, se esperaba %v", tc.nombre, variant.Name, got, want)
+			}
+		}
+	}
+}
+
+func TestProcessRechaza(t *testing.T) {
+	jpegValido := encodeJPEG(t, mitades(8, 8))
+	for _, tc := range []struct {
+		nombre string
+		data   []byte
+		want   error
+	}{
+		// El cliente puede declarar image/jpeg; lo que cuenta son los bytes
+		{"texto con Content-Type de imagen", []byte("no soy una imagen"), ErrUnsupportedFormat},
+		{"bytes mágicos de jpeg sin imagen", []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00}, ErrUnsupportedFormat},
+		{"jpeg truncado", jpegValido[:len(jpegValido)/2], ErrUnsupportedFormat},
+		{"demasiado ancha", encodePNG(t, image.NewGray(image.Rect(0, 0, MaxDimension+1, 1))), ErrTooLarge},
+		{"demasiado alta", encodePNG(t, image.NewGray(image.Rect(0, 0, 1, MaxDimension+1))), ErrTooLarge},
+	} {
+		if _, err := Process(tc.data, []int{64}); !errors.Is(err, tc.want) {
+			t.Errorf("%s: Process = %v, se esperaba %v", tc.nombre, err, tc.want)
+		}
+	}
+}
+
+// Un JPEG con Orientation=6 (90° horario) se entrega ya rotado y sin EXIF
+func TestProcessAplicaOrientacionEXIF(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		data := conOrientacion(encodeJPEG(t, mitades(80, 40)), order, 6)
+		if got := jpegOrientation(data); got != 6 {
+			t.Fatalf("%s: jpegOrientation = %d", order, got)
+		}
+
+		variants, err := Process(data, nil)
+		if err != nil {
+			t.Fatalf("%s: Process: %v", order, err)
+		}
+		original := variants[0]
+		if bytes.Contains(original.Data, []byte("Exif")) {
+			t.Errorf("%s: la variante conserva el EXIF", order)
+		}
+
+		img, err := jpeg.Decode(bytes.NewReader(original.Data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := img.Bounds().Size(); got != image.Pt(40, 80) {
+			t.Fatalf("%s: tamaño tras rotar = %v, se esperaba 40x80", order, got)
+		}
+		// La mitad izquierda (roja) queda arriba y la derecha (azul) abajo
+		arriba, abajo := img.At(20, 10), img.At(20, 70)
+		if r, _, b, _ := arriba.RGBA(); r < b {
+			t.Errorf("%s: arriba = %v, se esperaba rojo", order, arriba)
+		}
+		if r, _, b, _ := abajo.RGBA(); b < r {
+			t.Errorf("%s: abajo = %v, se esperaba azul", order, abajo)
+		}
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 2x1: (0,0) rojo, (1,0) azul
+	src := mitades(2, 1)
+	rojo := color.RGBA{R: 255, A: 255}
+
+	for orientation, tc := range map[int]struct {
+		size image.Point
+		rojo image.Point
+	}{
+		1: {image.Pt(2, 1), image.Pt(0, 0)},
+		2: {image.Pt(2, 1), image.Pt(1, 0)},
+		3: {image.Pt(2, 1), image.Pt(1, 0)},
+		4: {image.Pt(2, 1), image.Pt(0, 0)},
+		5: {image.Pt(1, 2), image.Pt(0, 0)},
+		6: {image.Pt(1, 2), image.Pt(0, 0)},
+		7: {image.Pt(1, 2), image.Pt(0, 1)},
+		8: {image.Pt(1, 2), image.Pt(0, 1)},
+	} {
+		img := applyOrientation(src, orientation)
+		if got := img.Bounds().Size(); got != tc.size {
+			t.Errorf("orientación %d: tamaño %v, se esperaba %v", orientation, got, tc.size)
+			continue
+		}
+		if got := color.RGBAModel.Convert(img.At(tc.rojo.X, tc.rojo.Y)); got != rojo {
+			t.Errorf("orientación %d: %v = %v, se esperaba rojo", orientation, tc.rojo, got)
+		}
+	}
+}
+
+func TestTiffOrientationDatosInvalidos(t *testing.T) {
+	valido := conOrientacion([]byte{0xFF, 0xD8}, binary.BigEndian, 8)[2+4+6:] // SOI, APP1 y "Exif\x00\x00"
+	for nombre, tiff := range map[string][]byte{
+		"vacío":              nil,
+		"orden desconocido":  append([]byte("XX"), valido[2:]...),
+		"IFD fuera de rango": {'M', 'M', 0, 42, 0xFF, 0xFF, 0xFF, 0xFF},
+		"entrada truncada":   valido[:14],
+	} {
+		if got := tiffOrientation(tiff); got != 1 {
+			t.Errorf("%s: tiffOrientation = %d, se esperaba 1", nombre, got)
+		}
+	}
+	if got := tiffOrientation(valido); got != 8 {
+		t.Errorf("tiffOrientation = %d, se esperaba 8", got)
+	}
+}
@@ -0,0 +1,169 @@
+package tabular
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"strings"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/xuri/excelize/v2"
+)
+
+// Formatos de archivo soportados
+const (
+	FormatCSV    = "csv"
+	FormatXLSX   = "xlsx"
+	FormatNDJSON = "ndjson" // solo exportación
+)
+
+const (
+	ContentTypeCSV    = "text/csv"
+	ContentTypeXLSX   = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	ContentTypeNDJSON = "application/x-ndjson"
+)
+
+var ErrUnsupportedFormat = errors.New("formato no soportado, se espera CSV o XLSX")
+
+// Límites al descomprimir un XLSX, para que un archivo pequeño no se expanda a
+// gigabytes (zip bomb). El XML de una hoja suele comprimir ~10x; por encima de
+// xlsxMaxXMLEnMemoria la hoja se descomprime a un archivo temporal
+const (
+	xlsxMaxDescomprimido = 10 * utils.MaxImportacionSize
+	xlsxMaxXMLEnMemoria  = 2 * utils.MaxImportacionSize
+)
+
+// FormatFromContentType reconoce text/csv y el content type de XLSX
+func FormatFromContentType(contentType string) (string, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case ContentTypeCSV, "application/csv":
+		return FormatCSV, nil
+	case ContentTypeXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// FormatFromFilename usa la extensión del archivo
+func FormatFromFilename(filename string) (string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// Record - Fila de datos indexada por el encabezado de su columna
+type Record struct {
+	Line   int // número de fila en el archivo, contando el encabezado como 1
+	Values map[string]string
+}
+
+// Get devuelve el valor de la columna name (sin mayúsculas ni espacios)
+func (r Record) Get(name string) string {
+	return r.Values[normalizeHeader(name)]
+}
+
+// ReadRecords lee la primera hoja (XLSX) o el archivo completo (CSV). La primera
+// fila es el encabezado; sus nombres se comparan sin mayúsculas ni espacios.
+// Las filas vacías se omiten pero cuentan para Line.
+func ReadRecords(r io.Reader, format string) ([]Record, error) {
+	var rows [][]string
+	var err error
+	switch format {
+	case FormatCSV:
+		rows, err = readCSV(r)
+	case FormatXLSX:
+		rows, err = readXLSX(r)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("el archivo está vacío")
+	}
+
+	header := make([]string, len(rows[0]))
+	for i, name := range rows[0] {
+		header[i] = normalizeHeader(name)
+	}
+
+	var records []Record
+	for i, row := range rows[1:] {
+		record := Record{Line: i + 2, Values: make(map[string]string, len(header))}
+		empty := true
+		for j, value := range row {
+			if j >= len(header) || header[j] == "" {
+				continue
+			}
+			value = strings.TrimSpace(value)
+			if format == FormatCSV {
+				value = unescapeFormula(value)
+			}
+			if value != "" {
+				empty = false
+			}
+			record.Values[header[j]] = value
+		}
+		if empty {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// readCSV acepta BOM y separador coma o punto y coma (Excel en español usa ;)
+func readCSV(r io.Reader) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+	reader := csv.NewReader(bytes.NewReader(data))
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("CSV inválido: %w", err)
+	}
+	return rows, nil
+}
+
+func readXLSX(r io.Reader) ([][]string, error) {
+	file, err := excelize.OpenReader(r, excelize.Options{
+		UnzipSizeLimit:    xlsxMaxDescomprimido,
+		UnzipXMLSizeLimit: xlsxMaxXMLEnMemoria,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("XLSX inválido: %w", err)
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, fmt.Errorf("el archivo no tiene hojas")
+	}
+	return file.GetRows(sheets[0])
+}
+
+func normalizeHeader(name string) string {
+	name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
@@ -0,0 +1,55 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/utils"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestReadRecordsXLSX(t *testing.T) {
+	file := excelize.NewFile()
+	file.SetSheetRow("Sheet1", "A1", &[]any{"Matrícula", "Nombres"})
+	file.SetSheetRow("Sheet1", "A2", &[]any{"A001", "Ana"})
+	file.SetSheetRow("Sheet1", "A4", &[]any{"A002", "Beto"})
+	buf, err := file.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := ReadRecords(buf, FormatXLSX)
+	if err != nil {
+		t.Fatalf("ReadRecords: %v", err)
+	}
+	if len(records) != 2 || records[0].Get("matrícula") != "A001" || records[1].Line != 4 || records[1].Get("Nombres") != "Beto" {
+		t.Fatalf("records = %+v", records)
+	}
+}
+
+// Un XLSX de pocos KB cuya hoja se expande más allá del límite se rechaza
+// sin descomprimirlo
+func TestReadRecordsXLSXZipBomb(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bloque := []byte(strings.Repeat(" ", 1<<20))
+	for range xlsxMaxDescomprimido>>20 + 1 {
+		w.Write(bloque)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() > utils.MaxImportacionSize {
+		t.Fatalf("el archivo de prueba mide %d bytes, más que una importación", buf.Len())
+	}
+
+	if _, err := ReadRecords(&buf, FormatXLSX); err == nil || !strings.Contains(err.Error(), "unzip size exceeds") {
+		t.Fatalf("ReadRecords = %v, se esperaba el error del límite de descompresión", err)
+	}
+}
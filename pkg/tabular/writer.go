@@ -0,0 +1,217 @@
+package tabular
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+var ErrUnsupportedExportFormat = errors.New("formato no soportado, se espera csv, xlsx o ndjson")
+
+// Writer escribe filas en el formato de exportación. El encabezado se escribe al
+// crearlo; Close debe llamarse para vaciar los datos pendientes.
+type Writer interface {
+	Write(values []any) error
+	Close() error
+}
+
+// ContentType devuelve el content type de un formato de exportación
+func ContentType(format string) string {
+	switch format {
+	case FormatXLSX:
+		return ContentTypeXLSX
+	case FormatNDJSON:
+		return ContentTypeNDJSON
+	default:
+		return ContentTypeCSV + "; charset=utf-8"
+	}
+}
+
+// FormatFromAccept elige el primer formato de exportación que acepte el cliente.
+// Un Accept vacío o */* equivale a CSV.
+func FormatFromAccept(accept string) (string, error) {
+	if strings.TrimSpace(accept) == "" {
+		return FormatCSV, nil
+	}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case ContentTypeCSV, "application/csv", "text/*", "*/*":
+			return FormatCSV, nil
+		case ContentTypeXLSX:
+			return FormatXLSX, nil
+		case ContentTypeNDJSON, "application/ndjson", "application/jsonl":
+			return FormatNDJSON, nil
+		}
+	}
+	return "", ErrUnsupportedExportFormat
+}
+
+// NewWriter crea el writer del formato y escribe el encabezado
+func NewWriter(w io.Writer, format string, header []string) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, header)
+	case FormatXLSX:
+		return newXLSXWriter(w, header)
+	case FormatNDJSON:
+		return newNDJSONWriter(w, header)
+	default:
+		return nil, ErrUnsupportedExportFormat
+	}
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+	row    []string
+}
+
+func newCSVWriter(w io.Writer, header []string) (*csvWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	return &csvWriter{writer: writer, row: make([]string, len(header))}, nil
+}
+
+func (c *csvWriter) Write(values []any) error {
+	for i, value := range values {
+		c.row[i] = formatValue(value)
+		if _, ok := value.(string); ok {
+			c.row[i] = escapeFormula(c.row[i])
+		}
+	}
+	return c.writer.Write(c.row)
+}
+
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// xlsxWriter usa el stream writer de excelize, que guarda las filas en un
+// archivo temporal en lugar de mantener la hoja en memoria
+type xlsxWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	line   int
+}
+
+func newXLSXWriter(w io.Writer, header []string) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	stream, err := file.NewStreamWriter(file.GetSheetList()[0])
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	x := &xlsxWriter{out: w, file: file, stream: stream}
+
+	row := make([]any, len(header))
+	for i, name := range header {
+		row[i] = name
+	}
+	if err := x.Write(row); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return x, nil
+}
+
+func (x *xlsxWriter) Write(values []any) error {
+	x.line++
+	cell, err := excelize.CoordinatesToCellName(1, x.line)
+	if err != nil {
+		return err
+	}
+	return x.stream.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+	if err := x.stream.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.out)
+}
+
+// ndjsonWriter escribe un objeto por línea con las llaves en el orden del encabezado
+type ndjsonWriter struct {
+	out  *bufio.Writer
+	keys [][]byte
+}
+
+func newNDJSONWriter(w io.Writer, header []string) (*ndjsonWriter, error) {
+	keys := make([][]byte, len(header))
+	for i, name := range header {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return &ndjsonWriter{out: bufio.NewWriter(w), keys: keys}, nil
+}
+
+func (n *ndjsonWriter) Write(values []any) error {
+	n.out.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			n.out.WriteByte(',')
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		n.out.Write(n.keys[i])
+		n.out.WriteByte(':')
+		n.out.Write(data)
+	}
+	_, err := n.out.WriteString("}\n")
+	return err
+}
+
+func (n *ndjsonWriter) Close() error {
+	return n.out.Flush()
+}
+
+// formulaPrefix - Caracteres con los que Excel y LibreOffice interpretan un
+// campo de un CSV como fórmula (CSV/formula injection)
+const formulaPrefix = "=+-@\t\r"
+
+// escapeFormula antepone un apóstrofo a los textos del CSV que empiezan como
+// fórmula, para que la hoja de cálculo los muestre como texto. ReadRecords lo quita.
+func escapeFormula(value string) string {
+	if value != "" && strings.IndexByte(formulaPrefix, value[0]) >= 0 {
+		return "'" + value
+	}
+	return value
+}
+
+// unescapeFormula revierte escapeFormula al importar un CSV exportado
+func unescapeFormula(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.IndexByte(formulaPrefix, value[1]) >= 0 {
+		return value[1:]
+	}
+	return value
+}
+
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,118 @@
+package tabular
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+var (
+	formulaHeader = []string{"nombres", "telefono", "promedio"}
+	formulaFilas  = [][]any{
+		{`=HYPERLINK("http://evil.example","x")`, "+525512345678", -1.5},
+		{"@SUM(A1)", "-2", 9.0},
+		{"\tTab", "\rCR", 8.5},
+		{"Ana", "", 10.0},
+	}
+)
+
+func escribir(t *testing.T, format string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, format, formulaHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fila := range formulaFilas {
+		if err := writer.Write(fila); err != nil {
+			t.Fatalf("%s: Write: %v", format, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("%s: Close: %v", format, err)
+	}
+	return buf.Bytes()
+}
+
+func TestCSVEscapaFormulas(t *testing.T) {
+	data := escribir(t, FormatCSV)
+	esperado := [][]string{
+		{`'=HYPERLINK("http://evil.example","x")`, "'+525512345678", "-1.5"},
+		{"'@SUM(A1)", "'-2", "9"},
+		{"'\tTab", "'\rCR", "8.5"},
+		{"Ana", "", "10"},
+	}
+
+	rows, err := readCSV(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range esperado {
+		for j := range want {
+			if got := rows[i+1][j]; got != want[j] {
+				t.Errorf("fila %d columna %s = %q, se esperaba %q", i+1, formulaHeader[j], got, want[j])
+			}
+		}
+	}
+
+	// Reimportar el archivo exportado devuelve los valores originales
+	records, err := ReadRecords(bytes.NewReader(data), FormatCSV)
+	if err != nil {
+		t.Fatalf("ReadRecords: %v", err)
+	}
+	if got := records[0].Get("telefono"); got != "+525512345678" {
+		t.Errorf("telefono reimportado = %q", got)
+	}
+	if got := records[0].Get("nombres"); !strings.HasPrefix(got, "=HYPERLINK") {
+		t.Errorf("nombres reimportado = %q", got)
+	}
+}
+
+// En XLSX los textos van como celdas de texto, que no se evalúan: se guardan
+// tal cual, sin apóstrofo
+func TestXLSXGuardaTextosSinEscapar(t *testing.T) {
+	data := escribir(t, FormatXLSX)
+	file, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	sheet := file.GetSheetList()[0]
+
+	for i, fila := range formulaFilas {
+		for j, value := range fila {
+			texto, ok := value.(string)
+			if !ok || texto == "" {
+				continue
+			}
+			cell, err := excelize.CoordinatesToCellName(j+1, i+2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := file.GetCellValue(sheet, cell)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != texto {
+				t.Errorf("%s = %q, se esperaba %q", cell, got, texto)
+			}
+			if formula, _ := file.GetCellFormula(sheet, cell); formula != "" {
+				t.Errorf("%s quedó como fórmula %q", cell, formula)
+			}
+		}
+	}
+
+	// Reimportar el XLSX devuelve los valores tal cual
+	records, err := ReadRecords(bytes.NewReader(data), FormatXLSX)
+	if err != nil {
+		t.Fatalf("ReadRecords: %v", err)
+	}
+	if got := records[0].Get("telefono"); got != "+525512345678" {
+		t.Errorf("telefono reimportado = %q", got)
+	}
+	if got := records[1].Get("nombres"); got != "@SUM(A1)" {
+		t.Errorf("nombres reimportado = %q", got)
+	}
+}
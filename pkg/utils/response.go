@@ -8,7 +8,6 @@ import (
 type Response struct {
 	Data    interface{} `json:"data,omitempty"`
 	Message string      `json:"message,omitempty"`
-	Error   interface{} `json:"error,omitempty"`
 }
 
 func JSON(w http.ResponseWriter, status int, data interface{}) {
@@ -23,8 +22,8 @@ func JSONMessage(w http.ResponseWriter, status int, message string) {
 	json.NewEncoder(w).Encode(Response{Message: message})
 }
 
-func JSONError(w http.ResponseWriter, status int, err interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(Response{Error: err})
+// NoStore marca una respuesta que lleva credenciales (sesión, secreto, URL
+// firmada) para que no se guarde en cachés ni para reintentos con Idempotency-Key
+func NoStore(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", "no-store")
 }
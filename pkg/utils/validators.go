@@ -1,28 +1,46 @@
 package utils
 
 import (
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
 	"strings"
 
 	apperrors "github.com/abrahamcruzc/aws-segundaentrega/pkg/errors"
+	"github.com/abrahamcruzc/aws-segundaentrega/pkg/i18n"
 )
 
-func ValidateAlumno(nombres, apellidos, matricula string, promedio float64, password string, isCreate bool) *apperrors.ValidationErrors {
+var telefonoRegexp = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+func ValidateAlumno(nombres, apellidos, matricula string, promedio float64, email, telefono, password string, isCreate bool) *apperrors.ValidationErrors {
 	errors := &apperrors.ValidationErrors{}
 
 	if strings.TrimSpace(nombres) == "" {
-		errors.Add("nombres", "El campo nombres es requerido")
+		errors.Add("nombres", i18n.MsgCampoRequerido, "nombres")
 	}
 
 	if strings.TrimSpace(apellidos) == "" {
-		errors.Add("apellidos", "El campo apellidos es requerido")
+		errors.Add("apellidos", i18n.MsgCampoRequerido, "apellidos")
 	}
 
 	if strings.TrimSpace(matricula) == "" {
-		errors.Add("matricula", "El campo matricula es requerido")
+		errors.Add("matricula", i18n.MsgCampoRequerido, "matricula")
 	}
 
 	if promedio < 0 || promedio > 10 {
-		errors.Add("promedio", "El promedio debe estar entre 0 y 10")
+		errors.Add("promedio", i18n.MsgPromedioRango)
+	}
+
+	if email != "" {
+		if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+			errors.Add("email", i18n.MsgEmailInvalido)
+		}
+	}
+
+	if telefono != "" && !telefonoRegexp.MatchString(telefono) {
+		errors.Add("telefono", i18n.MsgTelefonoFormato)
 	}
 
 	// Password es opcional - los tests no lo envían
@@ -34,19 +52,19 @@ func ValidateProfesor(numeroEmpleado int, nombres, apellidos string, horasClase
 	errors := &apperrors.ValidationErrors{}
 
 	if numeroEmpleado <= 0 {
-		errors.Add("numeroEmpleado", "El campo numeroEmpleado es requerido")
+		errors.Add("numeroEmpleado", i18n.MsgCampoRequerido, "numeroEmpleado")
 	}
 
 	if strings.TrimSpace(nombres) == "" {
-		errors.Add("nombres", "El campo nombres es requerido")
+		errors.Add("nombres", i18n.MsgCampoRequerido, "nombres")
 	}
 
 	if strings.TrimSpace(apellidos) == "" {
-		errors.Add("apellidos", "El campo apellidos es requerido")
+		errors.Add("apellidos", i18n.MsgCampoRequerido, "apellidos")
 	}
 
 	if horasClase < 0 {
-		errors.Add("horasClase", "El campo horasClase debe ser mayor o igual a 0")
+		errors.Add("horasClase", i18n.MsgHorasClaseNegativas)
 	}
 
 	return errors
@@ -56,7 +74,7 @@ func ValidatePassword(password string) *apperrors.ValidationErrors {
 	errors := &apperrors.ValidationErrors{}
 
 	if strings.TrimSpace(password) == "" {
-		errors.Add("password", "El campo password es requerido")
+		errors.Add("password", i18n.MsgCampoRequerido, "password")
 	}
 
 	return errors
@@ -66,7 +84,138 @@ func ValidateSessionString(sessionString string) *apperrors.ValidationErrors {
 	errors := &apperrors.ValidationErrors{}
 
 	if strings.TrimSpace(sessionString) == "" {
-		errors.Add("sessionString", "El campo sessionString es requerido")
+		errors.Add("sessionString", i18n.MsgCampoRequerido, "sessionString")
+	}
+
+	return errors
+}
+
+// Tipos de imagen aceptados para la foto de perfil y su extensión
+var FotoPerfilContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+const MaxFotoPerfilSize = 10 << 20 // 10 MB
+
+func ValidateFotoPerfil(contentType string, size int64) *apperrors.ValidationErrors {
+	errors := &apperrors.ValidationErrors{}
+
+	if _, ok := FotoPerfilContentTypes[contentType]; !ok {
+		errors.Add("contentType", i18n.MsgFotoContentType)
+	}
+
+	if size <= 0 || size > MaxFotoPerfilSize {
+		errors.Add("size", i18n.MsgFotoSize)
+	}
+
+	return errors
+}
+
+// MaxDocumentoSize - Mayor tamaño permitido entre todos los tipos de documento
+const MaxDocumentoSize = 20 << 20 // 20 MB
+
+// MaxImportacionSize - Tamaño máximo del CSV/XLSX de una importación masiva
+const MaxImportacionSize = 10 << 20 // 10 MB
+
+func ValidateDocumento(tipo, nombreArchivo string) *apperrors.ValidationErrors {
+	errors := &apperrors.ValidationErrors{}
+
+	if strings.TrimSpace(tipo) == "" {
+		errors.Add("tipo", i18n.MsgCampoRequerido, "tipo")
+	}
+
+	if strings.TrimSpace(nombreArchivo) == "" {
+		errors.Add("nombreArchivo", i18n.MsgCampoRequerido, "nombreArchivo")
+	}
+
+	return errors
+}
+
+func ValidateRevisionDocumento(estado, comentario string) *apperrors.ValidationErrors {
+	errors := &apperrors.ValidationErrors{}
+
+	if estado != "aprobado" && estado != "rechazado" {
+		errors.Add("estado", i18n.MsgEstadoRevision)
+	}
+
+	if estado == "rechazado" && strings.TrimSpace(comentario) == "" {
+		errors.Add("comentario", i18n.MsgComentarioRechazo)
+	}
+
+	return errors
+}
+
+// rangosNoPublicos - Rangos reservados que IsGlobalUnicast e IsPrivate no cubren
+var rangosNoPublicos = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"), // CGNAT
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"), // NAT64: puede traducirse a una IPv4 interna
+}
+
+// IsPublicIP indica si addr es una dirección pública de Internet: no es de
+// loopback, privada, link-local (p. ej. 169.254.169.254), multicast ni reservada
+func IsPublicIP(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, rango := range rangosNoPublicos {
+		if rango.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+var idiomaRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]{2})?$`)
+
+func ValidatePlantilla(clave, idioma, asunto, cuerpo string) *apperrors.ValidationErrors {
+	errors := &apperrors.ValidationErrors{}
+
+	if strings.TrimSpace(clave) == "" {
+		errors.Add("clave", i18n.MsgCampoRequerido, "clave")
+	}
+
+	if !idiomaRegexp.MatchString(idioma) {
+		errors.Add("idioma", i18n.MsgIdiomaFormato)
+	}
+
+	if strings.TrimSpace(asunto) == "" {
+		errors.Add("asunto", i18n.MsgCampoRequerido, "asunto")
+	}
+
+	if strings.TrimSpace(cuerpo) == "" {
+		errors.Add("cuerpo", i18n.MsgCampoRequerido, "cuerpo")
+	}
+
+	return errors
+}
+
+func ValidateWebhook(rawURL string, eventos []string, soportados []string) *apperrors.ValidationErrors {
+	errors := &apperrors.ValidationErrors{}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errors.Add("url", i18n.MsgWebhookURL)
+	} else if host := strings.ToLower(u.Hostname()); host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		errors.Add("url", i18n.MsgWebhookURLPrivada)
+	} else if addr, err := netip.ParseAddr(host); err == nil && !IsPublicIP(addr) {
+		// Los nombres de host se revisan al conectar, con la IP ya resuelta
+		errors.Add("url", i18n.MsgWebhookURLPrivada)
+	}
+
+	if len(eventos) == 0 {
+		errors.Add("eventos", i18n.MsgWebhookEventos)
+	}
+	for _, evento := range eventos {
+		if evento != "*" && !slices.Contains(soportados, evento) {
+			errors.Add("eventos", i18n.MsgWebhookEventoInvalido, evento)
+		}
 	}
 
 	return errors